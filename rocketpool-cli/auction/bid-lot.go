@@ -38,7 +38,7 @@ func bidOnLot(c *cli.Context) error {
 
     // Check for open lots
     if len(openLots) == 0 {
-        fmt.Println("No lots can be bid on.")
+        cliutils.Println("No lots can be bid on.")
         return nil
     }
 
@@ -72,7 +72,10 @@ func bidOnLot(c *cli.Context) error {
         for li, lot := range openLots {
             options[li] = fmt.Sprintf("lot %d (%.6f RPL available @ %.6f ETH per RPL)", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6), math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
         }
-        selected, _ := cliutils.Select("Please select a lot to bid on:", options)
+        selected, _, err := cliutils.Select("Please select a lot to bid on:", options)
+        if err != nil {
+            return err
+        }
         selectedLot = openLots[selected]
 
     }
@@ -106,12 +109,19 @@ func bidOnLot(c *cli.Context) error {
         maxAmount.Quo(&tmp, eth.EthToWei(1))
 
         // Prompt for maximum amount
-        if cliutils.Confirm(fmt.Sprintf("Would you like to bid the maximum amount of ETH (%.6f ETH)?", math.RoundDown(eth.WeiToEth(&maxAmount), 6))) {
+        bidMaxAmount, err := cliutils.Confirm(fmt.Sprintf("Would you like to bid the maximum amount of ETH (%.6f ETH)?", math.RoundDown(eth.WeiToEth(&maxAmount), 6)))
+        if err != nil {
+            return err
+        }
+        if bidMaxAmount {
             amountWei = &maxAmount
         } else {
 
             // Prompt for custom amount
-            inputAmount := cliutils.Prompt("Please enter an amount of ETH to bid:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            inputAmount, err := cliutils.Prompt("Please enter an amount of ETH to bid:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            if err != nil {
+                return err
+            }
             bidAmount, err := strconv.ParseFloat(inputAmount, 64)
             if err != nil {
                 return fmt.Errorf("Invalid bid amount '%s': %w", inputAmount, err)
@@ -128,26 +138,39 @@ func bidOnLot(c *cli.Context) error {
         return err
     }
     if !canBid.CanBid {
-        fmt.Println("Cannot bid on lot:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canBid)
+        }
+        cliutils.Println("Cannot bid on lot:")
         if canBid.BidOnLotDisabled {
-            fmt.Println("Bidding on lots is currently disabled.")
+            cliutils.Println("Bidding on lots is currently disabled.")
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to bid %.6f ETH on lot %d? Bids are final and non-refundable.", math.RoundDown(eth.WeiToEth(amountWei), 6), selectedLot.Details.Index))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to bid %.6f ETH on lot %d? Bids are final and non-refundable.", math.RoundDown(eth.WeiToEth(amountWei), 6), selectedLot.Details.Index))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Bid on lot
-    if _, err := rp.BidOnLot(selectedLot.Details.Index, amountWei); err != nil {
+    response, err := rp.BidOnLot(selectedLot.Details.Index, amountWei)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully bid %.6f ETH on lot %d.\n", math.RoundDown(eth.WeiToEth(amountWei), 6), selectedLot.Details.Index)
+    cliutils.Printf("Successfully bid %.6f ETH on lot %d.\n", math.RoundDown(eth.WeiToEth(amountWei), 6), selectedLot.Details.Index)
     return nil
 
 }
@@ -37,7 +37,7 @@ func claimFromLot(c *cli.Context) error {
 
     // Check for claimable lots
     if len(claimableLots) == 0 {
-        fmt.Println("No lots are available for RPL claims.")
+        cliutils.Println("No lots are available for RPL claims.")
         return nil
     }
 
@@ -77,7 +77,10 @@ func claimFromLot(c *cli.Context) error {
         for li, lot := range claimableLots {
             options[li + 1] = fmt.Sprintf("lot %d (%.6f ETH bid @ %.6f ETH per RPL)", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.AddressBidAmount), 6), math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
         }
-        selected, _ := cliutils.Select("Please select a lot to claim RPL from:", options)
+        selected, _, err := cliutils.Select("Please select a lot to claim RPL from:", options)
+        if err != nil {
+            return err
+        }
 
         // Get lots
         if selected == 0 {
@@ -89,14 +92,22 @@ func claimFromLot(c *cli.Context) error {
     }
 
     // Claim RPL from lots
+    results := []cliutils.ItemResult{}
     for _, lot := range selectedLots {
-        if _, err := rp.ClaimFromLot(lot.Details.Index); err != nil {
-            fmt.Printf("Could not claim RPL from lot %d: %s.\n", lot.Details.Index, err)
+        response, err := rp.ClaimFromLot(lot.Details.Index)
+        results = append(results, cliutils.NewItemResult(strconv.FormatUint(lot.Details.Index, 10), response, err))
+        if err != nil {
+            cliutils.Printf("Could not claim RPL from lot %d: %s.\n", lot.Details.Index, err)
         } else {
-            fmt.Printf("Successfully claimed RPL from lot %d.\n", lot.Details.Index)
+            cliutils.Printf("Successfully claimed RPL from lot %d.\n", lot.Details.Index)
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
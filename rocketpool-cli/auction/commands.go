@@ -95,6 +95,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidatePositiveEthAmount("bid amount", c.String("amount")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "lot", "amount", "yes"); err != nil { return err }
+
                     // Run
                     return bidOnLot(c)
 
@@ -122,6 +125,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateUint("lot ID", c.String("lot")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "lot"); err != nil { return err }
+
                     // Run
                     return claimFromLot(c)
 
@@ -149,6 +155,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateUint("lot ID", c.String("lot")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "lot"); err != nil { return err }
+
                     // Run
                     return recoverRplFromLot(c)
 
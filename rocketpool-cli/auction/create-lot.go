@@ -1,11 +1,11 @@
 package auction
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,12 +22,15 @@ func createLot(c *cli.Context) error {
         return err
     }
     if !canCreate.CanCreate {
-        fmt.Println("Cannot create lot:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canCreate)
+        }
+        cliutils.Println("Cannot create lot:")
         if canCreate.InsufficientBalance {
-            fmt.Println("The auction contract does not have a sufficient RPL balance to create a lot.")
+            cliutils.Println("The auction contract does not have a sufficient RPL balance to create a lot.")
         }
         if canCreate.CreateLotDisabled {
-            fmt.Println("Lot creation is currently disabled.")
+            cliutils.Println("Lot creation is currently disabled.")
         }
         return nil
     }
@@ -38,8 +41,13 @@ func createLot(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully created a new lot with ID %d.\n", response.LotId)
+    cliutils.Printf("Successfully created a new lot with ID %d.\n", response.LotId)
     return nil
 
 }
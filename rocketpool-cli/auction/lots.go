@@ -1,7 +1,6 @@
 package auction
 
 import (
-    "fmt"
     "math/big"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
@@ -9,6 +8,7 @@ import (
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
     "github.com/rocket-pool/smartnode/shared/types/api"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -26,6 +26,11 @@ func getLots(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, lots)
+    }
+
     // Get lots by status
     openLots := []api.LotDetails{}
     clearedLots := []api.LotDetails{}
@@ -51,7 +56,7 @@ func getLots(c *cli.Context) error {
 
     // Print lot details by status
     if len(lots.Lots) == 0 {
-        fmt.Println("There are no lots for auction yet.")
+        cliutils.Println("There are no lots for auction yet.")
     }
     for status := 0; status < 2; status++ {
 
@@ -68,60 +73,60 @@ func getLots(c *cli.Context) error {
         if len(statusLots) == 0 { continue }
 
         // Print
-        fmt.Printf(statusFormat, len(statusLots))
+        cliutils.Printf(statusFormat, len(statusLots))
         for _, lot := range statusLots {
-            fmt.Printf("--------------------\n")
-            fmt.Printf("\n")
-            fmt.Printf("Lot ID:               %d\n", lot.Details.Index)
-            fmt.Printf("Start block:          %d\n", lot.Details.StartBlock)
-            fmt.Printf("End block:            %d\n", lot.Details.EndBlock)
-            fmt.Printf("RPL starting price:   %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.StartPrice), 6))
-            fmt.Printf("RPL reserve price:    %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.ReservePrice), 6))
-            fmt.Printf("RPL current price:    %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
-            fmt.Printf("Total RPL amount:     %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.TotalRPLAmount), 6))
-            fmt.Printf("Claimed RPL amount:   %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.ClaimedRPLAmount), 6))
-            fmt.Printf("Remaining RPL amount: %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6))
-            fmt.Printf("Total ETH bid:        %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.TotalBidAmount), 6))
-            fmt.Printf("ETH bid by node:      %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.AddressBidAmount), 6))
+            cliutils.Printf("--------------------\n")
+            cliutils.Printf("\n")
+            cliutils.Printf("Lot ID:               %d\n", lot.Details.Index)
+            cliutils.Printf("Start block:          %d\n", lot.Details.StartBlock)
+            cliutils.Printf("End block:            %d\n", lot.Details.EndBlock)
+            cliutils.Printf("RPL starting price:   %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.StartPrice), 6))
+            cliutils.Printf("RPL reserve price:    %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.ReservePrice), 6))
+            cliutils.Printf("RPL current price:    %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
+            cliutils.Printf("Total RPL amount:     %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.TotalRPLAmount), 6))
+            cliutils.Printf("Claimed RPL amount:   %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.ClaimedRPLAmount), 6))
+            cliutils.Printf("Remaining RPL amount: %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6))
+            cliutils.Printf("Total ETH bid:        %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.TotalBidAmount), 6))
+            cliutils.Printf("ETH bid by node:      %.6f\n", math.RoundDown(eth.WeiToEth(lot.Details.AddressBidAmount), 6))
             if lot.Details.Cleared {
-            fmt.Printf("Cleared:              yes\n")
+            cliutils.Printf("Cleared:              yes\n")
                 if lot.Details.RemainingRPLAmount.Cmp(big.NewInt(0)) == 0 {
-            fmt.Printf("Unclaimed RPL:        no\n")
+            cliutils.Printf("Unclaimed RPL:        no\n")
                 } else if lot.Details.RPLRecovered {
-            fmt.Printf("Unclaimed RPL:        recovered\n")
+            cliutils.Printf("Unclaimed RPL:        recovered\n")
                 } else {
-            fmt.Printf("Unclaimed RPL:        yes\n")
+            cliutils.Printf("Unclaimed RPL:        yes\n")
                 }
             } else {
-            fmt.Printf("Cleared:              no\n")
+            cliutils.Printf("Cleared:              no\n")
             }
-            fmt.Printf("\n")
+            cliutils.Printf("\n")
         }
-        fmt.Println("")
+        cliutils.Println("")
 
     }
 
     // Print actionable lot details
     if len(claimableLots) > 0 {
-        fmt.Printf("%d lot(s) you have bid on have RPL available to claim:\n", len(claimableLots))
+        cliutils.Printf("%d lot(s) you have bid on have RPL available to claim:\n", len(claimableLots))
         for _, lot := range claimableLots {
-            fmt.Printf("- lot %d (%.6f ETH bid @ %.6f ETH per RPL)\n", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.AddressBidAmount), 6), math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
+            cliutils.Printf("- lot %d (%.6f ETH bid @ %.6f ETH per RPL)\n", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.AddressBidAmount), 6), math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
         }
-        fmt.Println("")
+        cliutils.Println("")
     }
     if len(biddableLots) > 0 {
-        fmt.Printf("%d lot(s) are open for bidding:\n", len(biddableLots))
+        cliutils.Printf("%d lot(s) are open for bidding:\n", len(biddableLots))
         for _, lot := range biddableLots {
-            fmt.Printf("- lot %d (%.6f RPL available @ %.6f ETH per RPL)\n", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6), math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
+            cliutils.Printf("- lot %d (%.6f RPL available @ %.6f ETH per RPL)\n", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6), math.RoundDown(eth.WeiToEth(lot.Details.CurrentPrice), 6))
         }
-        fmt.Println("")
+        cliutils.Println("")
     }
     if len(recoverableLots) > 0 {
-        fmt.Printf("%d lot(s) have unclaimed RPL ready to recover:\n", len(recoverableLots))
+        cliutils.Printf("%d lot(s) have unclaimed RPL ready to recover:\n", len(recoverableLots))
         for _, lot := range recoverableLots {
-            fmt.Printf("- lot %d (%.6f RPL unclaimed)\n", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6))
+            cliutils.Printf("- lot %d (%.6f RPL unclaimed)\n", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6))
         }
-        fmt.Println("")
+        cliutils.Println("")
     }
 
     // Return
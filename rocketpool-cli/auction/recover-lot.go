@@ -37,7 +37,7 @@ func recoverRplFromLot(c *cli.Context) error {
 
     // Check for recoverable lots
     if len(recoverableLots) == 0 {
-        fmt.Println("No lots are available for RPL recovery.")
+        cliutils.Println("No lots are available for RPL recovery.")
         return nil
     }
 
@@ -77,7 +77,10 @@ func recoverRplFromLot(c *cli.Context) error {
         for li, lot := range recoverableLots {
             options[li + 1] = fmt.Sprintf("lot %d (%.6f RPL unclaimed)", lot.Details.Index, math.RoundDown(eth.WeiToEth(lot.Details.RemainingRPLAmount), 6))
         }
-        selected, _ := cliutils.Select("Please select a lot to recover unclaimed RPL from:", options)
+        selected, _, err := cliutils.Select("Please select a lot to recover unclaimed RPL from:", options)
+        if err != nil {
+            return err
+        }
 
         // Get lots
         if selected == 0 {
@@ -89,14 +92,22 @@ func recoverRplFromLot(c *cli.Context) error {
     }
 
     // Claim RPL from lots
+    results := []cliutils.ItemResult{}
     for _, lot := range selectedLots {
-        if _, err := rp.RecoverUnclaimedRPLFromLot(lot.Details.Index); err != nil {
-            fmt.Printf("Could not recover unclaimed RPL from lot %d: %s.\n", lot.Details.Index, err)
+        response, err := rp.RecoverUnclaimedRPLFromLot(lot.Details.Index)
+        results = append(results, cliutils.NewItemResult(strconv.FormatUint(lot.Details.Index, 10), response, err))
+        if err != nil {
+            cliutils.Printf("Could not recover unclaimed RPL from lot %d: %s.\n", lot.Details.Index, err)
         } else {
-            fmt.Printf("Successfully recovered unclaimed RPL from lot %d.\n", lot.Details.Index)
+            cliutils.Printf("Successfully recovered unclaimed RPL from lot %d.\n", lot.Details.Index)
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
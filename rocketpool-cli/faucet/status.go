@@ -1,13 +1,13 @@
 package faucet
 
 import (
-    "fmt"
     "math/big"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -25,14 +25,19 @@ func getStatus(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, status)
+    }
+
     // Print status & return
-    fmt.Printf("The faucet has a balance of %.6f RPL.\n", math.RoundDown(eth.WeiToEth(status.Balance), 6))
+    cliutils.Printf("The faucet has a balance of %.6f RPL.\n", math.RoundDown(eth.WeiToEth(status.Balance), 6))
     if status.WithdrawableAmount.Cmp(big.NewInt(0)) > 0 {
-        fmt.Printf("You can withdraw %.6f RPL (requires a %.6f GoETH fee)!\n", math.RoundDown(eth.WeiToEth(status.WithdrawableAmount), 6), math.RoundDown(eth.WeiToEth(status.WithdrawalFee), 6))
+        cliutils.Printf("You can withdraw %.6f RPL (requires a %.6f GoETH fee)!\n", math.RoundDown(eth.WeiToEth(status.WithdrawableAmount), 6), math.RoundDown(eth.WeiToEth(status.WithdrawalFee), 6))
     } else {
-        fmt.Println("You cannot withdraw RPL right now.")
+        cliutils.Println("You cannot withdraw RPL right now.")
     }
-    fmt.Printf("Allowances reset in %d blocks.\n", status.ResetsInBlocks)
+    cliutils.Printf("Allowances reset in %d blocks.\n", status.ResetsInBlocks)
     return nil
 
 }
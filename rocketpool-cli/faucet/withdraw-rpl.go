@@ -1,12 +1,12 @@
 package faucet
 
 import (
-    "fmt"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -24,15 +24,18 @@ func withdrawRpl(c *cli.Context) error {
         return err
     }
     if !canWithdraw.CanWithdraw {
-        fmt.Println("Cannot withdraw RPL from the faucet:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canWithdraw)
+        }
+        cliutils.Println("Cannot withdraw RPL from the faucet:")
         if canWithdraw.InsufficientFaucetBalance {
-            fmt.Println("The faucet does not have any RPL for withdrawal")
+            cliutils.Println("The faucet does not have any RPL for withdrawal")
         }
         if canWithdraw.InsufficientAllowance {
-            fmt.Println("You don't have any allowance remaining for the withdrawal period")
+            cliutils.Println("You don't have any allowance remaining for the withdrawal period")
         }
         if canWithdraw.InsufficientNodeBalance {
-            fmt.Println("You don't have enough GoETH to pay the faucet withdrawal fee")
+            cliutils.Println("You don't have enough GoETH to pay the faucet withdrawal fee")
         }
         return nil
     }
@@ -43,8 +46,13 @@ func withdrawRpl(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully withdrew %.6f RPL from the faucet.\n", math.RoundDown(eth.WeiToEth(response.Amount), 6))
+    cliutils.Printf("Successfully withdrew %.6f RPL from the faucet.\n", math.RoundDown(eth.WeiToEth(response.Amount), 6))
     return nil
 
 }
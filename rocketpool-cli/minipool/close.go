@@ -38,7 +38,7 @@ func closeMinipools(c *cli.Context) error {
 
     // Check for closable minipools
     if len(closableMinipools) == 0 {
-        fmt.Println("No minipools can be closed.")
+        cliutils.Println("No minipools can be closed.")
         return nil
     }
 
@@ -52,7 +52,10 @@ func closeMinipools(c *cli.Context) error {
         for mi, minipool := range closableMinipools {
             options[mi + 1] = fmt.Sprintf("%s (%.6f ETH to claim)", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
         }
-        selected, _ := cliutils.Select("Please select a minipool to close:", options)
+        selected, _, err := cliutils.Select("Please select a minipool to close:", options)
+        if err != nil {
+            return err
+        }
 
         // Get minipools
         if selected == 0 {
@@ -82,14 +85,22 @@ func closeMinipools(c *cli.Context) error {
     }
 
     // Close minipools
+    results := []cliutils.ItemResult{}
     for _, minipool := range selectedMinipools {
-        if _, err := rp.CloseMinipool(minipool.Address); err != nil {
-            fmt.Printf("Could not close minipool %s: %s.\n", minipool.Address.Hex(), err)
+        response, err := rp.CloseMinipool(minipool.Address)
+        results = append(results, cliutils.NewItemResult(minipool.Address.Hex(), response, err))
+        if err != nil {
+            cliutils.Printf("Could not close minipool %s: %s.\n", minipool.Address.Hex(), err)
         } else {
-            fmt.Printf("Successfully closed minipool %s.\n", minipool.Address.Hex())
+            cliutils.Printf("Successfully closed minipool %s.\n", minipool.Address.Hex())
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
@@ -31,6 +31,32 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                 },
             },
 
+            cli.Command{
+                Name:      "performance",
+                Aliases:   []string{"p"},
+                Usage:     "Get the performance of the node's minipool validators over an epoch range",
+                UsageText: "rocketpool minipool performance [options]",
+                Flags: []cli.Flag{
+                    cli.Uint64Flag{
+                        Name:  "start-epoch, s",
+                        Usage: "The first epoch to report on (defaults to 10 epochs before the end epoch)",
+                    },
+                    cli.Uint64Flag{
+                        Name:  "end-epoch, e",
+                        Usage: "The last epoch to report on (defaults to the latest complete epoch)",
+                    },
+                },
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                    // Run
+                    return getPerformance(c)
+
+                },
+            },
+
             cli.Command{
                 Name:      "refund",
                 Aliases:   []string{"r"},
@@ -52,6 +78,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "minipool"); err != nil { return err }
+
                     // Run
                     return refundMinipools(c)
 
@@ -83,6 +112,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "minipool", "yes"); err != nil { return err }
+
                     // Run
                     return dissolveMinipools(c)
 
@@ -114,6 +146,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "minipool", "yes"); err != nil { return err }
+
                     // Run
                     return exitMinipools(c)
 
@@ -141,6 +176,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "minipool"); err != nil { return err }
+
                     // Run
                     return withdrawMinipools(c)
 
@@ -168,6 +206,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "minipool"); err != nil { return err }
+
                     // Run
                     return closeMinipools(c)
 
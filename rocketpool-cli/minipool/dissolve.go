@@ -16,6 +16,13 @@ import (
 )
 
 
+// Dissolve & close responses for a minipool
+type dissolveResult struct {
+    Dissolve api.DissolveMinipoolResponse   `json:"dissolve"`
+    Close api.CloseMinipoolResponse         `json:"close"`
+}
+
+
 func dissolveMinipools(c *cli.Context) error {
 
     // Get RP client
@@ -39,7 +46,7 @@ func dissolveMinipools(c *cli.Context) error {
 
     // Check for initialized minipools
     if len(initializedMinipools) == 0 {
-        fmt.Println("No minipools can be dissolved.")
+        cliutils.Println("No minipools can be dissolved.")
         return nil
     }
 
@@ -53,7 +60,10 @@ func dissolveMinipools(c *cli.Context) error {
         for mi, minipool := range initializedMinipools {
             options[mi + 1] = fmt.Sprintf("%s (%.6f ETH deposited)", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
         }
-        selected, _ := cliutils.Select("Please select a minipool to dissolve:", options)
+        selected, _, err := cliutils.Select("Please select a minipool to dissolve:", options)
+        if err != nil {
+            return err
+        }
 
         // Get minipools
         if selected == 0 {
@@ -83,26 +93,42 @@ func dissolveMinipools(c *cli.Context) error {
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to dissolve %d minipool(s)? This action cannot be undone!", len(selectedMinipools)))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to dissolve %d minipool(s)? This action cannot be undone!", len(selectedMinipools)))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Dissolve and close minipools
+    results := []cliutils.ItemResult{}
     for _, minipool := range selectedMinipools {
-        if _, err := rp.DissolveMinipool(minipool.Address); err != nil {
-            fmt.Printf("Could not dissolve minipool %s: %s.\n", minipool.Address.Hex(), err)
+        var result dissolveResult
+        result.Dissolve, err = rp.DissolveMinipool(minipool.Address)
+        if err != nil {
+            results = append(results, cliutils.NewItemResult(minipool.Address.Hex(), nil, err))
+            cliutils.Printf("Could not dissolve minipool %s: %s.\n", minipool.Address.Hex(), err)
             continue
         } else {
-            fmt.Printf("Successfully dissolved minipool %s.\n", minipool.Address.Hex())
+            cliutils.Printf("Successfully dissolved minipool %s.\n", minipool.Address.Hex())
         }
-        if _, err := rp.CloseMinipool(minipool.Address); err != nil {
-            fmt.Printf("Could not close minipool %s: %s.\n", minipool.Address.Hex(), err)
+        result.Close, err = rp.CloseMinipool(minipool.Address)
+        if err != nil {
+            results = append(results, cliutils.ItemResult{Item: minipool.Address.Hex(), Response: result, Error: err.Error()})
+            cliutils.Printf("Could not close minipool %s: %s.\n", minipool.Address.Hex(), err)
         } else {
-            fmt.Printf("Successfully closed minipool %s.\n", minipool.Address.Hex())
+            results = append(results, cliutils.NewItemResult(minipool.Address.Hex(), result, nil))
+            cliutils.Printf("Successfully closed minipool %s.\n", minipool.Address.Hex())
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
@@ -37,7 +37,7 @@ func exitMinipools(c *cli.Context) error {
 
     // Check for active minipools
     if len(activeMinipools) == 0 {
-        fmt.Println("No minipools can be exited.")
+        cliutils.Println("No minipools can be exited.")
         return nil
     }
 
@@ -51,7 +51,10 @@ func exitMinipools(c *cli.Context) error {
         for mi, minipool := range activeMinipools {
             options[mi + 1] = fmt.Sprintf("%s (staking since %s)", minipool.Address.Hex(), minipool.Status.StatusTime.Format(TimeFormat))
         }
-        selected, _ := cliutils.Select("Please select a minipool to exit:", options)
+        selected, _, err := cliutils.Select("Please select a minipool to exit:", options)
+        if err != nil {
+            return err
+        }
 
         // Get minipools
         if selected == 0 {
@@ -81,21 +84,33 @@ func exitMinipools(c *cli.Context) error {
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to exit %d minipool(s)? This action cannot be undone!", len(selectedMinipools)))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to exit %d minipool(s)? This action cannot be undone!", len(selectedMinipools)))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Exit minipools
+    results := []cliutils.ItemResult{}
     for _, minipool := range selectedMinipools {
-        if _, err := rp.ExitMinipool(minipool.Address); err != nil {
-            fmt.Printf("Could not exit minipool %s: %s.\n", minipool.Address.Hex(), err)
+        response, err := rp.ExitMinipool(minipool.Address)
+        results = append(results, cliutils.NewItemResult(minipool.Address.Hex(), response, err))
+        if err != nil {
+            cliutils.Printf("Could not exit minipool %s: %s.\n", minipool.Address.Hex(), err)
         } else {
-            fmt.Printf("Successfully exited minipool %s.\n", minipool.Address.Hex())
-            fmt.Println("It may take several hours for your minipool's status to be reflected.")
+            cliutils.Printf("Successfully exited minipool %s.\n", minipool.Address.Hex())
+            cliutils.Println("It may take several hours for your minipool's status to be reflected.")
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
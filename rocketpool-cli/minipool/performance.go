@@ -0,0 +1,56 @@
+package minipool
+
+import (
+
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+    "github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+
+func getPerformance(c *cli.Context) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Get minipool performance
+    performance, err := rp.MinipoolPerformance(c.Uint64("start-epoch"), c.Uint64("end-epoch"))
+    if err != nil {
+        return err
+    }
+
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, performance)
+    }
+
+    // Check for active minipools
+    if len(performance.Minipools) == 0 {
+        cliutils.Printf("The node does not have any active minipool validators between epochs %d and %d.\n", performance.StartEpoch, performance.EndEpoch)
+        return nil
+    }
+
+    // Print minipool performance
+    cliutils.Printf("Minipool performance from epoch %d to %d:\n\n", performance.StartEpoch, performance.EndEpoch)
+    for _, minipool := range performance.Minipools {
+        cliutils.Printf("--------------------\n")
+        cliutils.Printf("\n")
+        cliutils.Printf("Address:              %s\n", minipool.Address.Hex())
+        cliutils.Printf("Validator index:      %d\n", minipool.ValidatorIndex)
+        cliutils.Printf("Attestations:         %d of %d included (%d missed)\n", minipool.AttestationsExpected - minipool.AttestationsMissed, minipool.AttestationsExpected, minipool.AttestationsMissed)
+        cliutils.Printf("Blocks:               %d proposed, %d missed\n", minipool.BlocksProposed, minipool.BlocksMissed)
+        cliutils.Printf("Start balance:        %.6f ETH\n", math.RoundDown(eth.WeiToEth(eth.GweiToWei(float64(minipool.StartBalance))), 6))
+        cliutils.Printf("End balance:          %.6f ETH\n", math.RoundDown(eth.WeiToEth(eth.GweiToWei(float64(minipool.EndBalance))), 6))
+        cliutils.Printf("APR:                  %.2f%%\n", minipool.Apr * 100)
+        cliutils.Printf("\n")
+    }
+
+    // Return
+    return nil
+
+}
@@ -38,7 +38,7 @@ func refundMinipools(c *cli.Context) error {
 
     // Check for refundable minipools
     if len(refundableMinipools) == 0 {
-        fmt.Println("No minipools have refunds available.")
+        cliutils.Println("No minipools have refunds available.")
         return nil
     }
 
@@ -52,7 +52,10 @@ func refundMinipools(c *cli.Context) error {
         for mi, minipool := range refundableMinipools {
             options[mi + 1] = fmt.Sprintf("%s (%.6f ETH to claim)", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Node.RefundBalance), 6))
         }
-        selected, _ := cliutils.Select("Please select a minipool to refund ETH from:", options)
+        selected, _, err := cliutils.Select("Please select a minipool to refund ETH from:", options)
+        if err != nil {
+            return err
+        }
 
         // Get minipools
         if selected == 0 {
@@ -82,14 +85,22 @@ func refundMinipools(c *cli.Context) error {
     }
 
     // Refund minipools
+    results := []cliutils.ItemResult{}
     for _, minipool := range selectedMinipools {
-        if _, err := rp.RefundMinipool(minipool.Address); err != nil {
-            fmt.Printf("Could not refund ETH from minipool %s: %s.\n", minipool.Address.Hex(), err)
+        response, err := rp.RefundMinipool(minipool.Address)
+        results = append(results, cliutils.NewItemResult(minipool.Address.Hex(), response, err))
+        if err != nil {
+            cliutils.Printf("Could not refund ETH from minipool %s: %s.\n", minipool.Address.Hex(), err)
         } else {
-            fmt.Printf("Successfully refunded ETH from minipool %s.\n", minipool.Address.Hex())
+            cliutils.Printf("Successfully refunded ETH from minipool %s.\n", minipool.Address.Hex())
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
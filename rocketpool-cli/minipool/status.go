@@ -1,7 +1,6 @@
 package minipool
 
 import (
-    "fmt"
 
     "github.com/rocket-pool/rocketpool-go/types"
     "github.com/rocket-pool/rocketpool-go/utils/eth"
@@ -9,6 +8,7 @@ import (
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
     "github.com/rocket-pool/smartnode/shared/types/api"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/hex"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
@@ -27,6 +27,11 @@ func getStatus(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, status)
+    }
+
     // Get minipools by status
     statusMinipools := map[string][]api.MinipoolDetails{}
     refundableMinipools := []api.MinipoolDetails{}
@@ -56,96 +61,96 @@ func getStatus(c *cli.Context) error {
 
     // Print minipool details by status
     if len(status.Minipools) == 0 {
-        fmt.Println("The node does not have any minipools yet.")
+        cliutils.Println("The node does not have any minipools yet.")
     }
     for _, statusName := range types.MinipoolStatuses {
         minipools, ok := statusMinipools[statusName]
         if !ok { continue }
 
         // Minipool status count & description
-        fmt.Printf("%d %s minipool(s):\n", len(minipools), statusName)
+        cliutils.Printf("%d %s minipool(s):\n", len(minipools), statusName)
         if statusName == "Withdrawable" {
-            fmt.Println("(Withdrawal may not be available until after withdrawal delay)")
+            cliutils.Println("(Withdrawal may not be available until after withdrawal delay)")
         }
-        fmt.Println("")
+        cliutils.Println("")
 
         // Minipools
         for _, minipool := range minipools {
-            fmt.Printf("--------------------\n")
-            fmt.Printf("\n")
+            cliutils.Printf("--------------------\n")
+            cliutils.Printf("\n")
 
             // Main details
-            fmt.Printf("Address:              %s\n", minipool.Address.Hex())
-            fmt.Printf("Status updated:       %s\n", minipool.Status.StatusTime.Format(TimeFormat))
-            fmt.Printf("Node fee:             %f%%\n", minipool.Node.Fee * 100)
-            fmt.Printf("Node deposit:         %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
+            cliutils.Printf("Address:              %s\n", minipool.Address.Hex())
+            cliutils.Printf("Status updated:       %s\n", minipool.Status.StatusTime.Format(TimeFormat))
+            cliutils.Printf("Node fee:             %f%%\n", minipool.Node.Fee * 100)
+            cliutils.Printf("Node deposit:         %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
 
             // RP ETH deposit details - prelaunch & staking minipools
             if minipool.Status.Status == types.Prelaunch || minipool.Status.Status == types.Staking {
                 if minipool.User.DepositAssigned {
-            fmt.Printf("RP ETH assigned:      %s\n", minipool.User.DepositAssignedTime.Format(TimeFormat))
-            fmt.Printf("RP deposit:           %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.User.DepositBalance), 6))
+            cliutils.Printf("RP ETH assigned:      %s\n", minipool.User.DepositAssignedTime.Format(TimeFormat))
+            cliutils.Printf("RP deposit:           %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.User.DepositBalance), 6))
                 } else {
-            fmt.Printf("RP ETH assigned:      no\n")
+            cliutils.Printf("RP ETH assigned:      no\n")
                 }
             }
 
             // Validator details - staking minipools
             if minipool.Status.Status == types.Staking {
-            fmt.Printf("Validator pubkey:     %s\n", hex.AddPrefix(minipool.ValidatorPubkey.Hex()))
-            fmt.Printf("Validator index:      %d\n", minipool.Validator.Index)
+            cliutils.Printf("Validator pubkey:     %s\n", hex.AddPrefix(minipool.ValidatorPubkey.Hex()))
+            cliutils.Printf("Validator index:      %d\n", minipool.Validator.Index)
                 if minipool.Validator.Exists {
                     if minipool.Validator.Active {
-            fmt.Printf("Validator active:     yes\n")
+            cliutils.Printf("Validator active:     yes\n")
                     } else {
-            fmt.Printf("Validator active:     no\n")
+            cliutils.Printf("Validator active:     no\n")
                     }
-            fmt.Printf("Validator balance:    %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Validator.Balance), 6))
-            fmt.Printf("Expected rewards:     %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Validator.NodeBalance), 6))
+            cliutils.Printf("Validator balance:    %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Validator.Balance), 6))
+            cliutils.Printf("Expected rewards:     %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Validator.NodeBalance), 6))
                 } else {
-            fmt.Printf("Validator seen:       no\n")
+            cliutils.Printf("Validator seen:       no\n")
                 }
             }
 
             // Withdrawal details - withdrawable minipools
             if minipool.Status.Status == types.Withdrawable {
-            fmt.Printf("Final balance:        %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Staking.EndBalance), 6))
+            cliutils.Printf("Final balance:        %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Staking.EndBalance), 6))
                 if minipool.WithdrawalAvailable {
-            fmt.Printf("Withdrawal available: yes\n")
+            cliutils.Printf("Withdrawal available: yes\n")
                 } else if minipool.AlreadyWithdrawn {
-            fmt.Printf("Withdrawal available: no (already withdrawn)\n")
+            cliutils.Printf("Withdrawal available: no (already withdrawn)\n")
                 } else {
-            fmt.Printf("Withdrawal available: in %d blocks\n", minipool.WithdrawalAvailableInBlocks)
+            cliutils.Printf("Withdrawal available: in %d blocks\n", minipool.WithdrawalAvailableInBlocks)
                 }
             }
 
-            fmt.Printf("\n")
+            cliutils.Printf("\n")
         }
 
-        fmt.Println("")
+        cliutils.Println("")
     }
 
     // Print actionable minipool details
     if len(refundableMinipools) > 0 {
-        fmt.Printf("%d minipool(s) have refunds available:\n", len(refundableMinipools))
+        cliutils.Printf("%d minipool(s) have refunds available:\n", len(refundableMinipools))
         for _, minipool := range refundableMinipools {
-            fmt.Printf("- %s (%.6f ETH to claim)\n", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Node.RefundBalance), 6))
+            cliutils.Printf("- %s (%.6f ETH to claim)\n", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Node.RefundBalance), 6))
         }
-        fmt.Println("")
+        cliutils.Println("")
     }
     if len(withdrawableMinipools) > 0 {
-        fmt.Printf("%d minipool(s) are ready for withdrawal:\n", len(withdrawableMinipools))
+        cliutils.Printf("%d minipool(s) are ready for withdrawal:\n", len(withdrawableMinipools))
         for _, minipool := range withdrawableMinipools {
-            fmt.Printf("- %s (%.6f nETH to claim)\n", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Balances.NETH), 6))
+            cliutils.Printf("- %s (%.6f nETH to claim)\n", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Balances.NETH), 6))
         }
-        fmt.Println("")
+        cliutils.Println("")
     }
     if len(closeableMinipools) > 0 {
-        fmt.Printf("%d dissolved minipool(s) can be closed:\n", len(closeableMinipools))
+        cliutils.Printf("%d dissolved minipool(s) can be closed:\n", len(closeableMinipools))
         for _, minipool := range closeableMinipools {
-            fmt.Printf("- %s (%.6f ETH to claim)\n", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
+            cliutils.Printf("- %s (%.6f ETH to claim)\n", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
         }
-        fmt.Println("")
+        cliutils.Println("")
     }
 
     // Return
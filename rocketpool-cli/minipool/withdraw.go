@@ -38,7 +38,7 @@ func withdrawMinipools(c *cli.Context) error {
 
     // Check for withdrawable minipools
     if len(withdrawableMinipools) == 0 {
-        fmt.Println("No minipools can be withdrawn from.")
+        cliutils.Println("No minipools can be withdrawn from.")
         return nil
     }
 
@@ -52,7 +52,10 @@ func withdrawMinipools(c *cli.Context) error {
         for mi, minipool := range withdrawableMinipools {
             options[mi + 1] = fmt.Sprintf("%s (%.6f nETH to claim)", minipool.Address.Hex(), math.RoundDown(eth.WeiToEth(minipool.Balances.NETH), 6))
         }
-        selected, _ := cliutils.Select("Please select a minipool to withdraw from:", options)
+        selected, _, err := cliutils.Select("Please select a minipool to withdraw from:", options)
+        if err != nil {
+            return err
+        }
 
         // Get minipools
         if selected == 0 {
@@ -82,14 +85,22 @@ func withdrawMinipools(c *cli.Context) error {
     }
 
     // Withdraw minipools
+    results := []cliutils.ItemResult{}
     for _, minipool := range selectedMinipools {
-        if _, err := rp.WithdrawMinipool(minipool.Address); err != nil {
-            fmt.Printf("Could not withdraw from minipool %s: %s.\n", minipool.Address.Hex(), err)
+        response, err := rp.WithdrawMinipool(minipool.Address)
+        results = append(results, cliutils.NewItemResult(minipool.Address.Hex(), response, err))
+        if err != nil {
+            cliutils.Printf("Could not withdraw from minipool %s: %s.\n", minipool.Address.Hex(), err)
         } else {
-            fmt.Printf("Successfully withdrew from minipool %s.\n", minipool.Address.Hex())
+            cliutils.Printf("Successfully withdrew from minipool %s.\n", minipool.Address.Hex())
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
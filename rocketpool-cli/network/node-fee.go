@@ -1,11 +1,11 @@
 package network
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,11 +22,16 @@ func getNodeFee(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Print & return
-    fmt.Printf("The current network node commission rate is %f%%.\n", response.NodeFee * 100)
-    fmt.Printf("Minimum node commission rate: %f%%\n", response.MinNodeFee * 100)
-    fmt.Printf("Target node commission rate:  %f%%\n", response.TargetNodeFee * 100)
-    fmt.Printf("Maximum node commission rate: %f%%\n", response.MaxNodeFee * 100)
+    cliutils.Printf("The current network node commission rate is %f%%.\n", response.NodeFee * 100)
+    cliutils.Printf("Minimum node commission rate: %f%%\n", response.MinNodeFee * 100)
+    cliutils.Printf("Target node commission rate:  %f%%\n", response.TargetNodeFee * 100)
+    cliutils.Printf("Maximum node commission rate: %f%%\n", response.MaxNodeFee * 100)
     return nil
 
 }
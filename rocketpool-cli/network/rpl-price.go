@@ -1,12 +1,12 @@
 package network
 
 import (
-    "fmt"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -24,9 +24,14 @@ func getRplPrice(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Print & return
-    fmt.Printf("The current network RPL price is %.6f ETH.\n", math.RoundDown(eth.WeiToEth(response.RplPrice), 6))
-    fmt.Printf("Prices last updated at block: %d\n", response.RplPriceBlock)
+    cliutils.Printf("The current network RPL price is %.6f ETH.\n", math.RoundDown(eth.WeiToEth(response.RplPrice), 6))
+    cliutils.Printf("Prices last updated at block: %d\n", response.RplPriceBlock)
     return nil
 
 }
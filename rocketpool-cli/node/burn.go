@@ -1,12 +1,12 @@
 package node
 
 import (
-    "fmt"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -27,23 +27,32 @@ func nodeBurn(c *cli.Context, amount float64, token string) error {
         return err
     }
     if !canBurn.CanBurn {
-        fmt.Println("Cannot burn tokens:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canBurn)
+        }
+        cliutils.Println("Cannot burn tokens:")
         if canBurn.InsufficientBalance {
-            fmt.Printf("The node's %s balance is insufficient.\n", token)
+            cliutils.Printf("The node's %s balance is insufficient.\n", token)
         }
         if canBurn.InsufficientCollateral {
-            fmt.Printf("There is insufficient ETH collateral to trade %s for.\n", token)
+            cliutils.Printf("There is insufficient ETH collateral to trade %s for.\n", token)
         }
         return nil
     }
 
     // Burn tokens
-    if _, err := rp.NodeBurn(amountWei, token); err != nil {
+    response, err := rp.NodeBurn(amountWei, token)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully burned %.6f %s for ETH.\n", math.RoundDown(eth.WeiToEth(amountWei), 6), token)
+    cliutils.Printf("Successfully burned %.6f %s for ETH.\n", math.RoundDown(eth.WeiToEth(amountWei), 6), token)
     return nil
 
 }
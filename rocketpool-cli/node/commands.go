@@ -31,6 +31,62 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                 },
             },
 
+            cli.Command{
+                Name:      "pending-txs",
+                Usage:     "Get the node's pending and recently mined transactions",
+                UsageText: "rocketpool node pending-txs",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                    // Run
+                    return getPendingTxs(c)
+
+                },
+            },
+
+            cli.Command{
+                Name:      "rewards-report",
+                Usage:     "Export a report of the node's minipool rewards, RPL rewards and ETH withdrawals over a date range",
+                UsageText: "rocketpool node rewards-report [options]",
+                Flags: []cli.Flag{
+                    cli.StringFlag{
+                        Name:  "start, s",
+                        Usage: "The first date to report on (in the format 'YYYY-MM-DD')",
+                    },
+                    cli.StringFlag{
+                        Name:  "end, e",
+                        Usage: "The last date to report on (in the format 'YYYY-MM-DD'; defaults to now)",
+                    },
+                    cli.StringFlag{
+                        Name:  "format, t",
+                        Usage: "The report format ('csv' or 'json')",
+                        Value: "csv",
+                    },
+                    cli.StringFlag{
+                        Name:  "file, f",
+                        Usage: "The file to save the report to (defaults to printing the report)",
+                    },
+                },
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                    // Validate flags
+                    if _, err := cliutils.ValidateDate("start date", c.String("start")); err != nil { return err }
+                    if c.String("end") != "" {
+                        if _, err := cliutils.ValidateDate("end date", c.String("end")); err != nil { return err }
+                    }
+                    if _, err := cliutils.ValidateReportFormat("report format", c.String("format")); err != nil { return err }
+
+                    // Run
+                    return getRewardsReport(c)
+
+                },
+            },
+
             cli.Command{
                 Name:      "register",
                 Aliases:   []string{"r"},
@@ -52,6 +108,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateTimezoneLocation("timezone location", c.String("timezone")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "timezone"); err != nil { return err }
+
                     // Run
                     return registerNode(c)
 
@@ -76,6 +135,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     withdrawalAddress, err := cliutils.ValidateAddress("withdrawal address", c.Args().Get(0))
                     if err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run
                     return setWithdrawalAddress(c, withdrawalAddress)
 
@@ -103,6 +165,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateTimezoneLocation("timezone location", c.String("timezone")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "timezone"); err != nil { return err }
+
                     // Run
                     return setTimezoneLocation(c)
 
@@ -130,6 +195,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidatePositiveEthAmount("swap amount", c.String("amount")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "amount"); err != nil { return err }
+
                     // Run
                     return nodeSwapRpl(c)
 
@@ -165,6 +233,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidatePositiveEthAmount("stake amount", c.String("amount")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "amount", "yes"); err != nil { return err }
+
                     // Run
                     return nodeStakeRpl(c)
 
@@ -196,6 +267,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidatePositiveEthAmount("withdrawal amount", c.String("amount")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "amount", "yes"); err != nil { return err }
+
                     // Run
                     return nodeWithdrawRpl(c)
 
@@ -234,6 +308,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidatePercentage("maximum commission rate slippage", c.String("max-slippage")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "amount", "max-slippage", "yes"); err != nil { return err }
+
                     // Run
                     return nodeDeposit(c)
 
@@ -262,6 +339,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     toAddress, err := cliutils.ValidateAddress("to address", c.Args().Get(2))
                     if err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run
                     return nodeSend(c, amount, token, toAddress)
 
@@ -53,7 +53,10 @@ func nodeDeposit(c *cli.Context) error {
         }
 
         // Prompt for amount
-        selected, _ := cliutils.Select("Please choose an amount of ETH to deposit:", amountOptions)
+        selected, _, err := cliutils.Select("Please choose an amount of ETH to deposit:", amountOptions)
+        if err != nil {
+            return err
+        }
         switch selected {
             case 0: amount = 32
             case 1: amount = 16
@@ -69,21 +72,24 @@ func nodeDeposit(c *cli.Context) error {
         return err
     }
     if !canDeposit.CanDeposit {
-        fmt.Println("Cannot make node deposit:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canDeposit)
+        }
+        cliutils.Println("Cannot make node deposit:")
         if canDeposit.InsufficientBalance {
-            fmt.Println("The node's ETH balance is insufficient.")
+            cliutils.Println("The node's ETH balance is insufficient.")
         }
         if canDeposit.InsufficientRplStake {
-            fmt.Println("The node has not staked enough RPL to collateralize a new minipool.")
+            cliutils.Println("The node has not staked enough RPL to collateralize a new minipool.")
         }
         if canDeposit.InvalidAmount {
-            fmt.Println("The deposit amount is invalid.")
+            cliutils.Println("The deposit amount is invalid.")
         }
         if canDeposit.UnbondedMinipoolsAtMax {
-            fmt.Println("The node cannot create any more unbonded minipools.")
+            cliutils.Println("The node cannot create any more unbonded minipools.")
         }
         if canDeposit.DepositDisabled {
-            fmt.Println("Node deposits are currently disabled.")
+            cliutils.Println("Node deposits are currently disabled.")
         }
         return nil
     }
@@ -118,16 +124,23 @@ func nodeDeposit(c *cli.Context) error {
     } else {
 
         // Prompt for min node fee
-        minNodeFee = promptMinNodeFee(nodeFees.NodeFee, nodeFees.MinNodeFee)
+        minNodeFee, err = promptMinNodeFee(nodeFees.NodeFee, nodeFees.MinNodeFee)
+        if err != nil {
+            return err
+        }
 
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf(
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf(
         "Are you sure you want to deposit %.6f ETH to create a minipool with a minimum possible commission rate of %f%%? Running a minipool is a long-term commitment.",
         math.RoundDown(eth.WeiToEth(amountWei), 6),
-        minNodeFee * 100))) {
-            fmt.Println("Cancelled.")
+        minNodeFee * 100))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+            cliutils.Println("Cancelled.")
             return nil
     }
 
@@ -137,9 +150,14 @@ func nodeDeposit(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("The node deposit of %.6f ETH was made successfully.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
-    fmt.Printf("A new minipool was created at %s.\n", response.MinipoolAddress.Hex())
+    cliutils.Printf("The node deposit of %.6f ETH was made successfully.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
+    cliutils.Printf("A new minipool was created at %s.\n", response.MinipoolAddress.Hex())
     return nil
 
 }
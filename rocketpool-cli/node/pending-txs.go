@@ -0,0 +1,57 @@
+package node
+
+import (
+    "time"
+
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+
+func getPendingTxs(c *cli.Context) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Get pending transactions
+    pendingTxs, err := rp.NodePendingTxs()
+    if err != nil {
+        return err
+    }
+
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, pendingTxs)
+    }
+
+    // Check for transactions
+    if len(pendingTxs.Transactions) == 0 {
+        cliutils.Println("The node has no recent transactions.")
+        return nil
+    }
+
+    // Print transactions
+    for _, tx := range pendingTxs.Transactions {
+        cliutils.Printf("Transaction %s:\n", tx.Hash.Hex())
+        cliutils.Printf("Status:        %s\n", tx.Status)
+        cliutils.Printf("Nonce:         %d\n", tx.Nonce)
+        if tx.To != nil {
+            cliutils.Printf("To:            %s\n", tx.To.Hex())
+        }
+        cliutils.Printf("Value:         %.6f ETH\n", eth.WeiToEth(tx.Value))
+        cliutils.Printf("Gas price:     %.6f gwei\n", eth.WeiToGwei(tx.GasPrice))
+        cliutils.Printf("Replacements:  %d\n", tx.Replacements)
+        cliutils.Printf("First sent:    %s\n", time.Unix(tx.FirstSent, 0).Format(time.RFC822))
+        cliutils.Printf("Last sent:     %s\n", time.Unix(tx.LastSent, 0).Format(time.RFC822))
+        cliutils.Println("")
+    }
+
+    // Return
+    return nil
+
+}
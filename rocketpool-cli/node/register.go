@@ -1,11 +1,11 @@
 package node
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,12 +22,15 @@ func registerNode(c *cli.Context) error {
         return err
     }
     if !canRegister.CanRegister {
-        fmt.Println("The node cannot be registered:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canRegister)
+        }
+        cliutils.Println("The node cannot be registered:")
         if canRegister.AlreadyRegistered {
-            fmt.Println("The node is already registered with Rocket Pool.")
+            cliutils.Println("The node is already registered with Rocket Pool.")
         }
         if canRegister.RegistrationDisabled {
-            fmt.Println("Node registrations are currently disabled.")
+            cliutils.Println("Node registrations are currently disabled.")
         }
         return nil
     }
@@ -37,16 +40,25 @@ func registerNode(c *cli.Context) error {
     if c.String("timezone") != "" {
         timezoneLocation = c.String("timezone")
     } else {
-        timezoneLocation = promptTimezone()
+        timezoneLocation, err = promptTimezone()
+        if err != nil {
+            return err
+        }
     }
 
     // Register node
-    if _, err := rp.RegisterNode(timezoneLocation); err != nil {
+    response, err := rp.RegisterNode(timezoneLocation)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Println("The node was successfully registered with Rocket Pool.")
+    cliutils.Println("The node was successfully registered with Rocket Pool.")
     return nil
 
 }
@@ -0,0 +1,170 @@
+package node
+
+import (
+    "bytes"
+    "encoding/csv"
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "math/big"
+    "os"
+    "strconv"
+    "time"
+
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+
+// Config
+const RewardsReportFileMode = 0644
+
+
+func getRewardsReport(c *cli.Context) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Get time range; the end date is included in the range
+    startDate, err := cliutils.ValidateDate("start date", c.String("start"))
+    if err != nil { return err }
+    endTime := time.Now()
+    if c.String("end") != "" {
+        endDate, err := cliutils.ValidateDate("end date", c.String("end"))
+        if err != nil { return err }
+        endTime = endDate.AddDate(0, 0, 1).Add(-time.Second)
+    }
+
+    // Get rewards report
+    report, err := rp.NodeRewardsReport(startDate.Unix(), endTime.Unix())
+    if err != nil {
+        return err
+    }
+
+    // Print structured output unless a report format or file is specified
+    if cliutils.IsStructuredOutput(c) && !c.IsSet("format") && c.String("file") == "" {
+        return cliutils.PrintOutput(c, report)
+    }
+
+    // Encode report
+    var reportBytes []byte
+    format, err := cliutils.ValidateReportFormat("report format", c.String("format"))
+    if err != nil { return err }
+    switch format {
+        case "csv":
+            reportBytes, err = encodeRewardsReportCsv(report)
+        case "json":
+            reportBytes, err = json.MarshalIndent(report, "", "  ")
+    }
+    if err != nil {
+        return fmt.Errorf("Could not encode rewards report: %w", err)
+    }
+
+    // Print report
+    if c.String("file") == "" {
+        _, err := os.Stdout.Write(reportBytes)
+        return err
+    }
+
+    // Save report
+    if err := ioutil.WriteFile(c.String("file"), reportBytes, RewardsReportFileMode); err != nil {
+        return fmt.Errorf("Could not write rewards report to %s: %w", c.String("file"), err)
+    }
+    cliutils.Printf("Rewards report from %s to %s saved to %s.\n", time.Unix(report.StartTime, 0).Format(time.RFC3339), time.Unix(report.EndTime, 0).Format(time.RFC3339), c.String("file"))
+    cliutils.Printf("Minipool rewards (node share): %s ETH\n", formatEth(report.TotalNodeShare))
+    cliutils.Printf("ETH withdrawn:                 %s ETH\n", formatEth(report.TotalEthWithdrawn))
+    cliutils.Printf("RPL rewards claimed:           %s RPL (%s ETH)\n", formatEth(report.TotalRplClaimed), formatEth(report.TotalRplValue))
+    return nil
+
+}
+
+
+// Encode a rewards report as CSV, with a row per minipool balance change, ETH withdrawal and RPL rewards claim
+func encodeRewardsReportCsv(report api.NodeRewardsReportResponse) ([]byte, error) {
+
+    // Header
+    rows := [][]string{{"type", "time", "block", "txHash", "minipool", "validatorIndex", "nodeFee", "startBalanceEth", "endBalanceEth", "amount", "amountUnit", "rplPriceEth", "valueEth"}}
+    startTime := time.Unix(report.StartTime, 0).Format(time.RFC3339)
+    endTime := time.Unix(report.EndTime, 0).Format(time.RFC3339)
+
+    // Minipool balance changes; the value is the node's share of the change
+    for _, minipool := range report.Minipools {
+        rows = append(rows, []string{
+            "minipool-rewards",
+            startTime + "/" + endTime,
+            fmt.Sprintf("%d-%d", report.StartBlock, report.EndBlock),
+            "",
+            minipool.Address.Hex(),
+            strconv.FormatUint(minipool.ValidatorIndex, 10),
+            strconv.FormatFloat(minipool.NodeFee, 'f', -1, 64),
+            formatEth(minipool.StartBalance),
+            formatEth(minipool.EndBalance),
+            formatEth(minipool.BalanceChange),
+            "ETH",
+            "",
+            formatEth(minipool.NodeShare),
+        })
+    }
+
+    // ETH withdrawals
+    for _, withdrawal := range report.EthWithdrawals {
+        rows = append(rows, []string{
+            "eth-withdrawal",
+            time.Unix(withdrawal.Time, 0).Format(time.RFC3339),
+            strconv.FormatUint(withdrawal.Block, 10),
+            withdrawal.TxHash.Hex(),
+            withdrawal.Minipool.Hex(),
+            "",
+            "",
+            "",
+            "",
+            formatEth(withdrawal.Amount),
+            "ETH",
+            "",
+            formatEth(withdrawal.Amount),
+        })
+    }
+
+    // RPL rewards claims
+    for _, claim := range report.RplClaims {
+        rows = append(rows, []string{
+            "rpl-rewards",
+            time.Unix(claim.Time, 0).Format(time.RFC3339),
+            strconv.FormatUint(claim.Block, 10),
+            claim.TxHash.Hex(),
+            "",
+            "",
+            "",
+            "",
+            "",
+            formatEth(claim.Amount),
+            "RPL",
+            formatEth(claim.RplPrice),
+            formatEth(claim.EthValue),
+        })
+    }
+
+    // Encode
+    var buffer bytes.Buffer
+    writer := csv.NewWriter(&buffer)
+    if err := writer.WriteAll(rows); err != nil {
+        return []byte{}, err
+    }
+    return buffer.Bytes(), nil
+
+}
+
+
+// Format a wei amount as an exact decimal ETH amount
+func formatEth(wei *big.Int) string {
+    if wei == nil {
+        return ""
+    }
+    return new(big.Float).SetPrec(256).Quo(new(big.Float).SetInt(wei), new(big.Float).SetInt(eth.EthToWei(1))).Text('f', 18)
+}
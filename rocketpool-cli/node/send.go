@@ -29,26 +29,39 @@ func nodeSend(c *cli.Context, amount float64, token string, toAddress common.Add
         return err
     }
     if !canSend.CanSend {
-        fmt.Println("Cannot send tokens:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canSend)
+        }
+        cliutils.Println("Cannot send tokens:")
         if canSend.InsufficientBalance {
-            fmt.Printf("The node's %s balance is insufficient.\n", token)
+            cliutils.Printf("The node's %s balance is insufficient.\n", token)
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to send %.6f %s to %s? This action cannot be undone!", math.RoundDown(eth.WeiToEth(amountWei), 6), token, toAddress.Hex()))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to send %.6f %s to %s? This action cannot be undone!", math.RoundDown(eth.WeiToEth(amountWei), 6), token, toAddress.Hex()))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Send tokens
-    if _, err := rp.NodeSend(amountWei, token, toAddress); err != nil {
+    response, err := rp.NodeSend(amountWei, token, toAddress)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully sent %.6f %s to %s.\n", math.RoundDown(eth.WeiToEth(amountWei), 6), token, toAddress.Hex())
+    cliutils.Printf("Successfully sent %.6f %s to %s.\n", math.RoundDown(eth.WeiToEth(amountWei), 6), token, toAddress.Hex())
     return nil
 
 }
@@ -1,11 +1,11 @@
 package node
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -21,16 +21,25 @@ func setTimezoneLocation(c *cli.Context) error {
     if c.String("timezone") != "" {
         timezoneLocation = c.String("timezone")
     } else {
-        timezoneLocation = promptTimezone()
+        timezoneLocation, err = promptTimezone()
+        if err != nil {
+            return err
+        }
     }
 
     // Set node's timezone location
-    if _, err := rp.SetNodeTimezone(timezoneLocation); err != nil {
+    response, err := rp.SetNodeTimezone(timezoneLocation)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("The node's timezone location was successfully updated to '%s'.\n", timezoneLocation)
+    cliutils.Printf("The node's timezone location was successfully updated to '%s'.\n", timezoneLocation)
     return nil
 
 }
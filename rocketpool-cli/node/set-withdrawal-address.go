@@ -19,18 +19,28 @@ func setWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address) erro
     defer rp.Close()
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to set your node's withdrawal address to %s? All future ETH, nETH & RPL rewards/refunds will be sent here.", withdrawalAddress.Hex()))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to set your node's withdrawal address to %s? All future ETH, nETH & RPL rewards/refunds will be sent here.", withdrawalAddress.Hex()))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Set node's withdrawal address
-    if _, err := rp.SetNodeWithdrawalAddress(withdrawalAddress); err != nil {
+    response, err := rp.SetNodeWithdrawalAddress(withdrawalAddress)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("The node's withdrawal address was successfully set to %s.\n", withdrawalAddress.Hex())
+    cliutils.Printf("The node's withdrawal address was successfully set to %s.\n", withdrawalAddress.Hex())
     return nil
 
 }
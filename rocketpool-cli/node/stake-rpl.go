@@ -32,7 +32,14 @@ func nodeStakeRpl(c *cli.Context) error {
     if status.AccountBalances.FixedSupplyRPL.Cmp(big.NewInt(0)) > 0 {
 
         // Confirm swapping RPL
-        if (c.Bool("swap") || cliutils.Confirm(fmt.Sprintf("The node has a balance of %.6f old RPL. Would you like to swap it for new RPL before staking?", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6)))) {
+        swap := c.Bool("swap")
+        if !c.IsSet("swap") {
+            swap, err = cliutils.Confirm(fmt.Sprintf("The node has a balance of %.6f old RPL. Would you like to swap it for new RPL before staking?", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6)))
+            if err != nil {
+                return err
+            }
+        }
+        if swap {
 
             // Swap RPL
             if _, err := rp.NodeSwapRpl(status.AccountBalances.FixedSupplyRPL); err != nil {
@@ -40,8 +47,8 @@ func nodeStakeRpl(c *cli.Context) error {
             }
 
             // Log
-            fmt.Printf("Successfully swapped %.6f old RPL for new RPL.\n", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6))
-            fmt.Println("")
+            cliutils.Printf("Successfully swapped %.6f old RPL for new RPL.\n", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6))
+            cliutils.Println("")
 
             // Get new account RPL balance
             rplBalance.Add(status.AccountBalances.RPL, status.AccountBalances.FixedSupplyRPL)
@@ -101,7 +108,10 @@ func nodeStakeRpl(c *cli.Context) error {
             fmt.Sprintf("Your entire RPL balance (%.6f RPL)?", math.RoundDown(eth.WeiToEth(&rplBalance), 6)),
             "A custom amount",
         }
-        selected, _ := cliutils.Select("Please choose an amount of RPL to stake:", amountOptions)
+        selected, _, err := cliutils.Select("Please choose an amount of RPL to stake:", amountOptions)
+        if err != nil {
+            return err
+        }
         switch selected {
             case 0: amountWei = minAmount
             case 1: amountWei = maxAmount
@@ -110,7 +120,10 @@ func nodeStakeRpl(c *cli.Context) error {
 
         // Prompt for custom amount
         if amountWei == nil {
-            inputAmount := cliutils.Prompt("Please enter an amount of RPL to stake:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            inputAmount, err := cliutils.Prompt("Please enter an amount of RPL to stake:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            if err != nil {
+                return err
+            }
             stakeAmount, err := strconv.ParseFloat(inputAmount, 64)
             if err != nil {
                 return fmt.Errorf("Invalid stake amount '%s': %w", inputAmount, err)
@@ -126,26 +139,39 @@ func nodeStakeRpl(c *cli.Context) error {
         return err
     }
     if !canStake.CanStake {
-        fmt.Println("Cannot stake RPL:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canStake)
+        }
+        cliutils.Println("Cannot stake RPL:")
         if canStake.InsufficientBalance {
-            fmt.Println("The node's RPL balance is insufficient.")
+            cliutils.Println("The node's RPL balance is insufficient.")
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to stake %.6f RPL? Staked RPL can only be withdrawn after a delay.", math.RoundDown(eth.WeiToEth(amountWei), 6)))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to stake %.6f RPL? Staked RPL can only be withdrawn after a delay.", math.RoundDown(eth.WeiToEth(amountWei), 6)))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Stake RPL
-    if _, err := rp.NodeStakeRpl(amountWei); err != nil {
+    response, err := rp.NodeStakeRpl(amountWei)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully staked %.6f RPL.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
+    cliutils.Printf("Successfully staked %.6f RPL.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
     return nil
 
 }
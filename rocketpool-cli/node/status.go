@@ -2,13 +2,13 @@ package node
 
 import (
     "bytes"
-    "fmt"
     "math/big"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -26,15 +26,20 @@ func getStatus(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, status)
+    }
+
     // Account address & balances
-    fmt.Printf(
+    cliutils.Printf(
         "The node %s has a balance of %.6f ETH, %.6f RPL and %.6f nETH.\n",
         status.AccountAddress.Hex(),
         math.RoundDown(eth.WeiToEth(status.AccountBalances.ETH), 6),
         math.RoundDown(eth.WeiToEth(status.AccountBalances.RPL), 6),
         math.RoundDown(eth.WeiToEth(status.AccountBalances.NETH), 6))
     if status.AccountBalances.FixedSupplyRPL.Cmp(big.NewInt(0)) > 0 {
-        fmt.Printf("The node has a balance of %.6f old RPL which can be swapped for new RPL.\n", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6))
+        cliutils.Printf("The node has a balance of %.6f old RPL which can be swapped for new RPL.\n", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6))
     }
 
     // Registered node details
@@ -42,24 +47,24 @@ func getStatus(c *cli.Context) error {
 
         // Withdrawal address & balances
         if !bytes.Equal(status.AccountAddress.Bytes(), status.WithdrawalAddress.Bytes()) {
-            fmt.Printf(
+            cliutils.Printf(
                 "The node's withdrawal address %s has a balance of %.6f ETH, %.6f RPL and %.6f nETH.\n",
                 status.WithdrawalAddress.Hex(),
                 math.RoundDown(eth.WeiToEth(status.WithdrawalBalances.ETH), 6),
                 math.RoundDown(eth.WeiToEth(status.WithdrawalBalances.RPL), 6),
                 math.RoundDown(eth.WeiToEth(status.WithdrawalBalances.NETH), 6))
         }
-        fmt.Println("")
+        cliutils.Println("")
 
         // Node status
-        fmt.Printf("The node is registered with Rocket Pool with a timezone location of %s.\n", status.TimezoneLocation)
+        cliutils.Printf("The node is registered with Rocket Pool with a timezone location of %s.\n", status.TimezoneLocation)
         if status.Trusted {
-            fmt.Println("The node is a member of the oracle DAO - it can create unbonded minipools, vote on DAO proposals and perform watchtower duties.")
+            cliutils.Println("The node is a member of the oracle DAO - it can create unbonded minipools, vote on DAO proposals and perform watchtower duties.")
         }
-        fmt.Println("")
+        cliutils.Println("")
 
         // RPL stake details
-        fmt.Printf(
+        cliutils.Printf(
             "The node has a total stake of %.6f RPL and an effective stake of %.6f RPL, allowing it to run %d minipool(s) in total.\n",
             math.RoundDown(eth.WeiToEth(status.RplStake), 6),
             math.RoundDown(eth.WeiToEth(status.EffectiveRplStake), 6),
@@ -69,42 +74,42 @@ func getStatus(c *cli.Context) error {
         if status.MinipoolCounts.Total > 0 {
 
             // RPL stake
-            fmt.Printf("The node must keep at least %.6f RPL staked to collateralize its minipools and claim RPL rewards.\n", math.RoundDown(eth.WeiToEth(status.MinimumRplStake), 6))
-            fmt.Println("")
+            cliutils.Printf("The node must keep at least %.6f RPL staked to collateralize its minipools and claim RPL rewards.\n", math.RoundDown(eth.WeiToEth(status.MinimumRplStake), 6))
+            cliutils.Println("")
 
             // Minipools
-            fmt.Printf("The node has a total of %d minipool(s):\n", status.MinipoolCounts.Total)
+            cliutils.Printf("The node has a total of %d minipool(s):\n", status.MinipoolCounts.Total)
             if status.MinipoolCounts.Initialized > 0 {
-                fmt.Printf("- %d initialized\n", status.MinipoolCounts.Initialized)
+                cliutils.Printf("- %d initialized\n", status.MinipoolCounts.Initialized)
             }
             if status.MinipoolCounts.Prelaunch > 0 {
-                fmt.Printf("- %d at prelaunch\n", status.MinipoolCounts.Prelaunch)
+                cliutils.Printf("- %d at prelaunch\n", status.MinipoolCounts.Prelaunch)
             }
             if status.MinipoolCounts.Staking > 0 {
-                fmt.Printf("- %d staking\n", status.MinipoolCounts.Staking)
+                cliutils.Printf("- %d staking\n", status.MinipoolCounts.Staking)
             }
             if status.MinipoolCounts.Withdrawable > 0 {
-                fmt.Printf("- %d withdrawable (after withdrawal delay)\n", status.MinipoolCounts.Withdrawable)
+                cliutils.Printf("- %d withdrawable (after withdrawal delay)\n", status.MinipoolCounts.Withdrawable)
             }
             if status.MinipoolCounts.Dissolved > 0 {
-                fmt.Printf("- %d dissolved\n", status.MinipoolCounts.Dissolved)
+                cliutils.Printf("- %d dissolved\n", status.MinipoolCounts.Dissolved)
             }
             if status.MinipoolCounts.RefundAvailable > 0 {
-                fmt.Printf("* %d minipool(s) have refunds available!\n", status.MinipoolCounts.RefundAvailable)
+                cliutils.Printf("* %d minipool(s) have refunds available!\n", status.MinipoolCounts.RefundAvailable)
             }
             if status.MinipoolCounts.WithdrawalAvailable > 0 {
-                fmt.Printf("* %d minipool(s) are ready for withdrawal!\n", status.MinipoolCounts.WithdrawalAvailable)
+                cliutils.Printf("* %d minipool(s) are ready for withdrawal!\n", status.MinipoolCounts.WithdrawalAvailable)
             }
             if status.MinipoolCounts.CloseAvailable > 0 {
-                fmt.Printf("* %d dissolved minipool(s) can be closed!\n", status.MinipoolCounts.CloseAvailable)
+                cliutils.Printf("* %d dissolved minipool(s) can be closed!\n", status.MinipoolCounts.CloseAvailable)
             }
 
         } else {
-            fmt.Println("The node does not have any minipools yet.")
+            cliutils.Println("The node does not have any minipools yet.")
         }
         
     } else {
-        fmt.Println("The node is not registered with Rocket Pool.")
+        cliutils.Println("The node is not registered with Rocket Pool.")
     }
 
     // Return
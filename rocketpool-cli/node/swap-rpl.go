@@ -51,12 +51,19 @@ func nodeSwapRpl(c *cli.Context) error {
         entireAmount := status.AccountBalances.FixedSupplyRPL
 
         // Prompt for entire amount
-        if cliutils.Confirm(fmt.Sprintf("Would you like to swap your entire old RPL balance (%.6f RPL)?", math.RoundDown(eth.WeiToEth(entireAmount), 6))) {
+        swapEntireAmount, err := cliutils.Confirm(fmt.Sprintf("Would you like to swap your entire old RPL balance (%.6f RPL)?", math.RoundDown(eth.WeiToEth(entireAmount), 6)))
+        if err != nil {
+            return err
+        }
+        if swapEntireAmount {
             amountWei = entireAmount
         } else {
 
             // Prompt for custom amount
-            inputAmount := cliutils.Prompt("Please enter an amount of old RPL to swap:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            inputAmount, err := cliutils.Prompt("Please enter an amount of old RPL to swap:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            if err != nil {
+                return err
+            }
             swapAmount, err := strconv.ParseFloat(inputAmount, 64)
             if err != nil {
                 return fmt.Errorf("Invalid swap amount '%s': %w", inputAmount, err)
@@ -73,20 +80,29 @@ func nodeSwapRpl(c *cli.Context) error {
         return err
     }
     if !canSwap.CanSwap {
-        fmt.Println("Cannot swap RPL:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canSwap)
+        }
+        cliutils.Println("Cannot swap RPL:")
         if canSwap.InsufficientBalance {
-            fmt.Println("The node's old RPL balance is insufficient.")
+            cliutils.Println("The node's old RPL balance is insufficient.")
         }
         return nil
     }
 
     // Swap RPL
-    if _, err := rp.NodeSwapRpl(amountWei); err != nil {
+    response, err := rp.NodeSwapRpl(amountWei)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully swapped %.6f old RPL for new RPL.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
+    cliutils.Printf("Successfully swapped %.6f old RPL for new RPL.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
     return nil
 
 }
@@ -24,13 +24,17 @@ type freeGeoIPResponse struct {
 
 
 // Prompt user for a time zone string
-func promptTimezone() string {
+func promptTimezone() (string, error) {
 
     // Time zone value
     var timezone string
 
     // Prompt for auto-detect
-    if cliutils.Confirm("Would you like to detect your timezone automatically?") {
+    detect, err := cliutils.Confirm("Would you like to detect your timezone automatically?")
+    if err != nil {
+        return "", err
+    }
+    if detect {
 
         // Detect using FreeGeoIP
         if resp, err := http.Get(FreeGeoIPURL); err == nil {
@@ -54,50 +58,68 @@ func promptTimezone() string {
 
     // Confirm detected time zone
     if timezone != "" {
-        if !cliutils.Confirm(fmt.Sprintf("The detected timezone is '%s', would you like to register using this timezone?", timezone)) {
+        confirmed, err := cliutils.Confirm(fmt.Sprintf("The detected timezone is '%s', would you like to register using this timezone?", timezone))
+        if err != nil {
+            return "", err
+        }
+        if !confirmed {
             timezone = ""
         }
     }
 
     // Prompt for time zone
     for timezone == "" {
-        timezone = cliutils.Prompt("Please enter a timezone to register with in the format 'Country/City':", "^([a-zA-Z_]{2,}\\/)+[a-zA-Z_]{2,}$", "Please enter a timezone in the format 'Country/City'")
-        if !cliutils.Confirm(fmt.Sprintf("You have chosen to register with the timezone '%s', is this correct?", timezone)) {
+        timezone, err = cliutils.Prompt("Please enter a timezone to register with in the format 'Country/City':", "^([a-zA-Z_]{2,}\\/)+[a-zA-Z_]{2,}$", "Please enter a timezone in the format 'Country/City'")
+        if err != nil {
+            return "", err
+        }
+        confirmed, err := cliutils.Confirm(fmt.Sprintf("You have chosen to register with the timezone '%s', is this correct?", timezone))
+        if err != nil {
+            return "", err
+        }
+        if !confirmed {
             timezone = ""
         }
     }
 
     // Return
-    return timezone
+    return timezone, nil
 
 }
 
 
 // Prompt user for a minimum node fee
-func promptMinNodeFee(networkCurrentNodeFee, networkMinNodeFee float64) float64 {
+func promptMinNodeFee(networkCurrentNodeFee, networkMinNodeFee float64) (float64, error) {
 
     // Get suggested min node fee
     suggestedMinNodeFee := networkCurrentNodeFee - DefaultMaxNodeFeeSlippage
     if suggestedMinNodeFee < networkMinNodeFee { suggestedMinNodeFee = networkMinNodeFee }
 
     // Prompt for suggested max slippage
-    fmt.Printf("The current network node commission rate that your minipool should receive is %f%%.\n", networkCurrentNodeFee * 100)
-    fmt.Printf("The suggested maximum commission rate slippage for your deposit transaction is %f%%.\n", DefaultMaxNodeFeeSlippage * 100)
-    fmt.Printf("This will result in your minipool receiving a minimum possible commission rate of %f%%.\n", suggestedMinNodeFee * 100)
-    if cliutils.Confirm("Do you want to use the suggested maximum commission rate slippage?") {
-        return suggestedMinNodeFee
+    cliutils.Printf("The current network node commission rate that your minipool should receive is %f%%.\n", networkCurrentNodeFee * 100)
+    cliutils.Printf("The suggested maximum commission rate slippage for your deposit transaction is %f%%.\n", DefaultMaxNodeFeeSlippage * 100)
+    cliutils.Printf("This will result in your minipool receiving a minimum possible commission rate of %f%%.\n", suggestedMinNodeFee * 100)
+    useSuggested, err := cliutils.Confirm("Do you want to use the suggested maximum commission rate slippage?")
+    if err != nil {
+        return 0, err
+    }
+    if useSuggested {
+        return suggestedMinNodeFee, nil
     }
 
     // Prompt for custom max slippage
     for {
 
         // Get max slippage
-        maxNodeFeeSlippagePercStr := cliutils.Prompt("Please enter a maximum commission rate slippage % for your deposit:", "^\\d+(\\.\\d+)?$", "Invalid maximum commission rate slippage")
+        maxNodeFeeSlippagePercStr, err := cliutils.Prompt("Please enter a maximum commission rate slippage % for your deposit:", "^\\d+(\\.\\d+)?$", "Invalid maximum commission rate slippage")
+        if err != nil {
+            return 0, err
+        }
         maxNodeFeeSlippagePerc, _ := strconv.ParseFloat(maxNodeFeeSlippagePercStr, 64)
         maxNodeFeeSlippage := maxNodeFeeSlippagePerc / 100
         if maxNodeFeeSlippage < 0 || maxNodeFeeSlippage > 1 {
-            fmt.Println("Invalid maximum commission rate slippage")
-            fmt.Println("")
+            cliutils.Println("Invalid maximum commission rate slippage")
+            cliutils.Println("")
             continue
         }
 
@@ -106,8 +128,12 @@ func promptMinNodeFee(networkCurrentNodeFee, networkMinNodeFee float64) float64
         if minNodeFee < networkMinNodeFee { minNodeFee = networkMinNodeFee }
 
         // Confirm max slippage
-        if cliutils.Confirm(fmt.Sprintf("You have chosen a maximum commission rate slippage of %f%%, resulting in a minimum possible commission rate of %f%%. Is this correct?", maxNodeFeeSlippage * 100, minNodeFee * 100)) {
-            return minNodeFee
+        confirmed, err := cliutils.Confirm(fmt.Sprintf("You have chosen a maximum commission rate slippage of %f%%, resulting in a minimum possible commission rate of %f%%. Is this correct?", maxNodeFeeSlippage * 100, minNodeFee * 100))
+        if err != nil {
+            return 0, err
+        }
+        if confirmed {
+            return minNodeFee, nil
         }
 
     }
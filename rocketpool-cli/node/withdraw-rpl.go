@@ -62,12 +62,19 @@ func nodeWithdrawRpl(c *cli.Context) error {
         }
 
         // Prompt for maximum amount
-        if cliutils.Confirm(fmt.Sprintf("Would you like to withdraw the maximum amount of staked RPL (%.6f RPL)?", math.RoundDown(eth.WeiToEth(&maxAmount), 6))) {
+        withdrawMaxAmount, err := cliutils.Confirm(fmt.Sprintf("Would you like to withdraw the maximum amount of staked RPL (%.6f RPL)?", math.RoundDown(eth.WeiToEth(&maxAmount), 6)))
+        if err != nil {
+            return err
+        }
+        if withdrawMaxAmount {
             amountWei = &maxAmount
         } else {
 
             // Prompt for custom amount
-            inputAmount := cliutils.Prompt("Please enter an amount of staked RPL to withdraw:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            inputAmount, err := cliutils.Prompt("Please enter an amount of staked RPL to withdraw:", "^\\d+(\\.\\d+)?$", "Invalid amount")
+            if err != nil {
+                return err
+            }
             withdrawalAmount, err := strconv.ParseFloat(inputAmount, 64)
             if err != nil {
                 return fmt.Errorf("Invalid withdrawal amount '%s': %w", inputAmount, err)
@@ -84,32 +91,45 @@ func nodeWithdrawRpl(c *cli.Context) error {
         return err
     }
     if !canWithdraw.CanWithdraw {
-        fmt.Println("Cannot withdraw staked RPL:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canWithdraw)
+        }
+        cliutils.Println("Cannot withdraw staked RPL:")
         if canWithdraw.InsufficientBalance {
-            fmt.Println("The node's staked RPL balance is insufficient.")
+            cliutils.Println("The node's staked RPL balance is insufficient.")
         }
         if canWithdraw.MinipoolsUndercollateralized {
-            fmt.Println("Remaining staked RPL is not enough to collateralize the node's minipools.")
+            cliutils.Println("Remaining staked RPL is not enough to collateralize the node's minipools.")
         }
         if canWithdraw.WithdrawalDelayActive {
-            fmt.Println("The withdrawal delay period has not passed.")
+            cliutils.Println("The withdrawal delay period has not passed.")
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to withdraw %.6f staked RPL? This may decrease your node's RPL rewards.", math.RoundDown(eth.WeiToEth(amountWei), 6)))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to withdraw %.6f staked RPL? This may decrease your node's RPL rewards.", math.RoundDown(eth.WeiToEth(amountWei), 6)))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Withdraw RPL
-    if _, err := rp.NodeWithdrawRpl(amountWei); err != nil {
+    response, err := rp.NodeWithdrawRpl(amountWei)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully withdrew %.6f staked RPL.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
+    cliutils.Printf("Successfully withdrew %.6f staked RPL.\n", math.RoundDown(eth.WeiToEth(amountWei), 6))
     return nil
 
 }
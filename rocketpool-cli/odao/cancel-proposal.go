@@ -43,7 +43,7 @@ func cancelProposal(c *cli.Context) error {
 
     // Check for cancelable proposals
     if len(cancelableProposals) == 0 {
-        fmt.Println("No proposals can be cancelled.")
+        cliutils.Println("No proposals can be cancelled.")
         return nil
     }
 
@@ -77,18 +77,27 @@ func cancelProposal(c *cli.Context) error {
         for pi, proposal := range cancelableProposals {
             options[pi] = fmt.Sprintf("proposal %d (message: '%s', payload: %s)", proposal.ID, proposal.Message, proposal.PayloadStr)
         }
-        selected, _ := cliutils.Select("Please select a proposal to cancel:", options)
+        selected, _, err := cliutils.Select("Please select a proposal to cancel:", options)
+        if err != nil {
+            return err
+        }
         selectedProposal = cancelableProposals[selected]
 
     }
 
     // Cancel proposal
-    if _, err := rp.CancelTNDAOProposal(selectedProposal.ID); err != nil {
+    response, err := rp.CancelTNDAOProposal(selectedProposal.ID)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully cancelled proposal %d.\n", selectedProposal.ID)
+    cliutils.Printf("Successfully cancelled proposal %d.\n", selectedProposal.ID)
     return nil
 
 }
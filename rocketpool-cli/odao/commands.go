@@ -172,6 +172,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                                         if _, err := cliutils.ValidatePositiveEthAmount("fine amount", c.String("fine")); err != nil { return err }
                                     }
 
+                                    // Check inputs
+                                    if err := cliutils.RequireInputs(c, "member", "fine"); err != nil { return err }
+
                                     // Run
                                     return proposeKick(c)
 
@@ -373,6 +376,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                                 if _, err := cliutils.ValidatePositiveUint("proposal ID", c.String("proposal")); err != nil { return err }
                             }
 
+                            // Check inputs
+                            if err := cliutils.RequireInputs(c, "proposal"); err != nil { return err }
+
                             // Run
                             return cancelProposal(c)
 
@@ -411,6 +417,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                                 if _, err := cliutils.ValidateBool("support", c.String("support")); err != nil { return err }
                             }
 
+                            // Check inputs
+                            if err := cliutils.RequireInputs(c, "proposal", "support", "yes"); err != nil { return err }
+
                             // Run
                             return voteOnProposal(c)
 
@@ -438,6 +447,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                                 if _, err := cliutils.ValidatePositiveUint("proposal ID", c.String("proposal")); err != nil { return err }
                             }
 
+                            // Check inputs
+                            if err := cliutils.RequireInputs(c, "proposal"); err != nil { return err }
+
                             // Run
                             return executeProposal(c)
 
@@ -467,6 +479,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run
                     return join(c)
 
@@ -498,6 +513,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                         if _, err := cliutils.ValidateAddress("bond refund address", c.String("refund-address")); err != nil { return err }
                     }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "refund-address", "yes"); err != nil { return err }
+
                     // Run
                     return leave(c)
 
@@ -520,6 +538,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run
                     return replace(c)
 
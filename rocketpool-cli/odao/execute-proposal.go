@@ -36,7 +36,7 @@ func executeProposal(c *cli.Context) error {
 
     // Check for executable proposals
     if len(executableProposals) == 0 {
-        fmt.Println("No proposals can be executed.")
+        cliutils.Println("No proposals can be executed.")
         return nil
     }
 
@@ -76,7 +76,10 @@ func executeProposal(c *cli.Context) error {
         for pi, proposal := range executableProposals {
             options[pi + 1] = fmt.Sprintf("proposal %d (message: '%s', payload: %s)", proposal.ID, proposal.Message, proposal.PayloadStr)
         }
-        selected, _ := cliutils.Select("Please select a proposal to execute:", options)
+        selected, _, err := cliutils.Select("Please select a proposal to execute:", options)
+        if err != nil {
+            return err
+        }
 
         // Get proposals
         if selected == 0 {
@@ -88,14 +91,22 @@ func executeProposal(c *cli.Context) error {
     }
 
     // Execute proposals
+    results := []cliutils.ItemResult{}
     for _, proposal := range selectedProposals {
-        if _, err := rp.ExecuteTNDAOProposal(proposal.ID); err != nil {
-            fmt.Printf("Could not execute proposal %d: %s.\n", proposal.ID, err)
+        response, err := rp.ExecuteTNDAOProposal(proposal.ID)
+        results = append(results, cliutils.NewItemResult(strconv.FormatUint(proposal.ID, 10), response, err))
+        if err != nil {
+            cliutils.Printf("Could not execute proposal %d: %s.\n", proposal.ID, err)
         } else {
-            fmt.Printf("Successfully executed proposal %d.\n", proposal.ID)
+            cliutils.Printf("Successfully executed proposal %d.\n", proposal.ID)
         }
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, results)
+    }
+
     // Return
     return nil
 
@@ -1,12 +1,11 @@
 package odao
 
 import (
-	"fmt"
-
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -23,13 +22,18 @@ func getMemberSettings(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("ODAO Voting Quorum Threshold: %f%%\n", response.Quorum * 100)
-    fmt.Printf("Required Member RPL Bond: %f RPL\n", eth.WeiToEth(response.RPLBond))
-    fmt.Printf("Max Number of Unbonded Minipools: %d\n", response.MinipoolUnbondedMax)
-    fmt.Printf("Consecutive Challenge Cooldown: %d Blocks\n", response.ChallengeCooldown)
-    fmt.Printf("Challenge Meeting Window: %d Blocks\n", response.ChallengeWindow)
-    fmt.Printf("Cost for Non-members to Challenge Members: %f ETH\n", eth.WeiToEth(response.ChallengeCost))
+    cliutils.Printf("ODAO Voting Quorum Threshold: %f%%\n", response.Quorum * 100)
+    cliutils.Printf("Required Member RPL Bond: %f RPL\n", eth.WeiToEth(response.RPLBond))
+    cliutils.Printf("Max Number of Unbonded Minipools: %d\n", response.MinipoolUnbondedMax)
+    cliutils.Printf("Consecutive Challenge Cooldown: %d Blocks\n", response.ChallengeCooldown)
+    cliutils.Printf("Challenge Meeting Window: %d Blocks\n", response.ChallengeWindow)
+    cliutils.Printf("Cost for Non-members to Challenge Members: %f ETH\n", eth.WeiToEth(response.ChallengeCost))
     return nil
 
 }
@@ -48,12 +52,17 @@ func getProposalSettings(c* cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Cooldown Between Proposals: %d Blocks\n", response.Cooldown)
-    fmt.Printf("Proposal Voting Window: %d Blocks\n", response.VoteBlocks)
-    fmt.Printf("Delay Before Voting on a Proposal is Allowed: %d Blocks\n", response.VoteDelayBlocks)
-    fmt.Printf("Window to Execute an Accepted Proposal: %d Blocks\n", response.ExecuteBlocks)
-    fmt.Printf("Window to Act on an Executed Proposal: %d Blocks\n", response.ActionBlocks)
+    cliutils.Printf("Cooldown Between Proposals: %d Blocks\n", response.Cooldown)
+    cliutils.Printf("Proposal Voting Window: %d Blocks\n", response.VoteBlocks)
+    cliutils.Printf("Delay Before Voting on a Proposal is Allowed: %d Blocks\n", response.VoteDelayBlocks)
+    cliutils.Printf("Window to Execute an Accepted Proposal: %d Blocks\n", response.ExecuteBlocks)
+    cliutils.Printf("Window to Act on an Executed Proposal: %d Blocks\n", response.ActionBlocks)
     return nil
 
 }
@@ -30,7 +30,14 @@ func join(c *cli.Context) error {
     if status.AccountBalances.FixedSupplyRPL.Cmp(big.NewInt(0)) > 0 {
 
         // Confirm swapping RPL
-        if (c.Bool("swap") || cliutils.Confirm(fmt.Sprintf("The node has a balance of %.6f old RPL. Would you like to swap it for new RPL before transferring your bond?", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6)))) {
+        swap := c.Bool("swap")
+        if !c.IsSet("swap") {
+            swap, err = cliutils.Confirm(fmt.Sprintf("The node has a balance of %.6f old RPL. Would you like to swap it for new RPL before transferring your bond?", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6)))
+            if err != nil {
+                return err
+            }
+        }
+        if swap {
 
             // Swap RPL
             if _, err := rp.NodeSwapRpl(status.AccountBalances.FixedSupplyRPL); err != nil {
@@ -38,8 +45,8 @@ func join(c *cli.Context) error {
             }
 
             // log
-            fmt.Printf("Successfully swapped %.6f old RPL for new RPL.\n", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6))
-            fmt.Println("")
+            cliutils.Printf("Successfully swapped %.6f old RPL for new RPL.\n", math.RoundDown(eth.WeiToEth(status.AccountBalances.FixedSupplyRPL), 6))
+            cliutils.Println("")
 
         }
 
@@ -51,32 +58,45 @@ func join(c *cli.Context) error {
         return err
     }
     if !canJoin.CanJoin {
-        fmt.Println("Cannot join the oracle DAO:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canJoin)
+        }
+        cliutils.Println("Cannot join the oracle DAO:")
         if canJoin.ProposalExpired {
-            fmt.Println("The proposal for you to join the oracle DAO does not exist or has expired.")
+            cliutils.Println("The proposal for you to join the oracle DAO does not exist or has expired.")
         }
         if canJoin.AlreadyMember {
-            fmt.Println("The node is already a member of the oracle DAO.")
+            cliutils.Println("The node is already a member of the oracle DAO.")
         }
         if canJoin.InsufficientRplBalance {
-            fmt.Println("The node does not have enough RPL to pay the RPL bond.")
+            cliutils.Println("The node does not have enough RPL to pay the RPL bond.")
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to join the oracle DAO? Your RPL bond will be locked until you leave.")) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, "Are you sure you want to join the oracle DAO? Your RPL bond will be locked until you leave.")
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Join the oracle DAO
-    if _, err := rp.JoinTNDAO(); err != nil {
+    response, err := rp.JoinTNDAO()
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Println("Successfully joined the oracle DAO.")
+    cliutils.Println("Successfully joined the oracle DAO.")
     return nil
 
 }
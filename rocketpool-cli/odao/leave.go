@@ -43,12 +43,19 @@ func leave(c *cli.Context) error {
         }
 
         // Prompt for node address
-        if cliutils.Confirm(fmt.Sprintf("Would you like to refund your RPL bond to your node account (%s)?", wallet.AccountAddress.Hex())) {
+        refundToNode, err := cliutils.Confirm(fmt.Sprintf("Would you like to refund your RPL bond to your node account (%s)?", wallet.AccountAddress.Hex()))
+        if err != nil {
+            return err
+        }
+        if refundToNode {
             bondRefundAddress = wallet.AccountAddress
         } else {
 
             // Prompt for custom address
-            inputAddress := cliutils.Prompt("Please enter the address to refund your RPL bond to:", "^0x[0-9a-fA-F]{40}$", "Invalid address")
+            inputAddress, err := cliutils.Prompt("Please enter the address to refund your RPL bond to:", "^0x[0-9a-fA-F]{40}$", "Invalid address")
+            if err != nil {
+                return err
+            }
             bondRefundAddress = common.HexToAddress(inputAddress)
 
         }
@@ -61,29 +68,42 @@ func leave(c *cli.Context) error {
         return err
     }
     if !canLeave.CanLeave {
-        fmt.Println("Cannot leave the oracle DAO:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canLeave)
+        }
+        cliutils.Println("Cannot leave the oracle DAO:")
         if canLeave.ProposalExpired {
-            fmt.Println("The proposal for you to leave the oracle DAO does not exist or has expired.")
+            cliutils.Println("The proposal for you to leave the oracle DAO does not exist or has expired.")
         }
         if canLeave.InsufficientMembers {
-            fmt.Println("There are not enough members in the oracle DAO to allow a member to leave.")
+            cliutils.Println("There are not enough members in the oracle DAO to allow a member to leave.")
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to leave the oracle DAO and refund your RPL bond to %s? This action cannot be undone!", bondRefundAddress.Hex()))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to leave the oracle DAO and refund your RPL bond to %s? This action cannot be undone!", bondRefundAddress.Hex()))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Leave the oracle DAO
-    if _, err := rp.LeaveTNDAO(bondRefundAddress); err != nil {
+    response, err := rp.LeaveTNDAO(bondRefundAddress)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Println("Successfully left the oracle DAO.")
+    cliutils.Println("Successfully left the oracle DAO.")
     return nil
 
 }
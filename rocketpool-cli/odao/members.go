@@ -1,12 +1,12 @@
 package odao
 
 import (
-    "fmt"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -24,24 +24,29 @@ func getMembers(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, members)
+    }
+
     // Print & return
     if len(members.Members) > 0 {
-        fmt.Printf("The oracle DAO has %d members:\n", len(members.Members))
-        fmt.Println("")
+        cliutils.Printf("The oracle DAO has %d members:\n", len(members.Members))
+        cliutils.Println("")
     } else {
-        fmt.Println("The oracle DAO does not have any members yet.")
+        cliutils.Println("The oracle DAO does not have any members yet.")
     }
     for _, member := range members.Members {
-        fmt.Printf("--------------------\n")
-        fmt.Printf("\n")
-        fmt.Printf("Member ID:            %s\n", member.ID)
-        fmt.Printf("Email address:        %s\n", member.Email)
-        fmt.Printf("Node address:         %s\n", member.Address.Hex())
-        fmt.Printf("Joined at block:      %d\n", member.JoinedBlock)
-        fmt.Printf("Last proposal block:  %d\n", member.LastProposalBlock)
-        fmt.Printf("RPL bond amount:      %.6f\n", math.RoundDown(eth.WeiToEth(member.RPLBondAmount), 6))
-        fmt.Printf("Unbonded minipools:   %d\n", member.UnbondedValidatorCount)
-        fmt.Printf("\n")
+        cliutils.Printf("--------------------\n")
+        cliutils.Printf("\n")
+        cliutils.Printf("Member ID:            %s\n", member.ID)
+        cliutils.Printf("Email address:        %s\n", member.Email)
+        cliutils.Printf("Node address:         %s\n", member.Address.Hex())
+        cliutils.Printf("Joined at block:      %d\n", member.JoinedBlock)
+        cliutils.Printf("Last proposal block:  %d\n", member.LastProposalBlock)
+        cliutils.Printf("RPL bond amount:      %.6f\n", math.RoundDown(eth.WeiToEth(member.RPLBondAmount), 6))
+        cliutils.Printf("Unbonded minipools:   %d\n", member.UnbondedValidatorCount)
+        cliutils.Printf("\n")
     }
     return nil
 
@@ -2,13 +2,13 @@ package odao
 
 import (
     "encoding/hex"
-    "fmt"
 
     "github.com/rocket-pool/rocketpool-go/dao"
     "github.com/rocket-pool/rocketpool-go/types"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -25,6 +25,11 @@ func getProposals(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, allProposals)
+    }
+
     // Get proposals by state
     stateProposals := map[string][]dao.ProposalDetails{}
     for _, proposal := range allProposals.Proposals {
@@ -40,59 +45,59 @@ func getProposals(c *cli.Context) error {
 
     // Print & return
     if len(allProposals.Proposals) == 0 {
-        fmt.Println("There are no oracle DAO proposals yet.")
+        cliutils.Println("There are no oracle DAO proposals yet.")
     }
     for _, stateName := range proposalStates {
         proposals, ok := stateProposals[stateName]
         if !ok { continue }
 
         // Proposal state count
-        fmt.Printf("%d %s proposal(s):\n", len(proposals), stateName)
-        fmt.Println("")
+        cliutils.Printf("%d %s proposal(s):\n", len(proposals), stateName)
+        cliutils.Println("")
 
         // Proposals
         for _, proposal := range proposals {
-            fmt.Printf("--------------------\n")
-            fmt.Printf("\n")
+            cliutils.Printf("--------------------\n")
+            cliutils.Printf("\n")
 
             // Main details
-            fmt.Printf("Proposal ID:          %d\n", proposal.ID)
-            fmt.Printf("Message:              %s\n", proposal.Message)
-            fmt.Printf("Payload:              %s\n", proposal.PayloadStr)
-            fmt.Printf("Payload (bytes):      %s\n", hex.EncodeToString(proposal.Payload))
-            fmt.Printf("Proposed by:          %s\n", proposal.ProposerAddress.Hex())
-            fmt.Printf("Created at block:     %d\n", proposal.CreatedBlock)
+            cliutils.Printf("Proposal ID:          %d\n", proposal.ID)
+            cliutils.Printf("Message:              %s\n", proposal.Message)
+            cliutils.Printf("Payload:              %s\n", proposal.PayloadStr)
+            cliutils.Printf("Payload (bytes):      %s\n", hex.EncodeToString(proposal.Payload))
+            cliutils.Printf("Proposed by:          %s\n", proposal.ProposerAddress.Hex())
+            cliutils.Printf("Created at block:     %d\n", proposal.CreatedBlock)
 
             // Start block - pending proposals
             if proposal.State == types.Pending {
-            fmt.Printf("Starts at block:      %d\n", proposal.StartBlock)
+            cliutils.Printf("Starts at block:      %d\n", proposal.StartBlock)
             }
 
             // End block - active proposals
             if proposal.State == types.Active {
-            fmt.Printf("Ends at block:        %d\n", proposal.EndBlock)
+            cliutils.Printf("Ends at block:        %d\n", proposal.EndBlock)
             }
 
             // Expiry block - succeeded proposals
             if proposal.State == types.Succeeded {
-            fmt.Printf("Expires at block:     %d\n", proposal.ExpiryBlock)
+            cliutils.Printf("Expires at block:     %d\n", proposal.ExpiryBlock)
             }
 
             // Vote details
-            fmt.Printf("Votes required:       %.2f\n", proposal.VotesRequired)
-            fmt.Printf("Votes for:            %.2f\n", proposal.VotesFor)
-            fmt.Printf("Votes against:        %.2f\n", proposal.VotesAgainst)
+            cliutils.Printf("Votes required:       %.2f\n", proposal.VotesRequired)
+            cliutils.Printf("Votes for:            %.2f\n", proposal.VotesFor)
+            cliutils.Printf("Votes against:        %.2f\n", proposal.VotesAgainst)
             if proposal.MemberVoted {
                 if proposal.MemberSupported {
-            fmt.Printf("Node has voted:       for\n")
+            cliutils.Printf("Node has voted:       for\n")
                 } else {
-            fmt.Printf("Node has voted:       against\n")
+            cliutils.Printf("Node has voted:       against\n")
                 }
             } else {
-            fmt.Printf("Node has voted:       no\n")
+            cliutils.Printf("Node has voted:       no\n")
             }
 
-            fmt.Printf("\n")
+            cliutils.Printf("\n")
         }
 
     }
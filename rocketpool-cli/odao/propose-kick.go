@@ -53,7 +53,10 @@ func proposeKick(c *cli.Context) error {
         for mi, member := range members.Members {
             options[mi] = fmt.Sprintf("%s (email: %s, node: %s)", member.ID, member.Email, member.Address)
         }
-        selected, _ := cliutils.Select("Please select a member to propose kicking:", options)
+        selected, _, err := cliutils.Select("Please select a member to propose kicking:", options)
+        if err != nil {
+            return err
+        }
         selectedMember = members.Members[selected]
 
     }
@@ -77,7 +80,10 @@ func proposeKick(c *cli.Context) error {
     } else {
 
         // Prompt for custom amount
-        inputAmount := cliutils.Prompt(fmt.Sprintf("Please enter an RPL fine amount to propose (max %.6f RPL):", math.RoundDown(eth.WeiToEth(selectedMember.RPLBondAmount), 6)), "^\\d+(\\.\\d+)?$", "Invalid amount")
+        inputAmount, err := cliutils.Prompt(fmt.Sprintf("Please enter an RPL fine amount to propose (max %.6f RPL):", math.RoundDown(eth.WeiToEth(selectedMember.RPLBondAmount), 6)), "^\\d+(\\.\\d+)?$", "Invalid amount")
+        if err != nil {
+            return err
+        }
         fineAmount, err := strconv.ParseFloat(inputAmount, 64)
         if err != nil {
             return fmt.Errorf("Invalid fine amount '%s': %w", inputAmount, err)
@@ -92,12 +98,15 @@ func proposeKick(c *cli.Context) error {
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose kicking member:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose kicking member:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         if canPropose.InsufficientRplBond {
-            fmt.Printf("The fine amount of %.6f RPL is greater than the member's bond of %.6f RPL.\n", math.RoundDown(eth.WeiToEth(fineAmountWei), 6), math.RoundDown(eth.WeiToEth(selectedMember.RPLBondAmount), 6))
+            cliutils.Printf("The fine amount of %.6f RPL is greater than the member's bond of %.6f RPL.\n", math.RoundDown(eth.WeiToEth(fineAmountWei), 6), math.RoundDown(eth.WeiToEth(selectedMember.RPLBondAmount), 6))
         }
         return nil
     }
@@ -108,8 +117,13 @@ func proposeKick(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a kick proposal with ID %d for node %s, with a fine of %.6f RPL.\n", response.ProposalId, selectedMember.Address.Hex(), math.RoundDown(eth.WeiToEth(fineAmountWei), 6))
+    cliutils.Printf("Successfully submitted a kick proposal with ID %d for node %s, with a fine of %.6f RPL.\n", response.ProposalId, selectedMember.Address.Hex(), math.RoundDown(eth.WeiToEth(fineAmountWei), 6))
     return nil
 
 }
@@ -1,11 +1,11 @@
 package odao
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,12 +22,15 @@ func proposeLeave(c *cli.Context) error {
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose leaving:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose leaving:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         if canPropose.InsufficientMembers {
-            fmt.Println("There are not enough members in the oracle DAO to allow a member to leave.")
+            cliutils.Println("There are not enough members in the oracle DAO to allow a member to leave.")
         }
         return nil
     }
@@ -38,8 +41,13 @@ func proposeLeave(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a leave proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a leave proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -1,12 +1,12 @@
 package odao
 
 import (
-    "fmt"
 
     "github.com/ethereum/go-ethereum/common"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -23,12 +23,15 @@ func proposeReplace(c *cli.Context, memberAddress common.Address, memberId, memb
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose member replacement:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose member replacement:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         if canPropose.MemberAlreadyExists {
-            fmt.Printf("The node %s is already a member of the oracle DAO.\n", memberAddress.Hex())
+            cliutils.Printf("The node %s is already a member of the oracle DAO.\n", memberAddress.Hex())
         }
         return nil
     }
@@ -39,8 +42,13 @@ func proposeReplace(c *cli.Context, memberAddress common.Address, memberId, memb
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a replacement proposal with ID %d for node %s.\n", response.ProposalId, memberAddress.Hex())
+    cliutils.Printf("Successfully submitted a replacement proposal with ID %d for node %s.\n", response.ProposalId, memberAddress.Hex())
     return nil
 
 }
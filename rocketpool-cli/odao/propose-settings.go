@@ -1,12 +1,12 @@
 package odao
 
 import (
-    "fmt"
 
     "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -23,9 +23,12 @@ func proposeSettingMembersQuorum(c *cli.Context, quorumPercent float64) error {
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -36,8 +39,13 @@ func proposeSettingMembersQuorum(c *cli.Context, quorumPercent float64) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a members.quorum setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a members.quorum setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -56,9 +64,12 @@ func proposeSettingMembersRplBond(c *cli.Context, bondAmountEth float64) error {
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -69,8 +80,13 @@ func proposeSettingMembersRplBond(c *cli.Context, bondAmountEth float64) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a members.rplbond setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a members.rplbond setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -89,9 +105,12 @@ func proposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax uint6
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -102,8 +121,13 @@ func proposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax uint6
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a members.minipool.unbonded.max setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a members.minipool.unbonded.max setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -122,9 +146,12 @@ func proposeSettingProposalCooldown(c *cli.Context, proposalCooldownBlocks uint6
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -135,8 +162,13 @@ func proposeSettingProposalCooldown(c *cli.Context, proposalCooldownBlocks uint6
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a proposal.cooldown setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a proposal.cooldown setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -155,9 +187,12 @@ func proposeSettingProposalVoteBlocks(c *cli.Context, proposalVoteBlocks uint64)
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -168,8 +203,13 @@ func proposeSettingProposalVoteBlocks(c *cli.Context, proposalVoteBlocks uint64)
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a proposal.vote.blocks setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a proposal.vote.blocks setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -188,9 +228,12 @@ func proposeSettingProposalVoteDelayBlocks(c *cli.Context, proposalDelayBlocks u
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -201,8 +244,13 @@ func proposeSettingProposalVoteDelayBlocks(c *cli.Context, proposalDelayBlocks u
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a proposal.vote.delay.blocks setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a proposal.vote.delay.blocks setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -221,9 +269,12 @@ func proposeSettingProposalExecuteBlocks(c *cli.Context, proposalExecuteBlocks u
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -234,8 +285,13 @@ func proposeSettingProposalExecuteBlocks(c *cli.Context, proposalExecuteBlocks u
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a proposal.execute.blocks setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a proposal.execute.blocks setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
@@ -254,9 +310,12 @@ func proposeSettingProposalActionBlocks(c *cli.Context, proposalActionBlocks uin
         return err
     }
     if !canPropose.CanPropose {
-        fmt.Println("Cannot propose setting update:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canPropose)
+        }
+        cliutils.Println("Cannot propose setting update:")
         if canPropose.ProposalCooldownActive {
-            fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
+            cliutils.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
         }
         return nil
     }
@@ -267,8 +326,13 @@ func proposeSettingProposalActionBlocks(c *cli.Context, proposalActionBlocks uin
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully submitted a proposal.action.blocks setting update proposal with ID %d.\n", response.ProposalId)
+    cliutils.Printf("Successfully submitted a proposal.action.blocks setting update proposal with ID %d.\n", response.ProposalId)
     return nil
 
 }
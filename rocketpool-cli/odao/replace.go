@@ -1,7 +1,6 @@
 package odao
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
@@ -23,29 +22,42 @@ func replace(c *cli.Context) error {
         return err
     }
     if !canReplace.CanReplace {
-        fmt.Println("Cannot replace the node's position in the oracle DAO:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canReplace)
+        }
+        cliutils.Println("Cannot replace the node's position in the oracle DAO:")
         if canReplace.ProposalExpired {
-            fmt.Println("The proposal to replace your node's position in the oracle DAO does not exist or has expired.")
+            cliutils.Println("The proposal to replace your node's position in the oracle DAO does not exist or has expired.")
         }
         if canReplace.MemberAlreadyExists {
-            fmt.Println("The replacing node is already a member of the oracle DAO.")
+            cliutils.Println("The replacing node is already a member of the oracle DAO.")
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to replace your node's position in the oracle DAO? This action cannot be undone!")) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, "Are you sure you want to replace your node's position in the oracle DAO? This action cannot be undone!")
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Replace node's position in the oracle DAO
-    if _, err := rp.ReplaceTNDAOMember(); err != nil {
+    response, err := rp.ReplaceTNDAOMember()
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Println("Successfully replaced the node's position in the oracle DAO.")
+    cliutils.Println("Successfully replaced the node's position in the oracle DAO.")
     return nil
 
 }
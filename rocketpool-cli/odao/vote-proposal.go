@@ -36,7 +36,7 @@ func voteOnProposal(c *cli.Context) error {
 
     // Check for votable proposals
     if len(votableProposals) == 0 {
-        fmt.Println("No proposals can be voted on.")
+        cliutils.Println("No proposals can be voted on.")
         return nil
     }
 
@@ -78,7 +78,10 @@ func voteOnProposal(c *cli.Context) error {
                 proposal.VotesFor,
                 proposal.VotesAgainst)
         }
-        selected, _ := cliutils.Select("Please select a proposal to vote on:", options)
+        selected, _, err := cliutils.Select("Please select a proposal to vote on:", options)
+        if err != nil {
+            return err
+        }
         selectedProposal = votableProposals[selected]
 
     }
@@ -96,7 +99,10 @@ func voteOnProposal(c *cli.Context) error {
     } else {
 
         // Prompt for support status
-        support = cliutils.Confirm("Would you like to vote in support of the proposal?")
+        support, err = cliutils.Confirm("Would you like to vote in support of the proposal?")
+        if err != nil {
+            return err
+        }
 
     }
     if support {
@@ -111,26 +117,39 @@ func voteOnProposal(c *cli.Context) error {
         return err
     }
     if !canVote.CanVote {
-        fmt.Println("Cannot vote on proposal:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canVote)
+        }
+        cliutils.Println("Cannot vote on proposal:")
         if canVote.JoinedAfterCreated {
-            fmt.Println("You cannot vote on proposals created before you joined the oracle DAO.")
+            cliutils.Println("You cannot vote on proposals created before you joined the oracle DAO.")
         }
         return nil
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to vote %s proposal %d? Your vote cannot be changed later.", supportLabel, selectedProposal.ID))) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to vote %s proposal %d? Your vote cannot be changed later.", supportLabel, selectedProposal.ID))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
     // Vote on proposal
-    if _, err := rp.VoteOnTNDAOProposal(selectedProposal.ID, support); err != nil {
+    response, err := rp.VoteOnTNDAOProposal(selectedProposal.ID, support)
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Printf("Successfully voted %s proposal %d.\n", supportLabel, selectedProposal.ID)
+    cliutils.Printf("Successfully voted %s proposal %d.\n", supportLabel, selectedProposal.ID)
     return nil
 
 }
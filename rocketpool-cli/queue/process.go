@@ -1,11 +1,11 @@
 package queue
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,26 +22,35 @@ func processQueue(c *cli.Context) error {
         return err
     }
     if !canProcess.CanProcess {
-        fmt.Println("The deposit queue cannot be processed:")
+        if cliutils.IsStructuredOutput(c) {
+            return cliutils.PrintOutput(c, canProcess)
+        }
+        cliutils.Println("The deposit queue cannot be processed:")
         if canProcess.AssignDepositsDisabled {
-            fmt.Println("Deposit assignments are currently disabled.")
+            cliutils.Println("Deposit assignments are currently disabled.")
         }
         if canProcess.NoMinipoolsAvailable {
-            fmt.Println("No minipools are available for assignment.")
+            cliutils.Println("No minipools are available for assignment.")
         }
         if canProcess.InsufficientDepositBalance {
-            fmt.Println("The deposit pool has an insufficient balance for assignment.")
+            cliutils.Println("The deposit pool has an insufficient balance for assignment.")
         }
         return nil
     }
 
     // Process deposit queue
-    if _, err := rp.ProcessQueue(); err != nil {
+    response, err := rp.ProcessQueue()
+    if err != nil {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Println("The deposit queue was successfully processed.")
+    cliutils.Println("The deposit queue was successfully processed.")
     return nil
 
 }
@@ -15,6 +15,8 @@ import (
     "github.com/rocket-pool/smartnode/rocketpool-cli/queue"
     "github.com/rocket-pool/smartnode/rocketpool-cli/service"
     "github.com/rocket-pool/smartnode/rocketpool-cli/wallet"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -90,6 +92,23 @@ ______           _        _    ______           _
             Name:  "gasLimit, l",
             Usage: "Desired gas limit",
         },
+        cli.BoolFlag{
+            Name:  "dry-run",
+            Usage: "Simulate transactions against the pending state instead of sending them",
+        },
+        cli.StringFlag{
+            Name:  "output",
+            Usage: "Output `format` (table, json or yaml); json and yaml print command results to stdout and all other output to stderr",
+            Value: cliutils.TableOutput,
+        },
+        cli.BoolFlag{
+            Name:  "non-interactive",
+            Usage: "Fail with a list of missing inputs instead of prompting for them",
+        },
+        cli.StringFlag{
+            Name:  "answers",
+            Usage: "YAML answers file `path` providing command options, by command name (e.g. 'node deposit')",
+        },
     }
 
     // Register commands
@@ -103,22 +122,55 @@ ______           _        _    ______           _
      service.RegisterCommands(app, "service",  []string{"s"})
       wallet.RegisterCommands(app, "wallet",   []string{"w"})
 
-    // Check user ID
+    // Apply answers file inputs to commands
+    cliutils.WrapCommandActions(app.Commands)
+
+    // Check user ID, set output format & load inputs
+    outputFormat := cliutils.TableOutput
     app.Before = func(c *cli.Context) error {
         if os.Getuid() == 0 && !c.GlobalBool("allow-root") {
             fmt.Fprintln(os.Stderr, "rocketpool should not be run as root. Please try again without 'sudo'.")
             fmt.Fprintln(os.Stderr, "If you want to run rocketpool as root anyway, use the '--allow-root' option to override this warning.")
             os.Exit(1)
         }
+        format, err := cliutils.ValidateOutputFormat("output format", c.GlobalString("output"))
+        if err != nil {
+            fmt.Fprintln(os.Stderr, err)
+            os.Exit(1)
+        }
+        cliutils.SetNonInteractive(c.GlobalBool("non-interactive"))
+        if c.GlobalString("answers") != "" {
+            if err := cliutils.LoadAnswers(c.GlobalString("answers")); err != nil {
+                fmt.Fprintln(os.Stderr, err)
+                os.Exit(1)
+            }
+        }
+        if format != cliutils.TableOutput {
+
+            // Keep stdout for structured output only; prompts & messages are printed to stderr
+            cliutils.SetOutput(os.Stdout)
+            cliutils.SetTextOutput(os.Stderr)
+            outputFormat = format
+
+        } else {
+            cliutils.Println("")
+        }
         return nil
     }
 
     // Run application
-    fmt.Println("")
-    if err := app.Run(os.Args); err != nil {
-        fmt.Println(err)
+    err := app.Run(os.Args)
+    if outputFormat != cliutils.TableOutput {
+        if err != nil {
+            cliutils.PrintFormattedOutput(outputFormat, api.APIResponse{Status: "error", Error: err.Error()})
+            os.Exit(1)
+        }
+        return
+    }
+    if err != nil {
+        cliutils.Println(err)
     }
-    fmt.Println("")
+    cliutils.Println("")
 
 }
 
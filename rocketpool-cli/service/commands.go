@@ -55,6 +55,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run command
                     return installService(c)
 
@@ -65,12 +68,29 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                 Name:      "config",
                 Aliases:   []string{"c"},
                 Usage:     "Configure the Rocket Pool service",
-                UsageText: "rocketpool service config",
+                UsageText: "rocketpool service config [options]",
+                Flags: []cli.Flag{
+                    cli.StringFlag{
+                        Name:  "eth1-client",
+                        Usage: "The ID of the Eth 1.0 client to run",
+                    },
+                    cli.StringFlag{
+                        Name:  "eth2-client",
+                        Usage: "The ID of the Eth 2.0 client to run (or 'random')",
+                    },
+                    cli.StringSliceFlag{
+                        Name:  "param, p",
+                        Usage: "A client parameter to set, as `ENV=value` using the parameter's environment variable name; this flag may be defined multiple times",
+                    },
+                },
                 Action: func(c *cli.Context) error {
 
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "eth1-client", "eth2-client"); err != nil { return err }
+
                     // Run command
                     return configureService(c)
 
@@ -97,12 +117,31 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                 Name:      "start",
                 Aliases:   []string{"s"},
                 Usage:     "Start the Rocket Pool service",
-                UsageText: "rocketpool service start",
+                UsageText: "rocketpool service start [options]",
+                Flags: []cli.Flag{
+                    cli.BoolFlag{
+                        Name:  "migrate-slashing-protection, m",
+                        Usage: "Migrate slashing protection history to a newly selected Eth 2.0 client without confirmation",
+                    },
+                    cli.BoolFlag{
+                        Name:  "waive-slashing-protection",
+                        Usage: "Start a newly selected Eth 2.0 client without migrating slashing protection history (DANGEROUS)",
+                    },
+                    cli.BoolFlag{
+                        Name:  "yes, y",
+                        Usage: "Automatically confirm waiving slashing protection history",
+                    },
+                },
                 Action: func(c *cli.Context) error {
 
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if c.Bool("waive-slashing-protection") {
+                        if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+                    }
+
                     // Run command
                     return startService(c)
 
@@ -125,6 +164,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run command
                     return pauseService(c)
 
@@ -146,6 +188,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run command
                     return pauseService(c)
 
@@ -168,6 +213,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                     // Validate args
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+
                     // Run command
                     return stopService(c)
 
@@ -3,7 +3,9 @@ package service
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli"
@@ -13,6 +15,9 @@ import (
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
+// Config
+const RandomClientID = "random"
+
 // Configure the Rocket Pool service
 func configureService(c *cli.Context) error {
 
@@ -31,28 +36,60 @@ func configureService(c *cli.Context) error {
         return err
     }
 
+    // Get client param values
+    paramValues := map[string]string{}
+    for _, param := range c.StringSlice("param") {
+        parts := strings.SplitN(param, "=", 2)
+        if len(parts) != 2 {
+            return fmt.Errorf("Invalid client parameter '%s' - parameters must be in the format ENV=value", param)
+        }
+        paramValues[parts[0]] = parts[1]
+    }
+
+    // Get current Eth 2.0 client
+    previousEth2Client := userConfig.Chains.Eth2.Client.Selected
+
     // Configure chains
-    if err := configureChain(&(globalConfig.Chains.Eth1), &(userConfig.Chains.Eth1), "Eth 1.0", false); err != nil {
+    if err := configureChain(&(globalConfig.Chains.Eth1), &(userConfig.Chains.Eth1), "Eth 1.0", false, c.String("eth1-client"), paramValues); err != nil {
         return err
     }
-    if err := configureChain(&(globalConfig.Chains.Eth2), &(userConfig.Chains.Eth2), "Eth 2.0", true); err != nil {
+    if err := configureChain(&(globalConfig.Chains.Eth2), &(userConfig.Chains.Eth2), "Eth 2.0", true, c.String("eth2-client"), paramValues); err != nil {
         return err
     }
 
+    // Require slashing protection history to be migrated if the Eth 2.0 client changed
+    // The client to migrate from is kept until migration is complete, or cleared if the previous client is selected again
+    // Migration is not required when validator keys are held by a remote signer, which keeps its own history
+    eth2Client := userConfig.Chains.Eth2.Client.Selected
+    mergedConfig := config.Merge(&globalConfig, &userConfig)
+    if mergedConfig.UsesRemoteSigner() {
+        userConfig.Chains.Eth2.Client.MigrateFrom = ""
+    } else if userConfig.Chains.Eth2.Client.MigrateFrom == "" && previousEth2Client != "" && previousEth2Client != eth2Client {
+        userConfig.Chains.Eth2.Client.MigrateFrom = previousEth2Client
+    } else if userConfig.Chains.Eth2.Client.MigrateFrom == eth2Client {
+        userConfig.Chains.Eth2.Client.MigrateFrom = ""
+    }
+
     // Save user config
     if err := rp.SaveUserConfig(userConfig); err != nil {
         return err
     }
 
     // Log & return
-    fmt.Println("Done! Run 'rocketpool service start' to apply new configuration settings.")
+    if userConfig.Chains.Eth2.Client.MigrateFrom != "" {
+        cliutils.Printf("The Eth 2.0 client has changed, so the slashing protection history of your validators must be migrated to %s before it is started.\n", globalConfig.Chains.Eth2.GetSelectedClient().Name)
+        cliutils.Println("This will be done when you run 'rocketpool service start'.")
+        cliutils.Println("")
+    }
+    cliutils.Println("Done! Run 'rocketpool service start' to apply new configuration settings.")
     return nil
 
 }
 
 
 // Configure a chain
-func configureChain(globalChain, userChain *config.Chain, chainName string, defaultRandomClient bool) error {
+// The client & its params are prompted for if not specified
+func configureChain(globalChain, userChain *config.Chain, chainName string, defaultRandomClient bool, clientId string, paramValues map[string]string) error {
 
     // Check client options
     if len(globalChain.Client.Options) == 0 {
@@ -61,8 +98,14 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
 
     // Prompt for random client selection
     var randomClient bool
-    if defaultRandomClient {
-        randomClient = cliutils.Confirm(fmt.Sprintf("Would you like to run a random %s client (recommended)?", chainName))
+    var err error
+    if clientId != "" {
+        randomClient = (defaultRandomClient && clientId == RandomClientID)
+    } else if defaultRandomClient {
+        randomClient, err = cliutils.Confirm(fmt.Sprintf("Would you like to run a random %s client (recommended)?", chainName))
+        if err != nil {
+            return err
+        }
     }
 
     // Select client
@@ -70,6 +113,18 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
     if randomClient {
         rand.Seed(time.Now().UnixNano())
         selected = rand.Intn(len(globalChain.Client.Options))
+    } else if clientId != "" {
+        selected = -1
+        clientIds := make([]string, len(globalChain.Client.Options))
+        for oi, option := range globalChain.Client.Options {
+            clientIds[oi] = option.ID
+            if option.ID == clientId {
+                selected = oi
+            }
+        }
+        if selected == -1 {
+            return fmt.Errorf("Invalid %s client '%s' - valid clients are '%s'", chainName, clientId, strings.Join(clientIds, "', '"))
+        }
     } else {
         clientOptions := make([]string, len(globalChain.Client.Options))
         for oi, option := range globalChain.Client.Options {
@@ -79,7 +134,10 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
             }
             clientOptions[oi] = optionText
         }
-        selected, _ = cliutils.Select(fmt.Sprintf("Which %s client would you like to run?", chainName), clientOptions)
+        selected, _, err = cliutils.Select(fmt.Sprintf("Which %s client would you like to run?", chainName), clientOptions)
+        if err != nil {
+            return err
+        }
     }
 
     // Set selected client
@@ -87,11 +145,12 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
     userChain.Client.Selected = globalChain.Client.Options[selected].ID
 
     // Log
-    fmt.Printf("%s %s client selected.\n", globalChain.GetSelectedClient().Name, chainName)
-    fmt.Println("")
+    cliutils.Printf("%s %s client selected.\n", globalChain.GetSelectedClient().Name, chainName)
+    cliutils.Println("")
 
     // Prompt for params
     params := []config.UserParam{}
+    missingParams := []string{}
     for _, param := range globalChain.GetSelectedClient().Params {
 
         // Get expected param format
@@ -117,11 +176,33 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
             paramText += fmt.Sprintf("\n(%s)", param.Desc)
         }
 
+        // Get value from params if set
+        value, ok := paramValues[param.Env]
+        if ok {
+            if !param.Required && value == "" {
+                value = param.Default
+            } else if !regexp.MustCompile(expectedFormat).MatchString(value) {
+                return fmt.Errorf("Invalid %s '%s'", param.Name, value)
+            } else if err := checkParamType(param, value); err != nil {
+                return err
+            }
+        } else if cliutils.IsNonInteractive() {
+
+            // Use defaults for optional params in non-interactive mode
+            if param.Required {
+                missingParams = append(missingParams, fmt.Sprintf("--param %s=<%s>", param.Env, param.Name))
+                continue
+            }
+            value = param.Default
+
+        }
+
         // Prompt for value
-        var value string
-        for {
-            value = cliutils.Prompt(fmt.Sprintf("Please enter the %s", paramText), expectedFormat, fmt.Sprintf("Invalid %s", param.Name))
-            isValid := true
+        for !ok && !cliutils.IsNonInteractive() {
+            value, err = cliutils.Prompt(fmt.Sprintf("Please enter the %s", paramText), expectedFormat, fmt.Sprintf("Invalid %s", param.Name))
+            if err != nil {
+                return err
+            }
 
             // Allow blanks for optional params
             if !param.Required && value == "" {
@@ -130,23 +211,11 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
             }
 
             // Type checking
-            switch param.Type {
-            case "uint":
-                if _, err := strconv.ParseUint(value, 0, 0); err != nil {
-                    fmt.Printf("'%s' is not a valid value for %s, try again.\n", value, param.Name)
-                    isValid = false
-                }
-    
-            case "uint16":
-                if _, err := strconv.ParseUint(value, 0, 16); err != nil {
-                    fmt.Printf("'%s' is not a valid value for %s, try again.\n", value, param.Name)
-                    isValid = false
-                }
-            }
-            
-            if isValid {
-                break
+            if err := checkParamType(param, value); err != nil {
+                cliutils.Printf("%s, try again.\n", err.Error())
+                continue
             }
+            break
         }
 
         // Add param
@@ -157,6 +226,11 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
 
     }
 
+    // Check for missing params
+    if len(missingParams) > 0 {
+        return &cliutils.MissingInputError{Inputs: missingParams}
+    }
+
     // Set unselected client params to blank strings to prevent docker-compose warnings
     for _, option := range globalChain.Client.Options {
         if option.ID == globalChain.Client.Selected { continue }
@@ -189,3 +263,18 @@ func configureChain(globalChain, userChain *config.Chain, chainName string, defa
 
 }
 
+
+// Check a client param value's type
+func checkParamType(param config.ClientParam, value string) error {
+    var err error
+    switch param.Type {
+    case "uint":
+        _, err = strconv.ParseUint(value, 0, 0)
+    case "uint16":
+        _, err = strconv.ParseUint(value, 0, 16)
+    }
+    if err != nil {
+        return fmt.Errorf("'%s' is not a valid value for %s", value, param.Name)
+    }
+    return nil
+}
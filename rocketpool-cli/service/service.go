@@ -22,11 +22,15 @@ func installService(c *cli.Context) error {
     }
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf(
+    confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf(
         "The Rocket Pool service will be installed %s --\nNetwork: %s\nVersion: %s\n\nAny existing configuration will be overwritten.\nAre you sure you want to continue?",
         location, c.String("network"), c.String("version"),
-    ))) {
-        fmt.Println("Cancelled.")
+    ))
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
@@ -40,15 +44,15 @@ func installService(c *cli.Context) error {
     if err != nil { return err }
 
     // Print success message & return
-    fmt.Println("")
-    fmt.Printf("The Rocket Pool service was successfully installed %s!\n", location)
+    cliutils.Println("")
+    cliutils.Printf("The Rocket Pool service was successfully installed %s!\n", location)
     if c.GlobalString("host") == "" {
-        fmt.Println("")
-        fmt.Println("Please start a new shell session to apply updated user permissions.")
-        fmt.Println("(To start a new shell session, log out and back in.)")
-        fmt.Println("")
+        cliutils.Println("")
+        cliutils.Println("Please start a new shell session to apply updated user permissions.")
+        cliutils.Println("(To start a new shell session, log out and back in.)")
+        cliutils.Println("")
     }
-    fmt.Println("Run 'rocketpool service config' to configure the service before starting it.")
+    cliutils.Println("Run 'rocketpool service config' to configure the service before starting it.")
     return nil
 
 }
@@ -76,18 +80,131 @@ func startService(c *cli.Context) error {
     if err != nil { return err }
     defer rp.Close()
 
+    // Check slashing protection history has been migrated to the validator client
+    if migrated, err := migrateSlashingProtection(c, rp); err != nil {
+        return err
+    } else if !migrated {
+        cliutils.Println("Cancelled.")
+        return nil
+    }
+
     // Start service
     return rp.StartService(getComposeFiles(c))
 
 }
 
 
+// Migrate slashing protection history to a newly selected validator client, or waive it
+// Returns false if the validator client can't be started
+func migrateSlashingProtection(c *cli.Context, rp *rocketpool.Client) (bool, error) {
+
+    // Load config
+    cfg, err := rp.LoadMergedConfig()
+    if err != nil {
+        return false, err
+    }
+
+    // Check for pending migration
+    migrateFrom := cfg.Chains.Eth2.Client.MigrateFrom
+    if migrateFrom == "" {
+        return true, nil
+    }
+    eth2Client := cfg.GetSelectedEth2Client()
+    if eth2Client == nil {
+        return true, nil
+    }
+    previousClient := cfg.Chains.Eth2.GetClient(migrateFrom)
+    previousClientName := migrateFrom
+    if previousClient != nil {
+        previousClientName = previousClient.Name
+    }
+
+    // Waive migration
+    if c.Bool("waive-slashing-protection") {
+        confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to start %s WITHOUT the slashing protection history from %s? Your validators may be SLASHED if they were active recently!", eth2Client.Name, previousClientName))
+        if err != nil {
+            return false, err
+        }
+        if !confirmed {
+            return false, nil
+        }
+        return true, clearSlashingProtectionMigration(rp)
+    }
+
+    // Check that history can be exported from the previous client's database and imported into the new client's
+    // The migration is kept pending otherwise, until the history is migrated manually or the migration is waived
+    if previousClient == nil || !previousClient.CanExportSlashingProtection() || !eth2Client.CanImportSlashingProtection() {
+        return false, fmt.Errorf("%s can't be started until the slashing protection history of your validators has been migrated from %s, which can't be done automatically.\n" +
+            "Please export it with %s's own tools and import it with 'rocketpool wallet import-slashing-protection'.\n" +
+            "To start without it (DANGEROUS), run 'rocketpool service start --waive-slashing-protection'.", eth2Client.Name, previousClientName, previousClientName)
+    }
+
+    // Prompt for migration
+    migrate := c.Bool("migrate-slashing-protection")
+    if !migrate && !cliutils.IsNonInteractive() {
+        migrate, err = cliutils.Confirm(fmt.Sprintf(
+            "The Eth 2.0 client has changed from %s to %s.\nThe slashing protection history of your validators must be migrated before %s is started.\nWould you like to migrate it now?",
+            previousClientName, eth2Client.Name, eth2Client.Name,
+        ))
+        if err != nil {
+            return false, err
+        }
+    }
+    if !migrate {
+        return false, fmt.Errorf("%s can't be started until the slashing protection history of your validators has been migrated from %s.\n" +
+            "Run 'rocketpool service start --migrate-slashing-protection' to migrate it, or use 'rocketpool wallet export-slashing-protection' and 'rocketpool wallet import-slashing-protection'.\n" +
+            "To start without it (DANGEROUS), run 'rocketpool service start --waive-slashing-protection'.", eth2Client.Name, previousClientName)
+    }
+
+    // Stop the previous validator client so that it can't sign after its history is exported
+    composeFiles := getComposeFiles(c)
+    if err := rp.StopValidator(composeFiles); err != nil {
+        return false, err
+    }
+
+    // Export history from the previous validator client's database, add beacon chain watermarks & import it into the new client's
+    if err := rp.ExportClientSlashingProtection(composeFiles, migrateFrom, rocketpool.SlashingProtectionExportFile); err != nil {
+        return false, err
+    }
+    if _, err := rp.ExportSlashingProtection(rocketpool.SlashingProtectionExportFile); err != nil {
+        return false, err
+    }
+    if err := rp.ImportClientSlashingProtection(composeFiles, eth2Client.ID, rocketpool.SlashingProtectionExportFile); err != nil {
+        return false, err
+    }
+    response, err := rp.ImportSlashingProtection(rocketpool.SlashingProtectionExportFile)
+    if err != nil {
+        return false, err
+    }
+    cliutils.Printf("Migrated slashing protection history for %d validator(s) from %s to %s.\n", len(response.ValidatorKeys), previousClientName, eth2Client.Name)
+
+    // Clear migration & return
+    return true, clearSlashingProtectionMigration(rp)
+
+}
+
+
+// Clear a pending slashing protection history migration
+func clearSlashingProtectionMigration(rp *rocketpool.Client) error {
+    userConfig, err := rp.LoadUserConfig()
+    if err != nil {
+        return err
+    }
+    userConfig.Chains.Eth2.Client.MigrateFrom = ""
+    return rp.SaveUserConfig(userConfig)
+}
+
+
 // Pause the Rocket Pool service
 func pauseService(c *cli.Context) error {
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to pause the Rocket Pool service? Any staking minipools will be penalized!")) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, "Are you sure you want to pause the Rocket Pool service? Any staking minipools will be penalized!")
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
@@ -106,8 +223,12 @@ func pauseService(c *cli.Context) error {
 func stopService(c *cli.Context) error {
 
     // Prompt for confirmation
-    if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to terminate the Rocket Pool service? Any staking minipools will be penalized, chain databases will be deleted, and ethereum nodes will lose ALL sync progress!")) {
-        fmt.Println("Cancelled.")
+    confirmed, err := cliutils.ConfirmUnlessYes(c, "Are you sure you want to terminate the Rocket Pool service? Any staking minipools will be penalized, chain databases will be deleted, and ethereum nodes will lose ALL sync progress!")
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
         return nil
     }
 
@@ -150,6 +271,15 @@ func serviceStats(c *cli.Context) error {
 }
 
 
+// Service version information
+type serviceVersionInfo struct {
+    ClientVersion string                `json:"clientVersion"`
+    ServiceVersion string               `json:"serviceVersion"`
+    Eth1Client string                   `json:"eth1Client"`
+    Eth2Client string                   `json:"eth2Client"`
+}
+
+
 // View the Rocket Pool service version information
 func serviceVersion(c *cli.Context) error {
 
@@ -188,11 +318,21 @@ func serviceVersion(c *cli.Context) error {
         eth2ClientVersion = "(none)"
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, serviceVersionInfo{
+            ClientVersion: c.App.Version,
+            ServiceVersion: serviceVersion,
+            Eth1Client: eth1ClientVersion,
+            Eth2Client: eth2ClientVersion,
+        })
+    }
+
     // Print version info
-    fmt.Printf("Rocket Pool client version: %s\n", c.App.Version)
-    fmt.Printf("Rocket Pool service version: %s\n", serviceVersion)
-    fmt.Printf("Selected Eth 1.0 client: %s\n", eth1ClientVersion)
-    fmt.Printf("Selected Eth 2.0 client: %s\n", eth2ClientVersion)
+    cliutils.Printf("Rocket Pool client version: %s\n", c.App.Version)
+    cliutils.Printf("Rocket Pool service version: %s\n", serviceVersion)
+    cliutils.Printf("Selected Eth 1.0 client: %s\n", eth1ClientVersion)
+    cliutils.Printf("Selected Eth 2.0 client: %s\n", eth2ClientVersion)
     return nil
 
 }
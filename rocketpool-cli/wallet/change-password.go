@@ -0,0 +1,115 @@
+package wallet
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+
+func changePassword(c *cli.Context) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Get & check wallet status
+    status, err := rp.WalletStatus()
+    if err != nil {
+        return err
+    }
+    if !status.WalletInitialized {
+        cliutils.Println("The node wallet is not initialized.")
+        return nil
+    }
+
+    // Load config
+    cfg, err := rp.LoadMergedConfig()
+    if err != nil {
+        return err
+    }
+
+    // Check password provider; passwords provided by the process supervisor can't be changed here
+    passwordProvider, err := cfg.GetPasswordProvider()
+    if err != nil {
+        return err
+    }
+    if passwordProvider == config.EnvPasswordProvider || passwordProvider == config.FdPasswordProvider {
+        return fmt.Errorf("The node password is provided by the '%s' password provider and can't be changed here. Please update it where it is provided and restart the Rocket Pool service.", passwordProvider)
+    }
+
+    // Get current password
+    currentPassword := c.String("current-password")
+    if currentPassword == "" {
+        currentPassword, err = cliutils.PromptPassword("Please enter your current wallet password:", "^.+$", "Please enter your current wallet password:")
+        if err != nil {
+            return err
+        }
+    }
+
+    // Get new password
+    newPassword := c.String("new-password")
+    if newPassword == "" {
+        newPassword, err = promptPassword()
+        if err != nil {
+            return err
+        }
+    }
+
+    // Prompt for confirmation
+    confirmed, err := cliutils.ConfirmUnlessYes(c, "The node & watchtower daemons and the validator client must be stopped while the password is changed, and will be restarted with the new password. Would you like to continue?")
+    if err != nil {
+        return err
+    }
+    if !confirmed {
+        cliutils.Println("Cancelled.")
+        return nil
+    }
+
+    // Stop daemons & validator client, so that none of them re-write the wallet or validator keystores with the current password
+    if err := rp.StopDaemons(nil); err != nil {
+        return err
+    }
+    if err := rp.StopValidator(nil); err != nil {
+        return err
+    }
+
+    // Change password
+    response, err := rp.ChangePassword(currentPassword, newPassword)
+
+    // Restart daemons & validator client; if a migration is pending, the validator client is replaced when the service is started
+    if startErr := rp.StartDaemons(nil); startErr != nil && err == nil {
+        err = startErr
+    }
+    if cfg.Chains.Eth2.Client.MigrateFrom == "" {
+        if startErr := rp.StartValidator(nil); startErr != nil && err == nil {
+            err = startErr
+        }
+    }
+    if err != nil {
+        return err
+    }
+
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
+    // Log
+    cliutils.Println("The node wallet password was successfully changed.")
+    if len(response.Keystores) > 0 {
+        cliutils.Printf("The wallet and the %s validator keystores were re-encrypted with the new password.\n", strings.Join(response.Keystores, ", "))
+    } else {
+        cliutils.Println("The wallet was re-encrypted with the new password. Validator keys have their own secrets and were not affected.")
+    }
+
+    // Return
+    return nil
+
+}
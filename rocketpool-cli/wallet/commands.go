@@ -96,6 +96,71 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                 },
             },
 
+            cli.Command{
+                Name:      "unlock",
+                Aliases:   []string{"u"},
+                Usage:     "Enter the node password if it is not set, e.g. after the API server starts with the prompt password provider",
+                UsageText: "rocketpool wallet unlock [options]",
+                Flags: []cli.Flag{
+                    cli.StringFlag{
+                        Name:  "password, p",
+                        Usage: "The node password",
+                    },
+                },
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                    // Validate flags
+                    if c.String("password") != "" {
+                        if _, err := cliutils.ValidateNodePassword("password", c.String("password")); err != nil { return err }
+                    }
+
+                    // Run
+                    return unlockWallet(c)
+
+                },
+            },
+
+            cli.Command{
+                Name:      "change-password",
+                Aliases:   []string{"p"},
+                Usage:     "Change the node wallet password, re-encrypting the wallet and validator keystores",
+                UsageText: "rocketpool wallet change-password [options]",
+                Flags: []cli.Flag{
+                    cli.StringFlag{
+                        Name:  "current-password, c",
+                        Usage: "The current wallet password",
+                    },
+                    cli.StringFlag{
+                        Name:  "new-password, n",
+                        Usage: "The new password to secure the wallet with",
+                    },
+                    cli.BoolFlag{
+                        Name:  "yes, y",
+                        Usage: "Automatically confirm stopping the Rocket Pool daemons and validator client",
+                    },
+                },
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                    // Validate flags
+                    if c.String("new-password") != "" {
+                        if _, err := cliutils.ValidateNodePassword("new password", c.String("new-password")); err != nil { return err }
+                    }
+
+                    // Check inputs
+                    if err := cliutils.RequireInputs(c, "current-password", "new-password"); err != nil { return err }
+
+                    // Run
+                    return changePassword(c)
+
+                },
+            },
+
             cli.Command{
                 Name:      "rebuild",
                 Aliases:   []string{"b"},
@@ -128,6 +193,101 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
                 },
             },
 
+            cli.Command{
+                Name:      "keys",
+                Aliases:   []string{"k"},
+                Usage:     "Manage validator keystores",
+                Subcommands: []cli.Command{
+
+                    cli.Command{
+                        Name:      "verify",
+                        Aliases:   []string{"v"},
+                        Usage:     "Check the validator keys in each validator client keystore against the node wallet and minipools",
+                        UsageText: "rocketpool wallet keys verify [options]",
+                        Flags: []cli.Flag{
+                            cli.BoolFlag{
+                                Name:  "repair, r",
+                                Usage: "Restore missing and invalid keys from the node wallet",
+                            },
+                            cli.BoolFlag{
+                                Name:  "delete-extra",
+                                Usage: "Delete keys derived from the node wallet with no validating minipool, unless their minipool is open or their validator has not exited",
+                            },
+                            cli.BoolFlag{
+                                Name:  "yes, y",
+                                Usage: "Automatically confirm repairs and deletions",
+                            },
+                        },
+                        Action: func(c *cli.Context) error {
+
+                            // Validate args
+                            if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                            // Check inputs
+                            if c.Bool("repair") || c.Bool("delete-extra") {
+                                if err := cliutils.RequireInputs(c, "yes"); err != nil { return err }
+                            }
+
+                            // Run
+                            return verifyKeys(c)
+
+                        },
+                    },
+
+                },
+            },
+
+            cli.Command{
+                Name:      "export-slashing-protection",
+                Usage:     "Export slashing protection history for the node's validators in EIP-3076 interchange format",
+                UsageText: "rocketpool wallet export-slashing-protection [options]",
+                Flags: []cli.Flag{
+                    cli.StringFlag{
+                        Name:  "client, c",
+                        Usage: "The Eth 2.0 client to export history from (defaults to the previous client if a migration is pending, or the selected client)",
+                    },
+                    cli.StringFlag{
+                        Name:  "file, f",
+                        Usage: "The file to save the interchange to (prints to stdout if not set)",
+                    },
+                    cli.BoolFlag{
+                        Name:  "yes, y",
+                        Usage: "Automatically confirm stopping the validator client",
+                    },
+                },
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                    // Run
+                    return exportSlashingProtection(c)
+
+                },
+            },
+
+            cli.Command{
+                Name:      "import-slashing-protection",
+                Usage:     "Import EIP-3076 slashing protection history into the selected Eth 2.0 client",
+                UsageText: "rocketpool wallet import-slashing-protection [options] file",
+                Flags: []cli.Flag{
+                    cli.BoolFlag{
+                        Name:  "yes, y",
+                        Usage: "Automatically confirm stopping the validator client",
+                    },
+                },
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 1); err != nil { return err }
+                    path := c.Args().Get(0)
+
+                    // Run
+                    return importSlashingProtection(c, path)
+
+                },
+            },
+
         },
     })
 }
@@ -0,0 +1,117 @@
+package wallet
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "os"
+
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+
+// Config
+const SlashingProtectionFileMode = 0644
+
+
+func exportSlashingProtection(c *cli.Context) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Load config
+    cfg, err := rp.LoadMergedConfig()
+    if err != nil {
+        return err
+    }
+
+    // Get validator client to export from; defaults to the previous client if a migration is pending, or the selected client
+    clientId := c.String("client")
+    if clientId == "" {
+        if cfg.Chains.Eth2.Client.MigrateFrom != "" {
+            clientId = cfg.Chains.Eth2.Client.MigrateFrom
+        } else if cfg.GetSelectedEth2Client() != nil {
+            clientId = cfg.GetSelectedEth2Client().ID
+        } else {
+            return errors.New("No Eth 2.0 client selected. Please specify a client with the '--client' option.")
+        }
+    }
+
+    client := cfg.Chains.Eth2.GetClient(clientId)
+    if client == nil {
+        return fmt.Errorf("Unknown Eth 2.0 client '%s'.", clientId)
+    }
+
+    // Export history from the validator client's database; history for keys held by a remote signer is kept by the node
+    if !cfg.UsesRemoteSigner() {
+
+        // Check validator client
+        if !client.CanExportSlashingProtection() {
+            return fmt.Errorf("Slashing protection history can't be exported from %s automatically. Please use %s's own tools to export it.", client.Name, client.Name)
+        }
+
+        // Prompt for confirmation
+        confirmed, err := cliutils.ConfirmUnlessYes(c, "The validator client must be stopped while its slashing protection history is exported. Would you like to continue?")
+        if err != nil {
+            return err
+        }
+        if !confirmed {
+            cliutils.Println("Cancelled.")
+            return nil
+        }
+
+        // Stop validator client, exporting & restarting it if it is the running client
+        // If a migration is pending, the running client is the previous client and is replaced when the service is started
+        if err := rp.StopValidator(nil); err != nil {
+            return err
+        }
+        err = rp.ExportClientSlashingProtection(nil, clientId, rocketpool.SlashingProtectionExportFile)
+        if cfg.Chains.Eth2.Client.MigrateFrom == "" {
+            if startErr := rp.StartValidator(nil); startErr != nil && err == nil {
+                err = startErr
+            }
+        }
+        if err != nil {
+            return err
+        }
+
+    }
+
+    // Export slashing protection history
+    response, err := rp.ExportSlashingProtection(rocketpool.SlashingProtectionExportFile)
+    if err != nil {
+        return err
+    }
+
+    // Print structured output unless an output file is specified
+    if cliutils.IsStructuredOutput(c) && c.String("file") == "" {
+        return cliutils.PrintOutput(c, response)
+    }
+
+    // Encode interchange
+    interchangeBytes, err := json.MarshalIndent(response.Interchange, "", "  ")
+    if err != nil {
+        return fmt.Errorf("Could not encode slashing protection interchange: %w", err)
+    }
+
+    // Print interchange
+    if c.String("file") == "" {
+        _, err := os.Stdout.Write(append(interchangeBytes, '\n'))
+        return err
+    }
+
+    // Save interchange
+    if err := ioutil.WriteFile(c.String("file"), interchangeBytes, SlashingProtectionFileMode); err != nil {
+        return fmt.Errorf("Could not write slashing protection interchange to %s: %w", c.String("file"), err)
+    }
+    cliutils.Printf("Slashing protection history for %d validator(s) exported from %s to %s.\n", len(response.Interchange.Data), client.Name, c.String("file"))
+    return nil
+
+}
+
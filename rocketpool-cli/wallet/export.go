@@ -1,11 +1,11 @@
 package wallet
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,7 +22,7 @@ func exportWallet(c *cli.Context) error {
         return err
     }
     if !status.WalletInitialized {
-        fmt.Println("The node wallet is not initialized.")
+        cliutils.Println("The node wallet is not initialized.")
         return nil
     }
 
@@ -32,21 +32,26 @@ func exportWallet(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, export)
+    }
+
     // Print wallet & return
-    fmt.Println("Node account private key:")
-    fmt.Println("")
-    fmt.Println(export.AccountPrivateKey)
-    fmt.Println("")
-    fmt.Println("Wallet password:")
-    fmt.Println("")
-    fmt.Println(export.Password)
-    fmt.Println("")
-    fmt.Println("Wallet file:")
-    fmt.Println("============")
-    fmt.Println("")
-    fmt.Println(export.Wallet)
-    fmt.Println("")
-    fmt.Println("============")
+    cliutils.Println("Node account private key:")
+    cliutils.Println("")
+    cliutils.Println(export.AccountPrivateKey)
+    cliutils.Println("")
+    cliutils.Println("Wallet password:")
+    cliutils.Println("")
+    cliutils.Println(export.Password)
+    cliutils.Println("")
+    cliutils.Println("Wallet file:")
+    cliutils.Println("============")
+    cliutils.Println("")
+    cliutils.Println(export.Wallet)
+    cliutils.Println("")
+    cliutils.Println("============")
     return nil
 
 }
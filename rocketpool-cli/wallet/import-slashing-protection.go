@@ -0,0 +1,117 @@
+package wallet
+
+import (
+    "errors"
+    "fmt"
+    "io/ioutil"
+
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+
+func importSlashingProtection(c *cli.Context, path string) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Load interchange
+    interchangeBytes, err := ioutil.ReadFile(path)
+    if err != nil {
+        return fmt.Errorf("Could not read slashing protection interchange at %s: %w", path, err)
+    }
+    if _, err := eth2.ParseSlashingProtectionInterchange(interchangeBytes); err != nil {
+        return err
+    }
+
+    // Load config & check validator client
+    cfg, err := rp.LoadMergedConfig()
+    if err != nil {
+        return err
+    }
+    client := cfg.GetSelectedEth2Client()
+    if client == nil {
+        return errors.New("No Eth 2.0 client selected. Please run 'rocketpool service config' and try again.")
+    }
+    remoteSigner := cfg.UsesRemoteSigner()
+    if !remoteSigner && !client.CanImportSlashingProtection() {
+        return fmt.Errorf("Slashing protection history can't be imported into %s automatically. Please use %s's own tools to import it.", client.Name, client.Name)
+    }
+
+    // Write interchange to the validator keychain folder & check it
+    if err := rp.WriteValidatorKeychainFile(rocketpool.SlashingProtectionImportFile, interchangeBytes); err != nil {
+        return err
+    }
+    response, err := rp.ImportSlashingProtection(rocketpool.SlashingProtectionImportFile)
+    if err != nil {
+        return err
+    }
+
+    // Import history into the validator client's database; history for keys held by a remote signer is imported by the node
+    migrating := (cfg.Chains.Eth2.Client.MigrateFrom != "")
+    if !remoteSigner {
+
+        // Prompt for confirmation
+        confirmed, err := cliutils.ConfirmUnlessYes(c, "The validator client must be stopped while slashing protection history is imported. Would you like to continue?")
+        if err != nil {
+            return err
+        }
+        if !confirmed {
+            cliutils.Println("Cancelled.")
+            return nil
+        }
+
+        // Stop validator client, importing & restarting it if it is the running client
+        // If a migration is pending, the running client is the previous client and is replaced when the service is started
+        if err := rp.StopValidator(nil); err != nil {
+            return err
+        }
+        err = rp.ImportClientSlashingProtection(nil, client.ID, rocketpool.SlashingProtectionImportFile)
+        if !migrating {
+            if startErr := rp.StartValidator(nil); startErr != nil && err == nil {
+                err = startErr
+            }
+        }
+        if err != nil {
+            return err
+        }
+
+    }
+
+    // Clear pending migration
+    if migrating {
+        userConfig, err := rp.LoadUserConfig()
+        if err != nil {
+            return err
+        }
+        userConfig.Chains.Eth2.Client.MigrateFrom = ""
+        if err := rp.SaveUserConfig(userConfig); err != nil {
+            return err
+        }
+    }
+
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
+    // Log & return
+    cliutils.Printf("Slashing protection history for %d validator(s) was successfully imported into %s.\n", len(response.ValidatorKeys), client.Name)
+    if len(response.MissingValidatorKeys) > 0 {
+        cliutils.Println("WARNING: the interchange has no history for the following minipool validators:")
+        for _, key := range response.MissingValidatorKeys {
+            cliutils.Println(key.Hex())
+        }
+    }
+    if migrating {
+        cliutils.Println("Run 'rocketpool service start' now to switch validator clients, so that the previous client stops signing.")
+    }
+    return nil
+
+}
+
@@ -1,11 +1,11 @@
 package wallet
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/term"
 )
 
@@ -23,17 +23,29 @@ func initWallet(c *cli.Context) error {
         return err
     }
     if status.WalletInitialized {
-        fmt.Println("The node wallet is already initialized.")
+        cliutils.Println("The node wallet is already initialized.")
         return nil
     }
 
+    // Check inputs; a password is only required if one is not already set
+    inputs := []string{"confirm-mnemonic"}
+    if !status.PasswordSet {
+        inputs = append(inputs, "password")
+    }
+    if err := cliutils.RequireInputs(c, inputs...); err != nil {
+        return err
+    }
+
     // Set password if not set
     if !status.PasswordSet {
         var password string
         if c.String("password") != "" {
             password = c.String("password")
         } else {
-            password = promptPassword()
+            password, err = promptPassword()
+            if err != nil {
+                return err
+            }
         }
         if _, err := rp.SetPassword(password); err != nil {
             return err
@@ -47,26 +59,33 @@ func initWallet(c *cli.Context) error {
     }
 
     // Print mnemonic
-    fmt.Println("Your mnemonic phrase to recover your wallet is printed below. It can be used to recover your node account and validator keys if they are lost.")
-    fmt.Println("Record this phrase somewhere secure and private. Do not share it with anyone as it will give them control of your node account and validators.")
-    fmt.Println("==============================================================================================================================================")
-    fmt.Println("")
-    fmt.Println(response.Mnemonic)
-    fmt.Println("")
-    fmt.Println("==============================================================================================================================================")
-    fmt.Println("")
+    cliutils.Println("Your mnemonic phrase to recover your wallet is printed below. It can be used to recover your node account and validator keys if they are lost.")
+    cliutils.Println("Record this phrase somewhere secure and private. Do not share it with anyone as it will give them control of your node account and validators.")
+    cliutils.Println("==============================================================================================================================================")
+    cliutils.Println("")
+    cliutils.Println(response.Mnemonic)
+    cliutils.Println("")
+    cliutils.Println("==============================================================================================================================================")
+    cliutils.Println("")
 
     // Confirm mnemonic
     if !c.Bool("confirm-mnemonic") {
-        confirmMnemonic(response.Mnemonic)
+        if err := confirmMnemonic(response.Mnemonic); err != nil {
+            return err
+        }
     }
 
     // Clear terminal output
-    term.Clear()
+    term.Clear(cliutils.TextOutput())
+
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
 
     // Log & return
-    fmt.Println("The node wallet was successfully initialized.")
-    fmt.Printf("Node account: %s\n", response.AccountAddress.Hex())
+    cliutils.Println("The node wallet was successfully initialized.")
+    cliutils.Printf("Node account: %s\n", response.AccountAddress.Hex())
     return nil
 
 }
@@ -1,11 +1,11 @@
 package wallet
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,12 +22,12 @@ func rebuildWallet(c *cli.Context) error {
         return err
     }
     if !status.WalletInitialized {
-        fmt.Println("The node wallet is not initialized.")
+        cliutils.Println("The node wallet is not initialized.")
         return nil
     }
 
     // Log
-    fmt.Println("Rebuilding node validator keystores...")
+    cliutils.Println("Rebuilding node validator keystores...")
 
     // Rebuild wallet
     response, err := rp.RebuildWallet()
@@ -35,15 +35,20 @@ func rebuildWallet(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Println("The node wallet was successfully rebuilt.")
+    cliutils.Println("The node wallet was successfully rebuilt.")
     if len(response.ValidatorKeys) > 0 {
-        fmt.Println("Validator keys:")
+        cliutils.Println("Validator keys:")
         for _, key := range response.ValidatorKeys {
-            fmt.Println(key.Hex())
+            cliutils.Println(key.Hex())
         }
     } else {
-        fmt.Println("No validator keys were found.")
+        cliutils.Println("No validator keys were found.")
     }
     return nil
 
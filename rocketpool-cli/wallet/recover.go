@@ -1,11 +1,11 @@
 package wallet
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,17 +22,29 @@ func recoverWallet(c *cli.Context) error {
         return err
     }
     if status.WalletInitialized {
-        fmt.Println("The node wallet is already initialized.")
+        cliutils.Println("The node wallet is already initialized.")
         return nil
     }
 
+    // Check inputs; a password is only required if one is not already set
+    inputs := []string{"mnemonic"}
+    if !status.PasswordSet {
+        inputs = append(inputs, "password")
+    }
+    if err := cliutils.RequireInputs(c, inputs...); err != nil {
+        return err
+    }
+
     // Set password if not set
     if !status.PasswordSet {
         var password string
         if c.String("password") != "" {
             password = c.String("password")
         } else {
-            password = promptPassword()
+            password, err = promptPassword()
+            if err != nil {
+                return err
+            }
         }
         if _, err := rp.SetPassword(password); err != nil {
             return err
@@ -44,11 +56,14 @@ func recoverWallet(c *cli.Context) error {
     if c.String("mnemonic") != "" {
         mnemonic = c.String("mnemonic")
     } else {
-        mnemonic = promptMnemonic()
+        mnemonic, err = promptMnemonic()
+        if err != nil {
+            return err
+        }
     }
 
     // Log
-    fmt.Println("Recovering node wallet...")
+    cliutils.Println("Recovering node wallet...")
 
     // Recover wallet
     response, err := rp.RecoverWallet(mnemonic)
@@ -56,16 +71,21 @@ func recoverWallet(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
     // Log & return
-    fmt.Println("The node wallet was successfully recovered.")
-    fmt.Printf("Node account: %s\n", response.AccountAddress.Hex())
+    cliutils.Println("The node wallet was successfully recovered.")
+    cliutils.Printf("Node account: %s\n", response.AccountAddress.Hex())
     if len(response.ValidatorKeys) > 0 {
-        fmt.Println("Validator keys:")
+        cliutils.Println("Validator keys:")
         for _, key := range response.ValidatorKeys {
-            fmt.Println(key.Hex())
+            cliutils.Println(key.Hex())
         }
     } else {
-        fmt.Println("No validator keys were found.")
+        cliutils.Println("No validator keys were found.")
     }
     return nil
 
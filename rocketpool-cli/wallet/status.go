@@ -1,11 +1,11 @@
 package wallet
 
 import (
-    "fmt"
 
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -22,12 +22,17 @@ func getStatus(c *cli.Context) error {
         return err
     }
 
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, status)
+    }
+
     // Print status & return
     if status.WalletInitialized {
-        fmt.Println("The node wallet is initialized.")
-        fmt.Printf("Node account: %s\n", status.AccountAddress.Hex())
+        cliutils.Println("The node wallet is initialized.")
+        cliutils.Printf("Node account: %s\n", status.AccountAddress.Hex())
     } else {
-        fmt.Println("The node wallet has not been initialized.")
+        cliutils.Println("The node wallet has not been initialized.")
     }
     return nil
 
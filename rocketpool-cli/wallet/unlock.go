@@ -0,0 +1,58 @@
+package wallet
+
+import (
+    "fmt"
+
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/passwords"
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+
+func unlockWallet(c *cli.Context) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Get & check wallet status
+    status, err := rp.WalletStatus()
+    if err != nil {
+        return err
+    }
+    if status.PasswordSet {
+        cliutils.Println("The node password is already set.")
+        return nil
+    }
+
+    // Check inputs
+    if err := cliutils.RequireInputs(c, "password"); err != nil {
+        return err
+    }
+
+    // Get password
+    password := c.String("password")
+    if password == "" {
+        password, err = cliutils.PromptPassword(
+            "Please enter the node password:",
+            fmt.Sprintf("^.{%d,}$", passwords.MinPasswordLength),
+            fmt.Sprintf("Your password must be at least %d characters long. Please try again:", passwords.MinPasswordLength),
+        )
+        if err != nil {
+            return err
+        }
+    }
+
+    // Set password
+    if _, err := rp.SetPassword(password); err != nil {
+        return err
+    }
+
+    // Log & return
+    cliutils.Println("The node password was successfully entered.")
+    return nil
+
+}
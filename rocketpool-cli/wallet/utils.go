@@ -11,47 +11,59 @@ import (
 
 
 // Prompt for a wallet password
-func promptPassword() string {
+func promptPassword() (string, error) {
     for {
-        password := cliutils.PromptPassword(
+        password, err := cliutils.PromptPassword(
             "Please enter a password to secure your wallet with:",
             fmt.Sprintf("^.{%d,}$", passwords.MinPasswordLength),
             fmt.Sprintf("Your password must be at least %d characters long", passwords.MinPasswordLength),
         )
-        confirmation := cliutils.PromptPassword("Please confirm your password:", "^.*$", "")
+        if err != nil {
+            return "", err
+        }
+        confirmation, err := cliutils.PromptPassword("Please confirm your password:", "^.*$", "")
+        if err != nil {
+            return "", err
+        }
         if password == confirmation {
-            return password
+            return password, nil
         } else {
-            fmt.Println("Password confirmation does not match.")
-            fmt.Println("")
+            cliutils.Println("Password confirmation does not match.")
+            cliutils.Println("")
         }
     }
 }
 
 
 // Prompt for a recovery mnemonic phrase
-func promptMnemonic() string {
+func promptMnemonic() (string, error) {
     for {
-        mnemonic := cliutils.PromptPassword("Please enter your recovery mnemonic phrase:", "^.*$", "")
+        mnemonic, err := cliutils.PromptPassword("Please enter your recovery mnemonic phrase:", "^.*$", "")
+        if err != nil {
+            return "", err
+        }
         if bip39.IsMnemonicValid(mnemonic) {
-            return mnemonic
+            return mnemonic, nil
         } else {
-            fmt.Println("Invalid mnemonic phrase.")
-            fmt.Println("")
+            cliutils.Println("Invalid mnemonic phrase.")
+            cliutils.Println("")
         }
     }
 }
 
 
 // Confirm a recovery mnemonic phrase
-func confirmMnemonic(mnemonic string) {
+func confirmMnemonic(mnemonic string) error {
     for {
-        confirmation := cliutils.Prompt("Please enter your recorded mnemonic phrase to confirm it is correct:", "^.*$", "")
+        confirmation, err := cliutils.Prompt("Please enter your recorded mnemonic phrase to confirm it is correct:", "^.*$", "")
+        if err != nil {
+            return err
+        }
         if mnemonic == confirmation {
-            return
+            return nil
         } else {
-            fmt.Println("The mnemonic phrase you entered does not match your recovery phrase. Please try again.")
-            fmt.Println("")
+            cliutils.Println("The mnemonic phrase you entered does not match your recovery phrase. Please try again.")
+            cliutils.Println("")
         }
     }
 }
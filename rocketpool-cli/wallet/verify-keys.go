@@ -0,0 +1,141 @@
+package wallet
+
+import (
+    "fmt"
+
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+
+func verifyKeys(c *cli.Context) error {
+
+    // Get RP client
+    rp, err := rocketpool.NewClientFromCtx(c)
+    if err != nil { return err }
+    defer rp.Close()
+
+    // Get & check wallet status
+    status, err := rp.WalletStatus()
+    if err != nil {
+        return err
+    }
+    if !status.WalletInitialized {
+        cliutils.Println("The node wallet is not initialized.")
+        return nil
+    }
+
+    // Verify validator keys
+    response, err := rp.VerifyValidatorKeys(false, false)
+    if err != nil {
+        return err
+    }
+
+    // Repair validator keys & delete extra keys
+    repair := c.Bool("repair") && getRepairableKeyCount(response) > 0
+    deleteExtra := c.Bool("delete-extra") && getExtraKeyCount(response) > 0
+    if repair {
+        confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to repair %d validator key(s)? Missing and invalid keys will be restored from the node wallet.", getRepairableKeyCount(response)))
+        if err != nil {
+            return err
+        }
+        if !confirmed {
+            repair = false
+        }
+    }
+    if deleteExtra {
+        confirmed, err := cliutils.ConfirmUnlessYes(c, fmt.Sprintf("Are you sure you want to delete %d extra validator key(s)? Keys with a minipool or a validator on the beacon chain will be kept.", getExtraKeyCount(response)))
+        if err != nil {
+            return err
+        }
+        if !confirmed {
+            deleteExtra = false
+        }
+    }
+    if repair || deleteExtra {
+        response, err = rp.VerifyValidatorKeys(repair, deleteExtra)
+        if err != nil {
+            return err
+        }
+    }
+
+    // Print structured output
+    if cliutils.IsStructuredOutput(c) {
+        return cliutils.PrintOutput(c, response)
+    }
+
+    // Print keystore reports
+    cliutils.Printf("Checked validator keystores against %d validating minipool(s):\n", len(response.ValidatorKeys))
+    for _, keystore := range response.Keystores {
+        if keystore.Error != "" {
+            cliutils.Printf("- %s: ERROR: %s\n", keystore.Client, keystore.Error)
+            continue
+        }
+        cliutils.Printf("- %s: %d key(s)", keystore.Client, keystore.KeyCount)
+        if len(keystore.MissingKeys) + len(keystore.ExtraKeys) + len(keystore.UnknownKeys) + len(keystore.InvalidKeys) + len(keystore.DeletedKeys) == 0 {
+            cliutils.Println(", OK")
+            continue
+        }
+        cliutils.Println("")
+        for _, key := range keystore.MissingKeys {
+            cliutils.Printf("    Missing: %s\n", key.Hex())
+        }
+        for _, key := range keystore.InvalidKeys {
+            cliutils.Printf("    Invalid: %s (%s)\n", key.Pubkey.Hex(), key.Error)
+        }
+        for _, key := range keystore.ExtraKeys {
+            cliutils.Printf("    Extra (no validating minipool): %s\n", key.Hex())
+        }
+        for _, key := range keystore.DeletedKeys {
+            cliutils.Printf("    Deleted: %s\n", key.Hex())
+        }
+        for _, key := range keystore.RetainedKeys {
+            cliutils.Printf("    Kept: %s (%s)\n", key.Pubkey.Hex(), key.Error)
+        }
+        for _, key := range keystore.UnknownKeys {
+            cliutils.Printf("    Unknown (not derived from the node wallet): %s\n", key.Hex())
+        }
+    }
+    cliutils.Println("")
+
+    // Log & return
+    if response.Repaired || response.DeletedExtra {
+        cliutils.Println("The validator keystores were successfully updated. Please restart your validator client to load the updated keys.")
+        return nil
+    }
+    if getRepairableKeyCount(response) > 0 {
+        cliutils.Println("Run 'rocketpool wallet keys verify --repair' to restore missing and invalid keys.")
+    }
+    if getExtraKeyCount(response) > 0 {
+        cliutils.Println("Run 'rocketpool wallet keys verify --delete-extra' to delete extra keys of closed minipools whose validators have exited.")
+    }
+    if getRepairableKeyCount(response) + getExtraKeyCount(response) == 0 {
+        cliutils.Println("No repairs are needed.")
+    }
+    return nil
+
+}
+
+
+// Get the number of missing and invalid keys across all keystores
+func getRepairableKeyCount(response api.VerifyValidatorKeysResponse) int {
+    count := 0
+    for _, keystore := range response.Keystores {
+        count += len(keystore.MissingKeys) + len(keystore.InvalidKeys)
+    }
+    return count
+}
+
+
+// Get the number of extra keys across all keystores
+func getExtraKeyCount(response api.VerifyValidatorKeysResponse) int {
+    count := 0
+    for _, keystore := range response.Keystores {
+        count += len(keystore.ExtraKeys)
+    }
+    return count
+}
+
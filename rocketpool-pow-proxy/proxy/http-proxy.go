@@ -1,90 +1,212 @@
-package proxy
-
-import (
-    "errors"
-    "fmt"
-    "io"
-    "log"
-    "net/http"
-)
-
-
-// Config
-const InfuraURL = "https://%s.infura.io/v3/%s"
-
-
-// Proxy server
-type HttpProxyServer struct {
-    Port string
-    ProviderUrl string
-}
-
-
-// Create new proxy server
-func NewHttpProxyServer(port string, providerUrl string, network string, projectId string) *HttpProxyServer {
-
-    // Default provider to Infura
-    if providerUrl == "" {
-        providerUrl = fmt.Sprintf(InfuraURL, network, projectId)
-    }
-
-    // Create and return proxy server
-    return &HttpProxyServer{
-        Port: port,
-        ProviderUrl: providerUrl,
-    }
-
-}
-
-
-// Start proxy server
-func (p *HttpProxyServer) Start() error {
-
-    // Log
-    log.Printf("Proxy server listening on port %s\n", p.Port)
-
-    // Listen on RPC port
-    return http.ListenAndServe(":" + p.Port, p)
-
-}
-
-
-// Handle request / serve response
-func (p *HttpProxyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-
-    // Log request
-    log.Printf("New %s request received from %s\n", r.Method, r.RemoteAddr)
-
-    // Get request content type
-    contentTypes, ok := r.Header["Content-Type"]
-    if !ok || len(contentTypes) == 0 {
-        log.Println(errors.New("Request Content-Type header not specified"))
-        fmt.Fprintln(w, errors.New("Request Content-Type header not specified"))
-        return
-    }
-
-    // Forward request to provider
-    response, err := http.Post(p.ProviderUrl, contentTypes[0], r.Body)
-    if err != nil {
-        log.Println(fmt.Errorf("Error forwarding request to remote server: %w", err))
-        fmt.Fprintln(w, fmt.Errorf("Error forwarding request to remote server: %w", err))
-        return
-    }
-    defer response.Body.Close()
-
-    // Set response writer header
-    w.Header().Set("Content-Type", "application/json")
-
-    // Copy provider response body to response writer
-    _, err = io.Copy(w, response.Body)
-    if err != nil {
-        log.Println(fmt.Errorf("Error reading response from remote server: %w", err))
-        fmt.Fprintln(w, fmt.Errorf("Error reading response from remote server: %w", err))
-        return
-    }
-
-    // Log success
-    log.Printf("Response sent to %s successfully\n", r.RemoteAddr)
-
-}
-
+package proxy
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "log"
+    "net/http"
+    "strings"
+)
+
+
+// Config
+const InfuraURL = "https://%s.infura.io/v3/%s"
+const StatusPath = "/status"
+
+
+// JSON-RPC error codes
+const (
+    ParseErrorCode = -32700
+    InvalidRequestCode = -32600
+    ServerErrorCode = -32000
+)
+
+
+// Proxy server
+type HttpProxyServer struct {
+    Port string
+    ProviderUrls []string
+    upstreams *upstreamPool
+}
+
+
+// JSON-RPC error
+type rpcError struct {
+    Code int                            `json:"code"`
+    Message string                      `json:"message"`
+}
+type rpcErrorResponse struct {
+    Version string                      `json:"jsonrpc"`
+    ID json.RawMessage                  `json:"id"`
+    Error rpcError                      `json:"error"`
+}
+
+
+// Proxy status response
+type statusResponse struct {
+    Active string                       `json:"active"`
+    Upstreams []UpstreamStatus          `json:"upstreams"`
+}
+
+
+// Create new proxy server
+// Provider URLs are tried in priority order
+func NewHttpProxyServer(port string, providerUrls []string, network string, projectId string) *HttpProxyServer {
+
+    // Default provider to Infura
+    if len(providerUrls) == 0 {
+        providerUrls = []string{fmt.Sprintf(InfuraURL, network, projectId)}
+    }
+
+    // Create and return proxy server
+    return &HttpProxyServer{
+        Port: port,
+        ProviderUrls: providerUrls,
+        upstreams: newUpstreamPool(providerUrls),
+    }
+
+}
+
+
+// Start proxy server
+func (p *HttpProxyServer) Start() error {
+
+    // Log
+    log.Printf("Proxy server listening on port %s with %d provider(s)\n", p.Port, len(p.ProviderUrls))
+
+    // Start provider health checks
+    go p.upstreams.runHealthChecks()
+
+    // Listen on RPC port
+    return http.ListenAndServe(":" + p.Port, p)
+
+}
+
+
+// Handle request / serve response
+func (p *HttpProxyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+    // Serve status
+    if r.Method == http.MethodGet && r.URL.Path == StatusPath {
+        p.serveStatus(w)
+        return
+    }
+
+    // Log request
+    log.Printf("New %s request received from %s\n", r.Method, r.RemoteAddr)
+
+    // Get request content type
+    contentTypes, ok := r.Header["Content-Type"]
+    if !ok || len(contentTypes) == 0 {
+        log.Println(errors.New("Request Content-Type header not specified"))
+        writeRpcError(w, nil, InvalidRequestCode, "Request Content-Type header not specified")
+        return
+    }
+
+    // Read request body; it is re-sent on failover
+    body, err := ioutil.ReadAll(r.Body)
+    if err != nil {
+        log.Println(fmt.Errorf("Error reading request: %w", err))
+        writeRpcError(w, nil, ParseErrorCode, "Could not read request")
+        return
+    }
+
+    // Forward request to providers until one responds
+    var response *http.Response
+    var errs []string
+    for _, providerUrl := range p.upstreams.getProviderUrls() {
+        response, err = p.upstreams.forward(providerUrl, contentTypes[0], body)
+        if err == nil {
+            break
+        }
+        log.Println(fmt.Errorf("Error forwarding request to provider %s: %w", redactUrl(providerUrl), err))
+        p.upstreams.markFailed(providerUrl, err)
+        errs = append(errs, fmt.Sprintf("%s: %s", redactUrl(providerUrl), err.Error()))
+    }
+    if response == nil {
+        writeRpcError(w, body, ServerErrorCode, fmt.Sprintf("No provider could serve the request (%s)", strings.Join(errs, "; ")))
+        return
+    }
+    defer response.Body.Close()
+
+    // Set response writer header
+    w.Header().Set("Content-Type", "application/json")
+    w.WriteHeader(response.StatusCode)
+
+    // Copy provider response body to response writer
+    // Headers have been sent at this point, so errors can only be logged
+    _, err = io.Copy(w, response.Body)
+    if err != nil {
+        log.Println(fmt.Errorf("Error reading response from remote server: %w", err))
+        return
+    }
+
+    // Log success
+    log.Printf("Response sent to %s successfully\n", r.RemoteAddr)
+
+}
+
+
+// Serve the provider status
+func (p *HttpProxyServer) serveStatus(w http.ResponseWriter) {
+    active, upstreams := p.upstreams.getStatus()
+    w.Header().Set("Content-Type", "application/json")
+    if active == "" {
+        w.WriteHeader(http.StatusServiceUnavailable)
+    }
+    json.NewEncoder(w).Encode(statusResponse{
+        Active: active,
+        Upstreams: upstreams,
+    })
+}
+
+
+// Write a JSON-RPC error response
+// The request ID is echoed if the request can be decoded; batch requests receive an error for each request
+func writeRpcError(w http.ResponseWriter, body []byte, code int, message string) {
+
+    // Get request IDs
+    var request struct {
+        ID json.RawMessage              `json:"id"`
+    }
+    var batch []struct {
+        ID json.RawMessage              `json:"id"`
+    }
+    var response interface{}
+    if err := json.Unmarshal(body, &batch); err == nil && len(batch) > 0 {
+        responses := make([]rpcErrorResponse, len(batch))
+        for ri, batchRequest := range batch {
+            responses[ri] = newRpcErrorResponse(batchRequest.ID, code, message)
+        }
+        response = responses
+    } else {
+        json.Unmarshal(body, &request)
+        response = newRpcErrorResponse(request.ID, code, message)
+    }
+
+    // Write response
+    w.Header().Set("Content-Type", "application/json")
+    if err := json.NewEncoder(w).Encode(response); err != nil {
+        log.Println(fmt.Errorf("Error writing error response: %w", err))
+    }
+
+}
+
+
+// Create a JSON-RPC error response
+func newRpcErrorResponse(id json.RawMessage, code int, message string) rpcErrorResponse {
+    if len(id) == 0 {
+        id = json.RawMessage("null")
+    }
+    return rpcErrorResponse{
+        Version: "2.0",
+        ID: id,
+        Error: rpcError{
+            Code: code,
+            Message: message,
+        },
+    }
+}
@@ -0,0 +1,289 @@
+package proxy
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "log"
+    "net/http"
+    "net/url"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+)
+
+
+// Config
+const (
+    HealthCheckInterval = 15 * time.Second
+    HealthCheckTimeout = 5 * time.Second
+    ForwardTimeout = 30 * time.Second
+    MaxBlockLag = 5
+    MaxLatency = 3 * time.Second
+)
+
+
+// Upstream provider status
+type UpstreamStatus struct {
+    Url string                          `json:"url"`
+    Healthy bool                        `json:"healthy"`
+    BlockNumber uint64                  `json:"blockNumber"`
+    LatencyMs int64                     `json:"latencyMs"`
+    LastChecked int64                   `json:"lastChecked"`
+    Error string                        `json:"error,omitempty"`
+}
+
+
+// Upstream provider
+type upstream struct {
+    url string
+    healthy bool
+    blockNumber uint64
+    latency time.Duration
+    lastChecked time.Time
+    lastError string
+}
+
+
+// Upstream provider pool
+// Providers are used in priority order; the first healthy provider is active
+type upstreamPool struct {
+    upstreams []*upstream
+    client *http.Client
+    forwardClient *http.Client
+    lock sync.RWMutex
+}
+
+
+// Create new upstream provider pool
+// All providers are assumed healthy until checked
+func newUpstreamPool(providerUrls []string) *upstreamPool {
+    upstreams := make([]*upstream, len(providerUrls))
+    for ui, providerUrl := range providerUrls {
+        upstreams[ui] = &upstream{
+            url: providerUrl,
+            healthy: true,
+        }
+    }
+    return &upstreamPool{
+        upstreams: upstreams,
+        client: &http.Client{Timeout: HealthCheckTimeout},
+        forwardClient: &http.Client{Timeout: ForwardTimeout},
+    }
+}
+
+
+// Get provider URLs in the order they should be tried
+// Healthy providers are tried first, followed by unhealthy providers as a last resort
+func (p *upstreamPool) getProviderUrls() []string {
+    p.lock.RLock()
+    defer p.lock.RUnlock()
+    healthy := []string{}
+    unhealthy := []string{}
+    for _, u := range p.upstreams {
+        if u.healthy {
+            healthy = append(healthy, u.url)
+        } else {
+            unhealthy = append(unhealthy, u.url)
+        }
+    }
+    return append(healthy, unhealthy...)
+}
+
+
+// Mark a provider as unhealthy after a failed request
+// It is restored by the next successful health check
+func (p *upstreamPool) markFailed(providerUrl string, err error) {
+    p.lock.Lock()
+    defer p.lock.Unlock()
+    for _, u := range p.upstreams {
+        if u.url == providerUrl {
+            if u.healthy {
+                log.Printf("Provider %s failed and has been marked unhealthy: %s\n", redactUrl(u.url), err.Error())
+            }
+            u.healthy = false
+            u.lastError = err.Error()
+        }
+    }
+}
+
+
+// Get the status of all providers
+func (p *upstreamPool) getStatus() (string, []UpstreamStatus) {
+    p.lock.RLock()
+    defer p.lock.RUnlock()
+    var active string
+    statuses := make([]UpstreamStatus, len(p.upstreams))
+    for ui, u := range p.upstreams {
+        statuses[ui] = UpstreamStatus{
+            Url: redactUrl(u.url),
+            Healthy: u.healthy,
+            BlockNumber: u.blockNumber,
+            LatencyMs: u.latency.Milliseconds(),
+            Error: u.lastError,
+        }
+        if !u.lastChecked.IsZero() {
+            statuses[ui].LastChecked = u.lastChecked.Unix()
+        }
+        if active == "" && u.healthy {
+            active = statuses[ui].Url
+        }
+    }
+    return active, statuses
+}
+
+
+// Run provider health checks on an interval
+func (p *upstreamPool) runHealthChecks() {
+    for {
+        p.checkHealth()
+        time.Sleep(HealthCheckInterval)
+    }
+}
+
+
+// Check provider health
+// Providers are unhealthy if they fail to respond, respond slowly, or lag behind the highest block reported by any provider
+func (p *upstreamPool) checkHealth() {
+
+    // Check providers
+    type result struct {
+        blockNumber uint64
+        latency time.Duration
+        err error
+    }
+    results := make([]result, len(p.upstreams))
+    var wg sync.WaitGroup
+    for ui, u := range p.upstreams {
+        ui, providerUrl := ui, u.url
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            start := time.Now()
+            blockNumber, err := p.getBlockNumber(providerUrl)
+            results[ui] = result{blockNumber: blockNumber, latency: time.Since(start), err: err}
+        }()
+    }
+    wg.Wait()
+
+    // Get highest block number
+    var highestBlock uint64
+    for _, r := range results {
+        if r.err == nil && r.blockNumber > highestBlock {
+            highestBlock = r.blockNumber
+        }
+    }
+
+    // Update provider health
+    p.lock.Lock()
+    defer p.lock.Unlock()
+    now := time.Now()
+    for ui, u := range p.upstreams {
+        r := results[ui]
+        wasHealthy := u.healthy
+        u.lastChecked = now
+        u.latency = r.latency
+        switch {
+            case r.err != nil:
+                u.healthy = false
+                u.lastError = r.err.Error()
+            case highestBlock - r.blockNumber > MaxBlockLag:
+                u.healthy = false
+                u.blockNumber = r.blockNumber
+                u.lastError = fmt.Sprintf("Provider is %d blocks behind", highestBlock - r.blockNumber)
+            case r.latency > MaxLatency:
+                u.healthy = false
+                u.blockNumber = r.blockNumber
+                u.lastError = fmt.Sprintf("Provider responded in %s", r.latency)
+            default:
+                u.healthy = true
+                u.blockNumber = r.blockNumber
+                u.lastError = ""
+        }
+        if u.healthy != wasHealthy {
+            if u.healthy {
+                log.Printf("Provider %s is healthy at block %d\n", redactUrl(u.url), u.blockNumber)
+            } else {
+                log.Printf("Provider %s is unhealthy: %s\n", redactUrl(u.url), u.lastError)
+            }
+        }
+    }
+
+}
+
+
+// Get the latest block number from a provider
+func (p *upstreamPool) getBlockNumber(providerUrl string) (uint64, error) {
+
+    // Send request
+    response, err := p.client.Post(providerUrl, "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`))
+    if err != nil {
+        return 0, redactError(err)
+    }
+    defer response.Body.Close()
+    if response.StatusCode != http.StatusOK {
+        return 0, fmt.Errorf("Provider responded with status code %d", response.StatusCode)
+    }
+
+    // Decode response
+    body, err := ioutil.ReadAll(response.Body)
+    if err != nil {
+        return 0, err
+    }
+    var rpcResponse struct {
+        Result string                   `json:"result"`
+        Error *rpcError                 `json:"error"`
+    }
+    if err := json.Unmarshal(body, &rpcResponse); err != nil {
+        return 0, fmt.Errorf("Could not decode provider response: %w", err)
+    }
+    if rpcResponse.Error != nil {
+        return 0, errors.New(rpcResponse.Error.Message)
+    }
+
+    // Parse & return block number
+    blockNumber, err := strconv.ParseUint(strings.TrimPrefix(rpcResponse.Result, "0x"), 16, 64)
+    if err != nil {
+        return 0, fmt.Errorf("Invalid block number '%s': %w", rpcResponse.Result, err)
+    }
+    return blockNumber, nil
+
+}
+
+
+// Forward a request body to a provider
+// Provider HTTP errors and timeouts are returned as errors so that the request can be retried with another provider
+func (p *upstreamPool) forward(providerUrl string, contentType string, body []byte) (*http.Response, error) {
+    response, err := p.forwardClient.Post(providerUrl, contentType, bytes.NewReader(body))
+    if err != nil {
+        return nil, redactError(err)
+    }
+    if response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests {
+        response.Body.Close()
+        return nil, fmt.Errorf("Provider responded with status code %d", response.StatusCode)
+    }
+    return response, nil
+}
+
+
+// Remove credentials and paths (which may contain API keys) from a provider URL
+func redactUrl(providerUrl string) string {
+    parsed, err := url.Parse(providerUrl)
+    if err != nil {
+        return "invalid URL"
+    }
+    return fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host)
+}
+
+
+// Remove the provider URL from a request error
+func redactError(err error) error {
+    var urlErr *url.Error
+    if errors.As(err, &urlErr) {
+        return urlErr.Err
+    }
+    return err
+}
@@ -3,6 +3,7 @@ package main
 import (
     "log"
     "os"
+    "strings"
     "sync"
 
     "github.com/urfave/cli"
@@ -47,7 +48,7 @@ func main() {
         },
         cli.StringFlag{
             Name:  "providerUrl, u",
-            Usage: "External Eth 1.0 provider `URL` (defaults to Infura)",
+            Usage: "External Eth 1.0 provider `URL` (defaults to Infura); multiple comma-separated HTTP provider URLs may be specified in priority order for failover",
             Value: "",
         },
         cli.StringFlag{
@@ -65,20 +66,32 @@ func main() {
     // Set application action
     app.Action = func(c *cli.Context) error {
 
+        // Get provider URLs
+        providerUrls := []string{}
+        for _, providerUrl := range strings.Split(c.GlobalString("providerUrl"), ",") {
+            if providerUrl = strings.TrimSpace(providerUrl); providerUrl != "" {
+                providerUrls = append(providerUrls, providerUrl)
+            }
+        }
+        wsProviderUrl := ""
+        if len(providerUrls) > 0 {
+            wsProviderUrl = providerUrls[0]
+        }
+
         // We need a wait group since we have 2 HTTP listeners
         wg := new(sync.WaitGroup)
         wg.Add(2)
 
         // HTTP server
         go func() {
-            proxyServer := proxy.NewHttpProxyServer(c.GlobalString("port"), c.GlobalString("providerUrl"), c.GlobalString("network"), c.GlobalString("projectId"))
+            proxyServer := proxy.NewHttpProxyServer(c.GlobalString("port"), providerUrls, c.GlobalString("network"), c.GlobalString("projectId"))
             proxyServer.Start()
             wg.Done()
         }()
     
         // Websocket server
         go func() {
-            proxyServer := proxy.NewWsProxyServer(c.GlobalString("wsPort"), c.GlobalString("providerUrl"), c.GlobalString("network"), c.GlobalString("projectId"))
+            proxyServer := proxy.NewWsProxyServer(c.GlobalString("wsPort"), wsProviderUrl, c.GlobalString("network"), c.GlobalString("projectId"))
             proxyServer.Start()
             wg.Done()
         }()
@@ -11,6 +11,8 @@ import (
     "github.com/rocket-pool/smartnode/rocketpool/api/odao"
     "github.com/rocket-pool/smartnode/rocketpool/api/queue"
     "github.com/rocket-pool/smartnode/rocketpool/api/wallet"
+    apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -40,6 +42,45 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
        queue.RegisterSubcommands(&command, "queue",    []string{"q"})
       wallet.RegisterSubcommands(&command, "wallet",   []string{"w"})
 
+    // Register API server commands
+    command.Subcommands = append(command.Subcommands,
+        cli.Command{
+            Name:      "serve",
+            Usage:     "Serve API commands over HTTP, reusing services between requests",
+            UsageText: "rocketpool api serve [options]",
+            Flags: []cli.Flag{
+                cli.StringFlag{
+                    Name:  "address, a",
+                    Usage: "The TCP address (host:port) or Unix socket (unix:///path) to listen on; defaults to the smartnode apiAddress setting",
+                },
+            },
+            Action: func(c *cli.Context) error {
+
+                // Validate args
+                if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                // Run
+                return serve(c, app, name)
+
+            },
+        },
+        cli.Command{
+            Name:      "server-token",
+            Usage:     "Get the API server authentication token",
+            UsageText: "rocketpool api server-token",
+            Action: func(c *cli.Context) error {
+
+                // Validate args
+                if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                // Run
+                apiutils.Output(c).PrintResponse(getServerToken(c))
+                return nil
+
+            },
+        },
+    )
+
     // Register CLI command
     app.Commands = append(app.Commands, command)
 
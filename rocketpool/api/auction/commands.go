@@ -27,7 +27,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getStatus(c))
+                    api.Output(c).PrintResponse(getStatus(c))
                     return nil
 
                 },
@@ -44,7 +44,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getLots(c))
+                    api.Output(c).PrintResponse(getLots(c))
                     return nil
 
                 },
@@ -60,7 +60,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canCreateLot(c))
+                    api.Output(c).PrintResponse(canCreateLot(c))
                     return nil
 
                 },
@@ -76,7 +76,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(createLot(c))
+                    api.Output(c).PrintResponse(createLot(c))
                     return nil
 
                 },
@@ -94,7 +94,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canBidOnLot(c, lotIndex))
+                    api.Output(c).PrintResponse(canBidOnLot(c, lotIndex))
                     return nil
 
                 },
@@ -114,7 +114,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(bidOnLot(c, lotIndex, amountWei))
+                    api.Output(c).PrintResponse(bidOnLot(c, lotIndex, amountWei))
                     return nil
 
                 },
@@ -132,7 +132,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canClaimFromLot(c, lotIndex))
+                    api.Output(c).PrintResponse(canClaimFromLot(c, lotIndex))
                     return nil
 
                 },
@@ -150,7 +150,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(claimFromLot(c, lotIndex))
+                    api.Output(c).PrintResponse(claimFromLot(c, lotIndex))
                     return nil
 
                 },
@@ -168,7 +168,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canRecoverRplFromLot(c, lotIndex))
+                    api.Output(c).PrintResponse(canRecoverRplFromLot(c, lotIndex))
                     return nil
 
                 },
@@ -186,7 +186,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(recoverRplFromLot(c, lotIndex))
+                    api.Output(c).PrintResponse(recoverRplFromLot(c, lotIndex))
                     return nil
 
                 },
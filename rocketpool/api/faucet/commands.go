@@ -27,7 +27,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getStatus(c))
+                    api.Output(c).PrintResponse(getStatus(c))
                     return nil
 
                 },
@@ -43,7 +43,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canWithdrawRpl(c))
+                    api.Output(c).PrintResponse(canWithdrawRpl(c))
                     return nil
 
                 },
@@ -59,7 +59,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(withdrawRpl(c))
+                    api.Output(c).PrintResponse(withdrawRpl(c))
                     return nil
 
                 },
@@ -27,7 +27,28 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getStatus(c))
+                    api.Output(c).PrintResponse(getStatus(c))
+                    return nil
+
+                },
+            },
+
+            cli.Command{
+                Name:      "performance",
+                Aliases:   []string{"p"},
+                Usage:     "Get the performance of the node's minipool validators over an epoch range",
+                UsageText: "rocketpool api minipool performance start-epoch end-epoch",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 2); err != nil { return err }
+                    startEpoch, err := cliutils.ValidateUint("start epoch", c.Args().Get(0))
+                    if err != nil { return err }
+                    endEpoch, err := cliutils.ValidateUint("end epoch", c.Args().Get(1))
+                    if err != nil { return err }
+
+                    // Run
+                    api.Output(c).PrintResponse(getPerformance(c, startEpoch, endEpoch))
                     return nil
 
                 },
@@ -45,7 +66,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canRefundMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(canRefundMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -63,7 +84,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(refundMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(refundMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -81,7 +102,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canDissolveMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(canDissolveMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -99,7 +120,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(dissolveMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(dissolveMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -117,7 +138,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canExitMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(canExitMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -135,7 +156,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(exitMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(exitMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -153,7 +174,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canWithdrawMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(canWithdrawMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -171,7 +192,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(withdrawMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(withdrawMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -189,7 +210,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canCloseMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(canCloseMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -207,7 +228,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(closeMinipool(c, minipoolAddress))
+                    api.Output(c).PrintResponse(closeMinipool(c, minipoolAddress))
                     return nil
 
                 },
@@ -0,0 +1,295 @@
+package minipool
+
+import (
+    "fmt"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/urfave/cli"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+
+// Settings
+const (
+    DefaultPerformanceEpochs = 10
+    MaxPerformanceEpochs = 225
+    PerformanceDutiesBatchSize = 10
+    PerformanceBlocksBatchSize = 32
+    SecondsPerYear = 365 * 24 * 60 * 60
+)
+
+
+// Attestation duty key
+type attestationKey struct {
+    slot uint64
+    committeeIndex uint64
+}
+
+
+func getPerformance(c *cli.Context, startEpoch, endEpoch uint64) (*api.MinipoolPerformanceResponse, error) {
+
+    // Get services
+    if err := services.RequireNodeRegistered(c); err != nil { return nil, err }
+    if err := services.RequireBeaconClientSynced(c); err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+    bc, err := services.GetBeaconClient(c)
+    if err != nil { return nil, err }
+
+    // Response
+    response := api.MinipoolPerformanceResponse{}
+
+    // Get node account
+    nodeAccount, err := w.GetNodeAccount()
+    if err != nil {
+        return nil, err
+    }
+
+    // Data
+    var wg1 errgroup.Group
+    var addresses []common.Address
+    var eth2Config beacon.Eth2Config
+    var head beacon.BeaconHead
+
+    // Get minipool addresses
+    wg1.Go(func() error {
+        var err error
+        addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+        return err
+    })
+
+    // Get eth2 config
+    wg1.Go(func() error {
+        var err error
+        eth2Config, err = bc.GetEth2Config()
+        return err
+    })
+
+    // Get beacon head
+    wg1.Go(func() error {
+        var err error
+        head, err = bc.GetBeaconHead()
+        return err
+    })
+
+    // Wait for data
+    if err := wg1.Wait(); err != nil {
+        return nil, err
+    }
+
+    // Get epoch range
+    // Attestations may be included up to an epoch after they are made, so the latest epoch is two epochs behind the head
+    if head.Epoch < 2 {
+        return nil, fmt.Errorf("The beacon chain does not have any complete epochs yet")
+    }
+    latestEpoch := head.Epoch - 2
+    if endEpoch == 0 {
+        endEpoch = latestEpoch
+    } else if endEpoch > latestEpoch {
+        return nil, fmt.Errorf("End epoch %d is not complete yet; the latest complete epoch is %d", endEpoch, latestEpoch)
+    }
+    if startEpoch == 0 {
+        if endEpoch >= DefaultPerformanceEpochs {
+            startEpoch = endEpoch - DefaultPerformanceEpochs + 1
+        }
+    } else if startEpoch > endEpoch {
+        return nil, fmt.Errorf("Start epoch %d is after end epoch %d", startEpoch, endEpoch)
+    }
+    if endEpoch - startEpoch + 1 > MaxPerformanceEpochs {
+        return nil, fmt.Errorf("Epoch range is too large; at most %d epochs may be queried at once", MaxPerformanceEpochs)
+    }
+    response.StartEpoch = startEpoch
+    response.EndEpoch = endEpoch
+
+    // Get minipool validators
+    validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, nil, nil)
+    if err != nil {
+        return nil, err
+    }
+    indices := []uint64{}
+    for _, address := range addresses {
+        validator := validators[address]
+        if validator.Exists && validator.ActivationEpoch <= endEpoch {
+            indices = append(indices, validator.Index)
+        }
+    }
+
+    // Get validator performance
+    performance := make(map[uint64]*api.MinipoolPerformance, len(indices))
+    for _, index := range indices {
+        performance[index] = &api.MinipoolPerformance{ValidatorIndex: index}
+    }
+    if len(indices) > 0 {
+        if err := getValidatorPerformance(bc, eth2Config, indices, startEpoch, endEpoch, performance); err != nil {
+            return nil, err
+        }
+    }
+
+    // Build minipool performance
+    response.Minipools = []api.MinipoolPerformance{}
+    for _, address := range addresses {
+        validator := validators[address]
+        if !validator.Exists { continue }
+        mpPerformance, ok := performance[validator.Index]
+        if !ok { continue }
+        mpPerformance.Address = address
+        mpPerformance.ValidatorPubkey = validator.Pubkey
+        response.Minipools = append(response.Minipools, *mpPerformance)
+    }
+
+    // Return response
+    return &response, nil
+
+}
+
+
+// Get validator attestation, proposal & balance performance over an epoch range
+func getValidatorPerformance(bc beacon.Client, eth2Config beacon.Eth2Config, indices []uint64, startEpoch, endEpoch uint64, performance map[uint64]*api.MinipoolPerformance) error {
+
+    // Data
+    epochCount := endEpoch - startEpoch + 1
+    attesterDuties := make([][]beacon.AttesterDuty, epochCount)
+    proposerDuties := make([][]beacon.ProposerDuty, epochCount)
+    var startBalances, endBalances map[uint64]uint64
+
+    // Get duties in batches
+    for bsi := uint64(0); bsi < epochCount; bsi += PerformanceDutiesBatchSize {
+
+        // Get batch start & end index
+        esi := bsi
+        eei := bsi + PerformanceDutiesBatchSize
+        if eei > epochCount { eei = epochCount }
+
+        // Get duties
+        var wg errgroup.Group
+        for ei := esi; ei < eei; ei++ {
+            ei := ei
+            wg.Go(func() error {
+                duties, err := bc.GetAttesterDuties(indices, startEpoch + ei)
+                if err == nil { attesterDuties[ei] = duties }
+                return err
+            })
+            wg.Go(func() error {
+                duties, err := bc.GetProposerDuties(indices, startEpoch + ei)
+                if err == nil { proposerDuties[ei] = duties }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return err
+        }
+
+    }
+
+    // Get balances at the start and end of the range
+    var wg errgroup.Group
+    wg.Go(func() error {
+        var err error
+        startBalances, err = bc.GetValidatorBalances(indices, startEpoch)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        endBalances, err = bc.GetValidatorBalances(indices, endEpoch + 1)
+        return err
+    })
+    if err := wg.Wait(); err != nil {
+        return err
+    }
+
+    // Get blocks in batches
+    // Attestations made in the range may be included up to an epoch after its end
+    startSlot := startEpoch * eth2Config.SlotsPerEpoch
+    slotCount := (epochCount + 1) * eth2Config.SlotsPerEpoch
+    blocks := make([]beacon.BeaconBlock, slotCount)
+    blocksExist := make([]bool, slotCount)
+    for bsi := uint64(0); bsi < slotCount; bsi += PerformanceBlocksBatchSize {
+
+        // Get batch start & end index
+        ssi := bsi
+        sei := bsi + PerformanceBlocksBatchSize
+        if sei > slotCount { sei = slotCount }
+
+        // Get blocks
+        var wg errgroup.Group
+        for si := ssi; si < sei; si++ {
+            si := si
+            wg.Go(func() error {
+                block, exists, err := bc.GetBeaconBlock(startSlot + si)
+                if err == nil {
+                    blocks[si] = block
+                    blocksExist[si] = exists
+                }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return err
+        }
+
+    }
+
+    // Check attestations
+    dutiesByCommittee := make(map[attestationKey][]beacon.AttesterDuty)
+    for _, epochDuties := range attesterDuties {
+        for _, duty := range epochDuties {
+            key := attestationKey{slot: duty.Slot, committeeIndex: duty.CommitteeIndex}
+            dutiesByCommittee[key] = append(dutiesByCommittee[key], duty)
+            performance[duty.ValidatorIndex].AttestationsExpected++
+        }
+    }
+    attested := make(map[beacon.AttesterDuty]bool)
+    for si, block := range blocks {
+        if !blocksExist[si] { continue }
+        for _, attestation := range block.Attestations {
+            for _, duty := range dutiesByCommittee[attestationKey{slot: attestation.Slot, committeeIndex: attestation.CommitteeIndex}] {
+                if attestation.HasAttested(duty.CommitteePosition) {
+                    attested[duty] = true
+                }
+            }
+        }
+    }
+    for _, duties := range dutiesByCommittee {
+        for _, duty := range duties {
+            if !attested[duty] {
+                performance[duty.ValidatorIndex].AttestationsMissed++
+            }
+        }
+    }
+
+    // Check proposals
+    for _, epochDuties := range proposerDuties {
+        for _, duty := range epochDuties {
+            si := duty.Slot - startSlot
+            if duty.Slot >= startSlot && si < slotCount && blocksExist[si] && blocks[si].ProposerIndex == duty.ValidatorIndex {
+                performance[duty.ValidatorIndex].BlocksProposed++
+            } else {
+                performance[duty.ValidatorIndex].BlocksMissed++
+            }
+        }
+    }
+
+    // Get balances & annualized returns
+    epochsPerYear := float64(SecondsPerYear) / float64(eth2Config.SecondsPerEpoch)
+    for _, index := range indices {
+        mpPerformance := performance[index]
+        mpPerformance.StartBalance = startBalances[index]
+        mpPerformance.EndBalance = endBalances[index]
+        if mpPerformance.StartBalance > 0 {
+            balanceChange := float64(mpPerformance.EndBalance) - float64(mpPerformance.StartBalance)
+            mpPerformance.Apr = balanceChange / float64(mpPerformance.StartBalance) * epochsPerYear / float64(epochCount)
+        }
+    }
+
+    // Return
+    return nil
+
+}
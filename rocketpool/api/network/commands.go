@@ -27,7 +27,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getNodeFee(c))
+                    api.Output(c).PrintResponse(getNodeFee(c))
                     return nil
 
                 },
@@ -44,7 +44,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getRplPrice(c))
+                    api.Output(c).PrintResponse(getRplPrice(c))
                     return nil
 
                 },
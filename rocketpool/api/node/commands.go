@@ -27,7 +27,43 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getStatus(c))
+                    api.Output(c).PrintResponse(getStatus(c))
+                    return nil
+
+                },
+            },
+
+            cli.Command{
+                Name:      "pending-txs",
+                Usage:     "Get the node's pending transactions",
+                UsageText: "rocketpool api node pending-txs",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
+
+                    // Run
+                    api.Output(c).PrintResponse(getPendingTxs(c))
+                    return nil
+
+                },
+            },
+
+            cli.Command{
+                Name:      "rewards-report",
+                Usage:     "Get a report of the node's minipool rewards, RPL rewards and ETH withdrawals over a time range",
+                UsageText: "rocketpool api node rewards-report start-time end-time",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 2); err != nil { return err }
+                    startTime, err := cliutils.ValidateUint("start time", c.Args().Get(0))
+                    if err != nil { return err }
+                    endTime, err := cliutils.ValidateUint("end time", c.Args().Get(1))
+                    if err != nil { return err }
+
+                    // Run
+                    api.Output(c).PrintResponse(getRewardsReport(c, int64(startTime), int64(endTime)))
                     return nil
 
                 },
@@ -43,7 +79,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canRegisterNode(c))
+                    api.Output(c).PrintResponse(canRegisterNode(c))
                     return nil
 
                 },
@@ -61,7 +97,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(registerNode(c, timezoneLocation))
+                    api.Output(c).PrintResponse(registerNode(c, timezoneLocation))
                     return nil
 
                 },
@@ -80,7 +116,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(setWithdrawalAddress(c, withdrawalAddress))
+                    api.Output(c).PrintResponse(setWithdrawalAddress(c, withdrawalAddress))
                     return nil
 
                 },
@@ -99,7 +135,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(setTimezoneLocation(c, timezoneLocation))
+                    api.Output(c).PrintResponse(setTimezoneLocation(c, timezoneLocation))
                     return nil
 
                 },
@@ -117,7 +153,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canNodeSwapRpl(c, amountWei))
+                    api.Output(c).PrintResponse(canNodeSwapRpl(c, amountWei))
                     return nil
 
                 },
@@ -135,7 +171,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(nodeSwapRpl(c, amountWei))
+                    api.Output(c).PrintResponse(nodeSwapRpl(c, amountWei))
                     return nil
 
                 },
@@ -153,7 +189,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canNodeStakeRpl(c, amountWei))
+                    api.Output(c).PrintResponse(canNodeStakeRpl(c, amountWei))
                     return nil
 
                 },
@@ -171,7 +207,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(nodeStakeRpl(c, amountWei))
+                    api.Output(c).PrintResponse(nodeStakeRpl(c, amountWei))
                     return nil
 
                 },
@@ -189,7 +225,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canNodeWithdrawRpl(c, amountWei))
+                    api.Output(c).PrintResponse(canNodeWithdrawRpl(c, amountWei))
                     return nil
 
                 },
@@ -207,7 +243,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(nodeWithdrawRpl(c, amountWei))
+                    api.Output(c).PrintResponse(nodeWithdrawRpl(c, amountWei))
                     return nil
 
                 },
@@ -225,7 +261,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canNodeDeposit(c, amountWei))
+                    api.Output(c).PrintResponse(canNodeDeposit(c, amountWei))
                     return nil
 
                 },
@@ -245,7 +281,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(nodeDeposit(c, amountWei, minNodeFee))
+                    api.Output(c).PrintResponse(nodeDeposit(c, amountWei, minNodeFee))
                     return nil
 
                 },
@@ -265,7 +301,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canNodeSend(c, amountWei, token))
+                    api.Output(c).PrintResponse(canNodeSend(c, amountWei, token))
                     return nil
 
                 },
@@ -287,7 +323,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(nodeSend(c, amountWei, token, toAddress))
+                    api.Output(c).PrintResponse(nodeSend(c, amountWei, token, toAddress))
                     return nil
 
                 },
@@ -307,7 +343,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canNodeBurn(c, amountWei, token))
+                    api.Output(c).PrintResponse(canNodeBurn(c, amountWei, token))
                     return nil
 
                 },
@@ -327,7 +363,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(nodeBurn(c, amountWei, token))
+                    api.Output(c).PrintResponse(nodeBurn(c, amountWei, token))
                     return nil
 
                 },
@@ -0,0 +1,55 @@
+package node
+
+import (
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+func getPendingTxs(c *cli.Context) (*api.NodePendingTxsResponse, error) {
+
+    // Get services
+    if err := services.RequireNodeWallet(c); err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+
+    // Response
+    response := api.NodePendingTxsResponse{}
+
+    // Get node account
+    nodeAccount, err := w.GetNodeAccount()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get tracked transactions
+    pendingTxs, err := w.GetPendingTransactions()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get node account transactions
+    response.Transactions = []api.PendingTransaction{}
+    for _, tx := range pendingTxs {
+        if tx.From != nodeAccount.Address { continue }
+        response.Transactions = append(response.Transactions, api.PendingTransaction{
+            Hash: tx.Hash(),
+            Hashes: tx.Hashes,
+            Nonce: tx.Nonce,
+            To: tx.To,
+            Value: tx.Value,
+            GasLimit: tx.GasLimit,
+            GasPrice: tx.GasPrice,
+            Status: tx.Status,
+            FirstSent: tx.FirstSent,
+            LastSent: tx.LastSent,
+            Replacements: len(tx.Hashes) - 1,
+        })
+    }
+
+    // Return response
+    return &response, nil
+
+}
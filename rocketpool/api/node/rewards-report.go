@@ -0,0 +1,552 @@
+package node
+
+import (
+    "context"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum"
+    "github.com/ethereum/go-ethereum/accounts/abi"
+    "github.com/ethereum/go-ethereum/common"
+    ethtypes "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+
+// Settings
+const (
+    RewardsReportMinipoolBatchSize = 20
+    RewardsReportEventLogBlockRange = 10000
+)
+
+
+// RPL rewards claimed event data
+type rplTokensClaimed struct {
+    Amount *big.Int
+    Time *big.Int
+}
+
+
+// Minipool ETH withdrawn event data
+type etherWithdrawn struct {
+    Amount *big.Int
+    Time *big.Int
+}
+
+
+func getRewardsReport(c *cli.Context, startTime, endTime int64) (*api.NodeRewardsReportResponse, error) {
+
+    // Get services
+    if err := services.RequireNodeRegistered(c); err != nil { return nil, err }
+    if err := services.RequireBeaconClientSynced(c); err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    ec, err := services.GetEthClient(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+    bc, err := services.GetBeaconClient(c)
+    if err != nil { return nil, err }
+
+    // Response
+    response := api.NodeRewardsReportResponse{}
+
+    // Get node account
+    nodeAccount, err := w.GetNodeAccount()
+    if err != nil {
+        return nil, err
+    }
+
+    // Data
+    var wg1 errgroup.Group
+    var addresses []common.Address
+    var eth2Config beacon.Eth2Config
+    var head beacon.BeaconHead
+    var latestHeader *ethtypes.Header
+
+    // Get minipool addresses
+    wg1.Go(func() error {
+        var err error
+        addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+        return err
+    })
+
+    // Get eth2 config
+    wg1.Go(func() error {
+        var err error
+        eth2Config, err = bc.GetEth2Config()
+        return err
+    })
+
+    // Get beacon head
+    wg1.Go(func() error {
+        var err error
+        head, err = bc.GetBeaconHead()
+        return err
+    })
+
+    // Get latest block header
+    wg1.Go(func() error {
+        var err error
+        latestHeader, err = ec.HeaderByNumber(context.Background(), nil)
+        return err
+    })
+
+    // Wait for data
+    if err := wg1.Wait(); err != nil {
+        return nil, err
+    }
+
+    // Check time range; the end of the range is limited to the latest block
+    if latestHeader.Time < uint64(endTime) {
+        endTime = int64(latestHeader.Time)
+    }
+    if startTime < int64(eth2Config.GenesisTime) {
+        startTime = int64(eth2Config.GenesisTime)
+    }
+    if startTime >= endTime {
+        return nil, fmt.Errorf("The report start time must be before the end time and the current time")
+    }
+    response.StartTime = startTime
+    response.EndTime = endTime
+
+    // Get epoch range
+    response.StartEpoch = (uint64(startTime) - eth2Config.GenesisTime + eth2Config.SecondsPerEpoch - 1) / eth2Config.SecondsPerEpoch
+    response.EndEpoch = (uint64(endTime) - eth2Config.GenesisTime) / eth2Config.SecondsPerEpoch
+    if response.EndEpoch > head.Epoch {
+        response.EndEpoch = head.Epoch
+    }
+    if response.StartEpoch > response.EndEpoch {
+        response.StartEpoch = response.EndEpoch
+    }
+
+    // Get block range
+    response.StartBlock, err = getFirstBlockAfter(ec, uint64(startTime), latestHeader.Number.Uint64())
+    if err != nil {
+        return nil, err
+    }
+    blockAfterEnd, err := getFirstBlockAfter(ec, uint64(endTime) + 1, latestHeader.Number.Uint64())
+    if err != nil {
+        return nil, err
+    }
+    response.EndBlock = blockAfterEnd - 1
+
+    // Get minipool rewards
+    response.Minipools, err = getMinipoolRewards(rp, bc, addresses, response.StartEpoch, response.EndEpoch)
+    if err != nil {
+        return nil, err
+    }
+
+    // Get ETH withdrawals
+    response.EthWithdrawals, err = getEthWithdrawals(rp, ec, addresses, response.StartBlock, response.EndBlock)
+    if err != nil {
+        return nil, err
+    }
+
+    // Get RPL rewards claims
+    response.RplClaims, err = getRplClaims(rp, ec, nodeAccount.Address, response.StartBlock, response.EndBlock)
+    if err != nil {
+        return nil, err
+    }
+
+    // Get totals
+    withdrawn := make(map[common.Address]*big.Int)
+    response.TotalEthWithdrawn = big.NewInt(0)
+    for _, withdrawal := range response.EthWithdrawals {
+        if _, ok := withdrawn[withdrawal.Minipool]; !ok {
+            withdrawn[withdrawal.Minipool] = big.NewInt(0)
+        }
+        withdrawn[withdrawal.Minipool].Add(withdrawn[withdrawal.Minipool], withdrawal.Amount)
+        response.TotalEthWithdrawn.Add(response.TotalEthWithdrawn, withdrawal.Amount)
+    }
+    response.TotalNodeShare = big.NewInt(0)
+    for mi, mpRewards := range response.Minipools {
+        if amount, ok := withdrawn[mpRewards.Address]; ok {
+            response.Minipools[mi].EthWithdrawn = amount
+        }
+        response.TotalNodeShare.Add(response.TotalNodeShare, mpRewards.NodeShare)
+    }
+    response.TotalRplClaimed = big.NewInt(0)
+    response.TotalRplValue = big.NewInt(0)
+    for _, claim := range response.RplClaims {
+        response.TotalRplClaimed.Add(response.TotalRplClaimed, claim.Amount)
+        response.TotalRplValue.Add(response.TotalRplValue, claim.EthValue)
+    }
+
+    // Return response
+    return &response, nil
+
+}
+
+
+// Get minipool beacon chain balance changes and the node's share of them over an epoch range
+func getMinipoolRewards(rp *rocketpool.RocketPool, bc beacon.Client, addresses []common.Address, startEpoch, endEpoch uint64) ([]api.MinipoolRewards, error) {
+
+    // Get minipool validators
+    validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, nil, nil)
+    if err != nil {
+        return []api.MinipoolRewards{}, err
+    }
+
+    // Get minipools with validators active in the range
+    activeAddresses := []common.Address{}
+    indices := []uint64{}
+    for _, address := range addresses {
+        validator := validators[address]
+        if validator.Exists && validator.ActivationEpoch <= endEpoch {
+            activeAddresses = append(activeAddresses, address)
+            indices = append(indices, validator.Index)
+        }
+    }
+    if len(indices) == 0 {
+        return []api.MinipoolRewards{}, nil
+    }
+
+    // Load minipool deposit details in batches
+    nodeDetails := make([]minipool.NodeDetails, len(activeAddresses))
+    userDepositBalances := make([]*big.Int, len(activeAddresses))
+    for bsi := 0; bsi < len(activeAddresses); bsi += RewardsReportMinipoolBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + RewardsReportMinipoolBatchSize
+        if mei > len(activeAddresses) { mei = len(activeAddresses) }
+
+        // Load details
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                mp, err := minipool.NewMinipool(rp, activeAddresses[mi])
+                if err != nil {
+                    return err
+                }
+                details, err := mp.GetNodeDetails(nil)
+                if err != nil {
+                    return err
+                }
+                userDepositBalance, err := mp.GetUserDepositBalance(nil)
+                if err != nil {
+                    return err
+                }
+                nodeDetails[mi] = details
+                userDepositBalances[mi] = userDepositBalance
+                return nil
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []api.MinipoolRewards{}, err
+        }
+
+    }
+
+    // Get validator balances at the start and end of the range
+    var wg errgroup.Group
+    var startBalances, endBalances map[uint64]uint64
+    wg.Go(func() error {
+        var err error
+        startBalances, err = bc.GetValidatorBalances(indices, startEpoch)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        endBalances, err = bc.GetValidatorBalances(indices, endEpoch)
+        return err
+    })
+    if err := wg.Wait(); err != nil {
+        return []api.MinipoolRewards{}, err
+    }
+
+    // Build minipool rewards
+    minipools := make([]api.MinipoolRewards, len(activeAddresses))
+    for mi, address := range activeAddresses {
+        validator := validators[address]
+        depositBalance := new(big.Int).Add(nodeDetails[mi].DepositBalance, userDepositBalances[mi])
+
+        // Get balances; validators activated during the range start with their deposit balance
+        startBalance := depositBalance
+        if balance, ok := startBalances[validator.Index]; ok && validator.ActivationEpoch <= startEpoch {
+            startBalance = eth.GweiToWei(float64(balance))
+        }
+        endBalance := startBalance
+        if balance, ok := endBalances[validator.Index]; ok {
+            endBalance = eth.GweiToWei(float64(balance))
+        }
+        balanceChange := new(big.Int).Sub(endBalance, startBalance)
+
+        // Build
+        minipools[mi] = api.MinipoolRewards{
+            Address: address,
+            ValidatorPubkey: validator.Pubkey,
+            ValidatorIndex: validator.Index,
+            NodeFee: nodeDetails[mi].Fee,
+            NodeDepositBalance: nodeDetails[mi].DepositBalance,
+            UserDepositBalance: userDepositBalances[mi],
+            StartBalance: startBalance,
+            EndBalance: endBalance,
+            BalanceChange: balanceChange,
+            NodeShare: getNodeShare(balanceChange, nodeDetails[mi].DepositBalance, userDepositBalances[mi], nodeDetails[mi].Fee),
+            EthWithdrawn: big.NewInt(0),
+        }
+
+    }
+
+    // Return
+    return minipools, nil
+
+}
+
+
+// Get the node's share of a minipool validator balance change
+// Rewards earned on user deposits are split by the node commission fee; losses are borne by the node deposit
+func getNodeShare(balanceChange, nodeDepositBalance, userDepositBalance *big.Int, nodeFee float64) *big.Int {
+    depositBalance := new(big.Int).Add(nodeDepositBalance, userDepositBalance)
+    if balanceChange.Sign() <= 0 || depositBalance.Sign() == 0 {
+        return new(big.Int).Set(balanceChange)
+    }
+    userRewards := new(big.Int).Mul(balanceChange, userDepositBalance)
+    userRewards.Div(userRewards, depositBalance)
+    nodeCommission := new(big.Int).Mul(userRewards, eth.EthToWei(nodeFee))
+    nodeCommission.Div(nodeCommission, eth.EthToWei(1))
+    nodeShare := new(big.Int).Sub(balanceChange, userRewards)
+    return nodeShare.Add(nodeShare, nodeCommission)
+}
+
+
+// Get ETH withdrawn from minipools over a block range
+func getEthWithdrawals(rp *rocketpool.RocketPool, ec *ethclient.Client, addresses []common.Address, startBlock, endBlock uint64) ([]api.EthWithdrawal, error) {
+
+    // Check minipools
+    if len(addresses) == 0 {
+        return []api.EthWithdrawal{}, nil
+    }
+
+    // Get event ID
+    rocketMinipoolAbi, err := rp.GetABI("rocketMinipool")
+    if err != nil {
+        return []api.EthWithdrawal{}, err
+    }
+    etherWithdrawnEvent, ok := rocketMinipoolAbi.Events["EtherWithdrawn"]
+    if !ok {
+        return []api.EthWithdrawal{}, fmt.Errorf("Could not find the EtherWithdrawn event")
+    }
+
+    // Get events
+    logs, err := filterLogs(ec, startBlock, endBlock, addresses, [][]common.Hash{{etherWithdrawnEvent.ID}})
+    if err != nil {
+        return []api.EthWithdrawal{}, err
+    }
+
+    // Build withdrawals
+    withdrawals := []api.EthWithdrawal{}
+    for _, log := range logs {
+        if len(log.Topics) < 2 {
+            continue
+        }
+        var event etherWithdrawn
+        if err := rocketMinipoolAbi.UnpackIntoInterface(&event, "EtherWithdrawn", log.Data); err != nil {
+            return []api.EthWithdrawal{}, fmt.Errorf("Could not decode EtherWithdrawn event: %w", err)
+        }
+        withdrawals = append(withdrawals, api.EthWithdrawal{
+            Minipool: log.Address,
+            To: common.BytesToAddress(log.Topics[1].Bytes()),
+            Block: log.BlockNumber,
+            Time: event.Time.Int64(),
+            TxHash: log.TxHash,
+            Amount: event.Amount,
+        })
+    }
+
+    // Return
+    return withdrawals, nil
+
+}
+
+
+// Get RPL rewards claimed by the node over a block range, valued at the network RPL price at the time of each claim
+// Prices are read from network price update events, so an archive eth1 node is not required
+func getRplClaims(rp *rocketpool.RocketPool, ec *ethclient.Client, nodeAddress common.Address, startBlock, endBlock uint64) ([]api.RplRewardsClaim, error) {
+
+    // Get rewards pool address & event ID
+    rocketRewardsPoolAddress, err := rp.GetAddress("rocketRewardsPool")
+    if err != nil {
+        return []api.RplRewardsClaim{}, err
+    }
+    rocketRewardsPoolAbi, err := rp.GetABI("rocketRewardsPool")
+    if err != nil {
+        return []api.RplRewardsClaim{}, err
+    }
+    rplTokensClaimedEvent, ok := rocketRewardsPoolAbi.Events["RPLTokensClaimed"]
+    if !ok {
+        return []api.RplRewardsClaim{}, fmt.Errorf("Could not find the RPLTokensClaimed event")
+    }
+
+    // Get events claimed by the node
+    logs, err := filterLogs(ec, startBlock, endBlock, []common.Address{*rocketRewardsPoolAddress}, [][]common.Hash{{rplTokensClaimedEvent.ID}, {}, {nodeAddress.Hash()}})
+    if err != nil {
+        return []api.RplRewardsClaim{}, err
+    }
+
+    // Get network RPL price updates in effect for the claims
+    var priceLogs []ethtypes.Log
+    if len(logs) > 0 {
+        priceLogs, err = getRplPriceUpdates(rp, ec, logs[0].BlockNumber, logs[len(logs) - 1].BlockNumber)
+        if err != nil {
+            return []api.RplRewardsClaim{}, err
+        }
+    }
+    rocketNetworkPricesAbi, err := rp.GetABI("rocketNetworkPrices")
+    if err != nil {
+        return []api.RplRewardsClaim{}, err
+    }
+
+    // Build claims
+    claims := make([]api.RplRewardsClaim, len(logs))
+    for li, log := range logs {
+        var event rplTokensClaimed
+        if err := rocketRewardsPoolAbi.UnpackIntoInterface(&event, "RPLTokensClaimed", log.Data); err != nil {
+            return []api.RplRewardsClaim{}, fmt.Errorf("Could not decode RPLTokensClaimed event: %w", err)
+        }
+        rplPrice, err := getRplPriceAt(rocketNetworkPricesAbi, priceLogs, log)
+        if err != nil {
+            return []api.RplRewardsClaim{}, err
+        }
+        ethValue := new(big.Int).Mul(event.Amount, rplPrice)
+        claims[li] = api.RplRewardsClaim{
+            Block: log.BlockNumber,
+            Time: event.Time.Int64(),
+            TxHash: log.TxHash,
+            Amount: event.Amount,
+            RplPrice: rplPrice,
+            EthValue: ethValue.Div(ethValue, eth.EthToWei(1)),
+        }
+    }
+
+    // Return
+    return claims, nil
+
+}
+
+
+// Get the network RPL price update events over a block range, including the latest update before the range
+func getRplPriceUpdates(rp *rocketpool.RocketPool, ec *ethclient.Client, startBlock, endBlock uint64) ([]ethtypes.Log, error) {
+
+    // Get network prices address & event ID
+    rocketNetworkPricesAddress, err := rp.GetAddress("rocketNetworkPrices")
+    if err != nil {
+        return []ethtypes.Log{}, err
+    }
+    rocketNetworkPricesAbi, err := rp.GetABI("rocketNetworkPrices")
+    if err != nil {
+        return []ethtypes.Log{}, err
+    }
+    pricesUpdatedEvent, ok := rocketNetworkPricesAbi.Events["PricesUpdated"]
+    if !ok {
+        return []ethtypes.Log{}, fmt.Errorf("Could not find the PricesUpdated event")
+    }
+    addresses := []common.Address{*rocketNetworkPricesAddress}
+    topics := [][]common.Hash{{pricesUpdatedEvent.ID}}
+
+    // Get events in the range
+    logs, err := filterLogs(ec, startBlock, endBlock, addresses, topics)
+    if err != nil {
+        return []ethtypes.Log{}, err
+    }
+
+    // Search back from the start of the range for the latest earlier event
+    for end := startBlock; end > 0; {
+        start := uint64(0)
+        if end > RewardsReportEventLogBlockRange { start = end - RewardsReportEventLogBlockRange }
+        earlierLogs, err := filterLogs(ec, start, end - 1, addresses, topics)
+        if err != nil {
+            return []ethtypes.Log{}, err
+        }
+        if len(earlierLogs) > 0 {
+            logs = append([]ethtypes.Log{earlierLogs[len(earlierLogs) - 1]}, logs...)
+            break
+        }
+        end = start
+    }
+
+    // Return
+    return logs, nil
+
+}
+
+
+// Get the network RPL price in effect at an event log from the price update events preceding it
+func getRplPriceAt(rocketNetworkPricesAbi *abi.ABI, priceLogs []ethtypes.Log, log ethtypes.Log) (*big.Int, error) {
+
+    // Get the latest price update before the log
+    var priceLog *ethtypes.Log
+    for pi := range priceLogs {
+        if priceLogs[pi].BlockNumber > log.BlockNumber || (priceLogs[pi].BlockNumber == log.BlockNumber && priceLogs[pi].Index > log.Index) { break }
+        priceLog = &priceLogs[pi]
+    }
+    if priceLog == nil {
+        return nil, fmt.Errorf("Could not find an RPL price update before block %d", log.BlockNumber)
+    }
+
+    // Decode price
+    event := make(map[string]interface{})
+    if err := rocketNetworkPricesAbi.UnpackIntoMap(event, "PricesUpdated", priceLog.Data); err != nil {
+        return nil, fmt.Errorf("Could not decode PricesUpdated event: %w", err)
+    }
+    rplPrice, ok := event["rplPrice"].(*big.Int)
+    if !ok {
+        return nil, fmt.Errorf("Could not find the RPL price in the PricesUpdated event")
+    }
+    return rplPrice, nil
+
+}
+
+
+// Get the number of the first block with a timestamp at or after a time
+// Returns the block after the latest block if no block exists yet
+func getFirstBlockAfter(ec *ethclient.Client, timestamp, latestBlock uint64) (uint64, error) {
+    low, high := uint64(0), latestBlock + 1
+    for low < high {
+        mid := (low + high) / 2
+        header, err := ec.HeaderByNumber(context.Background(), big.NewInt(int64(mid)))
+        if err != nil {
+            return 0, fmt.Errorf("Could not get block %d: %w", mid, err)
+        }
+        if header.Time < timestamp {
+            low = mid + 1
+        } else {
+            high = mid
+        }
+    }
+    return low, nil
+}
+
+
+// Get event logs over a block range in chunks
+func filterLogs(ec *ethclient.Client, fromBlock, toBlock uint64, addresses []common.Address, topics [][]common.Hash) ([]ethtypes.Log, error) {
+    logs := []ethtypes.Log{}
+    for start := fromBlock; start <= toBlock; start += RewardsReportEventLogBlockRange {
+        end := start + RewardsReportEventLogBlockRange - 1
+        if end > toBlock { end = toBlock }
+        rangeLogs, err := ec.FilterLogs(context.Background(), ethereum.FilterQuery{
+            FromBlock: big.NewInt(int64(start)),
+            ToBlock: big.NewInt(int64(end)),
+            Addresses: addresses,
+            Topics: topics,
+        })
+        if err != nil {
+            return []ethtypes.Log{}, fmt.Errorf("Could not get event logs for blocks %d - %d: %w", start, end, err)
+        }
+        logs = append(logs, rangeLogs...)
+    }
+    return logs, nil
+}
@@ -26,7 +26,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getStatus(c))
+                    api.Output(c).PrintResponse(getStatus(c))
                     return nil
 
                 },
@@ -43,7 +43,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getMembers(c))
+                    api.Output(c).PrintResponse(getMembers(c))
                     return nil
 
                 },
@@ -60,7 +60,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getProposals(c))
+                    api.Output(c).PrintResponse(getProposals(c))
                     return nil
 
                 },
@@ -78,7 +78,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canProposeInvite(c, memberAddress))
+                    api.Output(c).PrintResponse(canProposeInvite(c, memberAddress))
                     return nil
 
                 },
@@ -100,7 +100,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeInvite(c, memberAddress, memberId, memberEmail))
+                    api.Output(c).PrintResponse(proposeInvite(c, memberAddress, memberId, memberEmail))
                     return nil
 
                 },
@@ -116,7 +116,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canProposeLeave(c))
+                    api.Output(c).PrintResponse(canProposeLeave(c))
                     return nil
 
                 },
@@ -132,7 +132,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeLeave(c))
+                    api.Output(c).PrintResponse(proposeLeave(c))
                     return nil
 
                 },
@@ -150,7 +150,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canProposeReplace(c, memberAddress))
+                    api.Output(c).PrintResponse(canProposeReplace(c, memberAddress))
                     return nil
 
                 },
@@ -172,7 +172,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeReplace(c, memberAddress, memberId, memberEmail))
+                    api.Output(c).PrintResponse(proposeReplace(c, memberAddress, memberId, memberEmail))
                     return nil
 
                 },
@@ -192,7 +192,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canProposeKick(c, memberAddress, fineAmountWei))
+                    api.Output(c).PrintResponse(canProposeKick(c, memberAddress, fineAmountWei))
                     return nil
 
                 },
@@ -212,7 +212,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeKick(c, memberAddress, fineAmountWei))
+                    api.Output(c).PrintResponse(proposeKick(c, memberAddress, fineAmountWei))
                     return nil
 
                 },
@@ -230,7 +230,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canCancelProposal(c, proposalId))
+                    api.Output(c).PrintResponse(canCancelProposal(c, proposalId))
                     return nil
 
                 },
@@ -248,7 +248,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(cancelProposal(c, proposalId))
+                    api.Output(c).PrintResponse(cancelProposal(c, proposalId))
                     return nil
 
                 },
@@ -266,7 +266,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canVoteOnProposal(c, proposalId))
+                    api.Output(c).PrintResponse(canVoteOnProposal(c, proposalId))
                     return nil
 
                 },
@@ -286,7 +286,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(voteOnProposal(c, proposalId, support))
+                    api.Output(c).PrintResponse(voteOnProposal(c, proposalId, support))
                     return nil
 
                 },
@@ -304,7 +304,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canExecuteProposal(c, proposalId))
+                    api.Output(c).PrintResponse(canExecuteProposal(c, proposalId))
                     return nil
 
                 },
@@ -322,7 +322,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(executeProposal(c, proposalId))
+                    api.Output(c).PrintResponse(executeProposal(c, proposalId))
                     return nil
 
                 },
@@ -338,7 +338,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canJoin(c))
+                    api.Output(c).PrintResponse(canJoin(c))
                     return nil
 
                 },
@@ -354,7 +354,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(join(c))
+                    api.Output(c).PrintResponse(join(c))
                     return nil
 
                 },
@@ -370,7 +370,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canLeave(c))
+                    api.Output(c).PrintResponse(canLeave(c))
                     return nil
 
                 },
@@ -388,7 +388,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(leave(c, bondRefundAddress))
+                    api.Output(c).PrintResponse(leave(c, bondRefundAddress))
                     return nil
 
                 },
@@ -404,7 +404,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canReplace(c))
+                    api.Output(c).PrintResponse(canReplace(c))
                     return nil
 
                 },
@@ -420,7 +420,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(replace(c))
+                    api.Output(c).PrintResponse(replace(c))
                     return nil
 
                 },
@@ -436,7 +436,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(canProposeSetting(c))
+                    api.Output(c).PrintResponse(canProposeSetting(c))
                     return nil
 
                 },
@@ -453,7 +453,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingMembersQuorum(c, quorum))
+                    api.Output(c).PrintResponse(proposeSettingMembersQuorum(c, quorum))
                     return nil
 
                 },
@@ -470,7 +470,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingMembersRplBond(c, bondAmountWei))
+                    api.Output(c).PrintResponse(proposeSettingMembersRplBond(c, bondAmountWei))
                     return nil
 
                 },
@@ -487,7 +487,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
+                    api.Output(c).PrintResponse(proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
                     return nil
 
                 },
@@ -504,7 +504,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingProposalCooldown(c, proposalCooldownBlocks))
+                    api.Output(c).PrintResponse(proposeSettingProposalCooldown(c, proposalCooldownBlocks))
                     return nil
 
                 },
@@ -521,7 +521,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingProposalVoteBlocks(c, proposalVoteBlocks))
+                    api.Output(c).PrintResponse(proposeSettingProposalVoteBlocks(c, proposalVoteBlocks))
                     return nil
 
                 },
@@ -538,7 +538,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingProposalVoteDelayBlocks(c, proposalDelayBlocks))
+                    api.Output(c).PrintResponse(proposeSettingProposalVoteDelayBlocks(c, proposalDelayBlocks))
                     return nil
 
                 },
@@ -555,7 +555,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingProposalExecuteBlocks(c, proposalExecuteBlocks))
+                    api.Output(c).PrintResponse(proposeSettingProposalExecuteBlocks(c, proposalExecuteBlocks))
                     return nil
 
                 },
@@ -572,7 +572,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(proposeSettingProposalActionBlocks(c, proposalActionBlocks))
+                    api.Output(c).PrintResponse(proposeSettingProposalActionBlocks(c, proposalActionBlocks))
                     return nil
 
                 },
@@ -584,7 +584,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                 Action: func(c *cli.Context) error {
 
                     // Run
-                    api.PrintResponse(getMemberSettings(c))
+                    api.Output(c).PrintResponse(getMemberSettings(c))
                     return nil
 
                 },
@@ -596,7 +596,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                 Action: func(c *cli.Context) error {
 
                     // Run
-                    api.PrintResponse(getProposalSettings(c))
+                    api.Output(c).PrintResponse(getProposalSettings(c))
                     return nil
 
                 },
@@ -0,0 +1,404 @@
+package api
+
+import (
+    "bytes"
+    "crypto/rand"
+    "crypto/subtle"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "log"
+    "net"
+    "net/http"
+    "os"
+    "path/filepath"
+    "strings"
+    "sync"
+
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+
+// Config
+const (
+    APIServerPath = "/api"
+    UnixSocketPrefix = "unix://"
+    APITokenBytes = 32
+    APITokenFileMode = 0600
+    APISocketFileMode = 0600
+    MaxRequestSize = 1024 * 1024
+)
+
+
+// Commands which can't be run through the API server
+var serverOnlyCommands = map[string]bool{
+    "serve": true,
+    "server-token": true,
+}
+
+
+// Commands which don't send transactions or modify the wallet, by API command group
+// Commands which check whether a transaction can be sent ("can-*") are also read-only
+var readOnlyCommands = map[string]map[string]bool{
+    "auction": {"status": true, "lots": true},
+    "faucet": {"status": true},
+    "minipool": {"status": true, "performance": true},
+    "network": {"node-fee": true, "rpl-price": true},
+    "node": {"status": true, "pending-txs": true, "rewards-report": true},
+    "odao": {"status": true, "members": true, "proposals": true, "get-member-settings": true, "get-proposal-settings": true},
+    "queue": {"status": true},
+    "wallet": {"status": true},
+}
+
+
+// API server
+// Runs API commands in-process so that services are initialized once and reused between requests
+type apiServer struct {
+    c *cli.Context
+    app *cli.App
+    commandName string
+    globalArgs []string
+    cfg config.RocketPoolConfig
+    token string
+    lock sync.RWMutex
+}
+
+
+// Serve API commands over HTTP until the listener fails
+func serve(c *cli.Context, app *cli.App, commandName string) error {
+
+    // Allow the node password to be entered after the server starts with the prompt password provider
+    services.SetAPIServer()
+
+    // Get config
+    // The config is loaded before any requests are handled, so per-request options are not merged into it
+    cfg, err := services.GetConfig(c)
+    if err != nil {
+        return err
+    }
+
+    // Get listen address
+    address := c.String("address")
+    if address == "" {
+        address = cfg.Smartnode.ApiAddress
+    }
+    if address == "" {
+        return errors.New("The API server address must be set with --address or the smartnode apiAddress setting")
+    }
+
+    // Get token
+    token, err := getAPIToken(cfg.GetApiTokenPath(), true)
+    if err != nil {
+        return err
+    }
+
+    // Get node password if it is held in memory only; without a terminal, it is entered with 'rocketpool wallet unlock'
+    if err := services.PromptNodePassword(c); err != nil {
+        return err
+    }
+
+    // Listen on address
+    listener, err := listen(address)
+    if err != nil {
+        return err
+    }
+    defer listener.Close()
+
+    // Initialize server
+    server := &apiServer{
+        c: c,
+        app: app,
+        commandName: commandName,
+        globalArgs: getGlobalArgs(c),
+        cfg: cfg,
+        token: token,
+    }
+    mux := http.NewServeMux()
+    mux.Handle(APIServerPath, server)
+
+    // Serve requests
+    log.Printf("API server listening on %s\n", address)
+    return http.Serve(listener, mux)
+
+}
+
+
+// Print the API server token
+func getServerToken(c *cli.Context) (*api.APIServerTokenResponse, error) {
+
+    // Get config
+    cfg, err := services.GetConfig(c)
+    if err != nil {
+        return nil, err
+    }
+
+    // Response
+    response := api.APIServerTokenResponse{}
+
+    // Get token
+    token, err := getAPIToken(cfg.GetApiTokenPath(), false)
+    if err != nil {
+        return nil, err
+    }
+    response.Token = token
+
+    // Return response
+    return &response, nil
+
+}
+
+
+// Handle request / serve response
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+    // Check request method & token
+    if r.Method != http.MethodPost {
+        http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer " + s.token)) != 1 {
+        http.Error(w, "Unauthorized", http.StatusUnauthorized)
+        return
+    }
+
+    // Decode request
+    var request api.APIRequest
+    if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxRequestSize)).Decode(&request); err != nil {
+        http.Error(w, fmt.Sprintf("Could not decode API request: %s", err.Error()), http.StatusBadRequest)
+        return
+    }
+
+    // Run command & write response
+    w.Header().Set("Content-Type", "application/json")
+    w.Write(s.run(request))
+
+}
+
+
+// Run an API command and return its encoded response
+// Read-only commands run concurrently; other commands run one at a time, as they set the wallet's transaction options
+func (s *apiServer) run(request api.APIRequest) []byte {
+    if isReadOnlyCommand(request.Args) {
+        s.lock.RLock()
+        defer s.lock.RUnlock()
+    } else {
+        s.lock.Lock()
+        defer s.lock.Unlock()
+    }
+
+    // Copy the app for the request, to capture its API output, discard help text, and return exit errors as responses instead of exiting
+    // Commands are copied as well, as running subcommands modifies them
+    var output bytes.Buffer
+    app := *s.app
+    app.Commands = copyCommands(s.app.Commands)
+    app.Metadata = map[string]interface{}{}
+    app.Writer, app.ErrWriter = ioutil.Discard, ioutil.Discard
+    app.ExitErrHandler = func(c *cli.Context, err error) {}
+    app.Before = nil
+    apiutils.SetOutput(&app, &output)
+    printer := apiutils.NewResponsePrinter(&output)
+
+    // Run command
+    if err := s.runCommand(&app, request); err != nil {
+        output.Reset()
+        printer.PrintErrorResponse(err)
+    }
+
+    // Check output; commands which print nothing (e.g. unknown commands) return an error response
+    if output.Len() == 0 {
+        printer.PrintErrorResponse(fmt.Errorf("Invalid API command '%s'", strings.Join(request.Args, " ")))
+    }
+    return output.Bytes()
+
+}
+
+
+// Run an API command with request options applied
+func (s *apiServer) runCommand(app *cli.App, request api.APIRequest) (err error) {
+
+    // Check command
+    if len(request.Args) == 0 {
+        return errors.New("No API command specified")
+    }
+    if serverOnlyCommands[request.Args[0]] {
+        return fmt.Errorf("The '%s' command can't be run through the API server", request.Args[0])
+    }
+
+    // Apply transaction options; read-only commands don't send transactions and run alongside other commands
+    if !isReadOnlyCommand(request.Args) {
+        if err := s.setTransactionOptions(request); err != nil {
+            return err
+        }
+    }
+
+    // Recover from command panics so that the server keeps running
+    defer func() {
+        if r := recover(); r != nil {
+            err = fmt.Errorf("API command failed: %v", r)
+        }
+    }()
+
+    // Run command
+    args := append([]string{app.Name}, s.globalArgs...)
+    args = append(args, s.commandName)
+    args = append(args, request.Args...)
+    return app.Run(args)
+
+}
+
+
+// Copy a command tree
+func copyCommands(commands []cli.Command) []cli.Command {
+    copied := make([]cli.Command, len(commands))
+    for i, command := range commands {
+        command.Subcommands = copyCommands(command.Subcommands)
+        copied[i] = command
+    }
+    return copied
+}
+
+
+// Check whether API command arguments are for a read-only command
+func isReadOnlyCommand(args []string) bool {
+    if len(args) < 2 {
+        return false
+    }
+    return strings.HasPrefix(args[1], "can-") || readOnlyCommands[args[0]][args[1]]
+}
+
+
+// Apply per-request gas & dry run options to the node wallet
+func (s *apiServer) setTransactionOptions(request api.APIRequest) error {
+
+    // Get wallet; commands which require it report their own errors if it can't be loaded
+    w, err := services.GetWallet(s.c)
+    if err != nil || w == nil {
+        return nil
+    }
+
+    // Get gas options, defaulting to the config
+    cfg := s.cfg
+    if request.GasPrice != "" {
+        cfg.Smartnode.GasPrice = request.GasPrice
+    }
+    if request.GasLimit != "" {
+        cfg.Smartnode.GasLimit = request.GasLimit
+    }
+    gasPrice, err := cfg.GetGasPrice()
+    if err != nil {
+        return err
+    }
+    gasLimit, err := cfg.GetGasLimit()
+    if err != nil {
+        return err
+    }
+    w.SetGasOptions(gasPrice, gasLimit)
+
+    // Set dry run mode
+    if request.DryRun {
+        ec, err := services.GetEthClient(s.c)
+        if err != nil {
+            return err
+        }
+        w.EnableDryRun(ec)
+    } else {
+        w.DisableDryRun()
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Get the global flags the server was started with, to pass to each command
+func getGlobalArgs(c *cli.Context) []string {
+    args := []string{}
+    for _, flag := range c.App.Flags {
+        name := strings.Split(flag.GetName(), ",")[0]
+        if !c.GlobalIsSet(name) {
+            continue
+        }
+        if _, ok := flag.(cli.BoolFlag); ok {
+            args = append(args, "--" + name)
+        } else {
+            args = append(args, fmt.Sprintf("--%s=%s", name, c.GlobalString(name)))
+        }
+    }
+    return args
+}
+
+
+// Listen on a TCP address or Unix socket
+// Unix sockets are only accessible to the server user
+func listen(address string) (net.Listener, error) {
+
+    // TCP address
+    if !strings.HasPrefix(address, UnixSocketPrefix) {
+        listener, err := net.Listen("tcp", address)
+        if err != nil {
+            return nil, fmt.Errorf("Could not start API server on %s: %w", address, err)
+        }
+        return listener, nil
+    }
+
+    // Remove stale socket
+    socketPath := strings.TrimPrefix(address, UnixSocketPrefix)
+    if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+        return nil, fmt.Errorf("Could not remove existing API server socket at %s: %w", socketPath, err)
+    }
+
+    // Listen on socket
+    listener, err := net.Listen("unix", socketPath)
+    if err != nil {
+        return nil, fmt.Errorf("Could not start API server on %s: %w", socketPath, err)
+    }
+    if err := os.Chmod(socketPath, APISocketFileMode); err != nil {
+        listener.Close()
+        return nil, fmt.Errorf("Could not set API server socket permissions: %w", err)
+    }
+    return listener, nil
+
+}
+
+
+// Get the API server token from disk, optionally generating it if it doesn't exist
+func getAPIToken(path string, generate bool) (string, error) {
+
+    // Read token
+    tokenBytes, err := ioutil.ReadFile(path)
+    if err == nil {
+        token := strings.TrimSpace(string(tokenBytes))
+        if token == "" {
+            return "", fmt.Errorf("The API server token at %s is empty", path)
+        }
+        return token, nil
+    }
+    if !os.IsNotExist(err) || !generate {
+        return "", fmt.Errorf("Could not read API server token at %s: %w", path, err)
+    }
+
+    // Generate token
+    randomBytes := make([]byte, APITokenBytes)
+    if _, err := rand.Read(randomBytes); err != nil {
+        return "", fmt.Errorf("Could not generate API server token: %w", err)
+    }
+    token := hex.EncodeToString(randomBytes)
+
+    // Save & return token
+    if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+        return "", fmt.Errorf("Could not create API server token folder: %w", err)
+    }
+    if err := ioutil.WriteFile(path, []byte(token), APITokenFileMode); err != nil {
+        return "", fmt.Errorf("Could not write API server token to %s: %w", path, err)
+    }
+    return token, nil
+
+}
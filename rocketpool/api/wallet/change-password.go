@@ -0,0 +1,31 @@
+package wallet
+
+import (
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+func changePassword(c *cli.Context, currentPassword, newPassword string) (*api.ChangePasswordResponse, error) {
+
+    // Get services
+    if err := services.RequireNodeWallet(c); err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+
+    // Response
+    response := api.ChangePasswordResponse{}
+
+    // Change password
+    keystores, err := w.ChangePassword(currentPassword, newPassword)
+    if err != nil {
+        return nil, err
+    }
+    response.Keystores = keystores
+
+    // Return response
+    return &response, nil
+
+}
@@ -27,7 +27,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(getStatus(c))
+                    api.Output(c).PrintResponse(getStatus(c))
                     return nil
 
                 },
@@ -46,7 +46,26 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(setPassword(c, password))
+                    api.Output(c).PrintResponse(setPassword(c, password))
+                    return nil
+
+                },
+            },
+
+            cli.Command{
+                Name:      "change-password",
+                Usage:     "Change the node wallet password, re-encrypting the wallet and validator keystores",
+                UsageText: "rocketpool api wallet change-password current-password new-password",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 2); err != nil { return err }
+                    currentPassword := c.Args().Get(0)
+                    newPassword, err := cliutils.ValidateNodePassword("new wallet password", c.Args().Get(1))
+                    if err != nil { return err }
+
+                    // Run
+                    api.Output(c).PrintResponse(changePassword(c, currentPassword, newPassword))
                     return nil
 
                 },
@@ -63,7 +82,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(initWallet(c))
+                    api.Output(c).PrintResponse(initWallet(c))
                     return nil
 
                 },
@@ -82,7 +101,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err != nil { return err }
 
                     // Run
-                    api.PrintResponse(recoverWallet(c, mnemonic))
+                    api.Output(c).PrintResponse(recoverWallet(c, mnemonic))
                     return nil
 
                 },
@@ -99,7 +118,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(rebuildWallet(c))
+                    api.Output(c).PrintResponse(rebuildWallet(c))
                     return nil
 
                 },
@@ -116,7 +135,63 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
                     if err := cliutils.ValidateArgCount(c, 0); err != nil { return err }
 
                     // Run
-                    api.PrintResponse(exportWallet(c))
+                    api.Output(c).PrintResponse(exportWallet(c))
+                    return nil
+
+                },
+            },
+
+            cli.Command{
+                Name:      "export-slashing-protection",
+                Usage:     "Prepare EIP-3076 slashing protection history exported by a validator client to a file in the validator keychain folder, adding beacon chain watermarks for the node's validators",
+                UsageText: "rocketpool api wallet export-slashing-protection file",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 1); err != nil { return err }
+                    file, err := cliutils.ValidateRelativePath("interchange file", c.Args().Get(0))
+                    if err != nil { return err }
+
+                    // Run
+                    api.Output(c).PrintResponse(exportSlashingProtection(c, file))
+                    return nil
+
+                },
+            },
+
+            cli.Command{
+                Name:      "import-slashing-protection",
+                Usage:     "Check an EIP-3076 slashing protection history file in the validator keychain folder before a validator client imports it",
+                UsageText: "rocketpool api wallet import-slashing-protection file",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 1); err != nil { return err }
+                    file, err := cliutils.ValidateRelativePath("interchange file", c.Args().Get(0))
+                    if err != nil { return err }
+
+                    // Run
+                    api.Output(c).PrintResponse(importSlashingProtection(c, file))
+                    return nil
+
+                },
+            },
+
+            cli.Command{
+                Name:      "verify-keys",
+                Usage:     "Check the validator keys in each validator client keystore against the node wallet and minipools, optionally repairing them and deleting extra keys",
+                UsageText: "rocketpool api wallet verify-keys repair delete-extra",
+                Action: func(c *cli.Context) error {
+
+                    // Validate args
+                    if err := cliutils.ValidateArgCount(c, 2); err != nil { return err }
+                    repair, err := cliutils.ValidateBool("repair", c.Args().Get(0))
+                    if err != nil { return err }
+                    deleteExtra, err := cliutils.ValidateBool("delete-extra", c.Args().Get(1))
+                    if err != nil { return err }
+
+                    // Run
+                    api.Output(c).PrintResponse(verifyValidatorKeys(c, repair, deleteExtra))
                     return nil
 
                 },
@@ -0,0 +1,104 @@
+package wallet
+
+import (
+    "fmt"
+    "os"
+    "path/filepath"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+)
+
+
+// Config
+// Watermarks are set this many epochs past the beacon chain head, so that validators can't sign again until the previous validator client has stopped
+const SlashingProtectionEpochMargin = 1
+
+
+func exportSlashingProtection(c *cli.Context, file string) (*api.ExportSlashingProtectionResponse, error) {
+
+    // Get services
+    if err := services.RequireNodeWallet(c); err != nil { return nil, err }
+    if err := services.RequireRocketStorage(c); err != nil { return nil, err }
+    if err := services.RequireBeaconClientSynced(c); err != nil { return nil, err }
+    cfg, err := services.GetConfig(c)
+    if err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+    bc, err := services.GetBeaconClient(c)
+    if err != nil { return nil, err }
+
+    // Response
+    response := api.ExportSlashingProtectionResponse{}
+
+    // Get node account
+    nodeAccount, err := w.GetNodeAccount()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get node's validating pubkeys
+    pubkeys, err := minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAccount.Address, nil)
+    if err != nil {
+        return nil, err
+    }
+
+    // Get eth2 config & beacon head
+    eth2Config, err := bc.GetEth2Config()
+    if err != nil {
+        return nil, err
+    }
+    head, err := bc.GetBeaconHead()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get history held for keys registered with a remote signer, or exported by the validator client
+    path := filepath.Join(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), file)
+    var history *eth2.SlashingProtectionInterchange
+    if cfg.UsesRemoteSigner() {
+        history, err = w.LoadSlashingProtection(config.RemoteSignerValidatorKeystore)
+    } else {
+        history, err = keystore.LoadSlashingProtection(path)
+        if err == nil && history == nil {
+            err = fmt.Errorf("No slashing protection history was exported by the validator client to %s", path)
+        }
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    // Build interchange from the history for the node's validators
+    interchange := eth2.NewSlashingProtectionInterchange(eth2Config.GenesisValidatorsRoot)
+    if history != nil {
+        if err := interchange.Merge(history, pubkeys); err != nil {
+            return nil, err
+        }
+    }
+
+    // Add beacon chain watermarks for the node's validators
+    watermarkEpoch := head.Epoch + SlashingProtectionEpochMargin
+    watermarkSlot := (watermarkEpoch + 1) * eth2Config.SlotsPerEpoch - 1
+    for _, pubkey := range pubkeys {
+        interchange.AddWatermark(pubkey, watermarkSlot, head.JustifiedEpoch, watermarkEpoch)
+    }
+
+    // Write interchange for the next validator client to import
+    if err := keystore.StoreSlashingProtection(path, interchange); err != nil {
+        return nil, err
+    }
+    response.Interchange = *interchange
+
+    // Return response
+    return &response, nil
+
+}
+
@@ -0,0 +1,100 @@
+package wallet
+
+import (
+    "fmt"
+    "os"
+    "path/filepath"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+)
+
+
+func importSlashingProtection(c *cli.Context, file string) (*api.ImportSlashingProtectionResponse, error) {
+
+    // Get services
+    if err := services.RequireNodeWallet(c); err != nil { return nil, err }
+    if err := services.RequireRocketStorage(c); err != nil { return nil, err }
+    cfg, err := services.GetConfig(c)
+    if err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+    bc, err := services.GetBeaconClient(c)
+    if err != nil { return nil, err }
+
+    // Response
+    response := api.ImportSlashingProtectionResponse{}
+
+    // Load interchange
+    path := filepath.Join(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), file)
+    interchange, err := keystore.LoadSlashingProtection(path)
+    if err != nil {
+        return nil, err
+    }
+    if interchange == nil {
+        return nil, fmt.Errorf("No slashing protection interchange was found at %s", path)
+    }
+
+    // Check interchange is for the current network
+    eth2Config, err := bc.GetEth2Config()
+    if err != nil {
+        return nil, err
+    }
+    history := eth2.NewSlashingProtectionInterchange(eth2Config.GenesisValidatorsRoot)
+    if err := history.Merge(interchange, nil); err != nil {
+        return nil, err
+    }
+
+    // Merge interchange into the history held for keys registered with a remote signer
+    // History for other validator clients is imported by the client itself
+    if cfg.UsesRemoteSigner() {
+        storedHistory, err := w.LoadSlashingProtection(config.RemoteSignerValidatorKeystore)
+        if err != nil {
+            return nil, err
+        }
+        if storedHistory != nil {
+            if err := history.Merge(storedHistory, nil); err != nil {
+                return nil, err
+            }
+        }
+        if err := w.StoreSlashingProtection(config.RemoteSignerValidatorKeystore, history); err != nil {
+            return nil, err
+        }
+    }
+    response.ValidatorKeys, err = interchange.GetPubkeys()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get node account
+    nodeAccount, err := w.GetNodeAccount()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get node's validating pubkeys without history
+    pubkeys, err := minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAccount.Address, nil)
+    if err != nil {
+        return nil, err
+    }
+    response.MissingValidatorKeys = []types.ValidatorPubkey{}
+    for _, pubkey := range pubkeys {
+        if interchange.GetData(pubkey) == nil {
+            response.MissingValidatorKeys = append(response.MissingValidatorKeys, pubkey)
+        }
+    }
+
+    // Return response
+    return &response, nil
+
+}
+
@@ -15,19 +15,33 @@ func setPassword(c *cli.Context, password string) (*api.SetPasswordResponse, err
     // Get services
     pm, err := services.GetPasswordManager(c)
     if err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
 
     // Response
     response := api.SetPasswordResponse{}
 
     // Check if password is already set
-    if pm.IsPasswordSet() {
+    passwordSet, err := pm.IsPasswordSet()
+    if err != nil {
+        return nil, err
+    }
+    if passwordSet {
         return nil, errors.New("The node password is already set")
     }
 
-    // Set password
+    // Check the password against an existing wallet
+    if err := w.CheckPassword(password); err != nil {
+        return nil, err
+    }
+
+    // Set password & load an existing wallet
     if err := pm.SetPassword(password); err != nil {
         return nil, err
     }
+    if _, err := w.GetInitialized(); err != nil {
+        return nil, err
+    }
 
     // Return response
     return &response, nil
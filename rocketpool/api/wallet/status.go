@@ -20,7 +20,10 @@ func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
     response := api.WalletStatusResponse{}
 
     // Get wallet status
-    response.PasswordSet = pm.IsPasswordSet()
+    response.PasswordSet, err = pm.IsPasswordSet()
+    if err != nil {
+        return nil, err
+    }
     response.WalletInitialized = w.IsInitialized()
 
     // Get accounts if initialized
@@ -0,0 +1,205 @@
+package wallet
+
+import (
+    "errors"
+    "fmt"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+func verifyValidatorKeys(c *cli.Context, repair, deleteExtra bool) (*api.VerifyValidatorKeysResponse, error) {
+
+    // Get services
+    if err := services.RequireNodeWallet(c); err != nil { return nil, err }
+    if err := services.RequireRocketStorage(c); err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+    bc, err := services.GetBeaconClient(c)
+    if err != nil { return nil, err }
+
+    // Response
+    response := api.VerifyValidatorKeysResponse{
+        Keystores: []api.KeystoreValidatorKeys{},
+        Repaired: repair,
+        DeletedExtra: deleteExtra,
+    }
+
+    // Get node account
+    nodeAccount, err := w.GetNodeAccount()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get node's validating pubkeys
+    pubkeys, err := minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAccount.Address, nil)
+    if err != nil {
+        return nil, err
+    }
+    response.ValidatorKeys = pubkeys
+    expected := make(map[types.ValidatorPubkey]bool, len(pubkeys))
+    for _, pubkey := range pubkeys {
+        expected[pubkey] = true
+    }
+
+    // Extra key deletion checks, shared between keystores
+    deletableErrors := make(map[types.ValidatorPubkey]error)
+    var currentEpoch uint64
+    if deleteExtra {
+        head, err := bc.GetBeaconHead()
+        if err != nil {
+            return nil, fmt.Errorf("Could not get beacon chain head: %w", err)
+        }
+        currentEpoch = head.Epoch
+    }
+
+    // Verify keystores
+    for _, name := range w.GetKeystoreNames() {
+        keystore := api.KeystoreValidatorKeys{
+            Client: name,
+            MissingKeys: []types.ValidatorPubkey{},
+            ExtraKeys: []types.ValidatorPubkey{},
+            UnknownKeys: []types.ValidatorPubkey{},
+            InvalidKeys: []api.InvalidValidatorKey{},
+            DeletedKeys: []types.ValidatorPubkey{},
+            RetainedKeys: []api.InvalidValidatorKey{},
+        }
+
+        // Get stored keys
+        storedPubkeys, err := w.ListStoredValidatorKeys(name)
+        if err != nil {
+            keystore.Error = err.Error()
+            response.Keystores = append(response.Keystores, keystore)
+            continue
+        }
+        keystore.KeyCount = len(storedPubkeys)
+        stored := make(map[types.ValidatorPubkey]bool, len(storedPubkeys))
+        for _, pubkey := range storedPubkeys {
+            stored[pubkey] = true
+        }
+
+        // Check for missing keys
+        for _, pubkey := range pubkeys {
+            if !stored[pubkey] {
+                keystore.MissingKeys = append(keystore.MissingKeys, pubkey)
+            }
+        }
+
+        // Check stored keys; keys not for a validating minipool are extra if derived from the wallet, or unknown otherwise
+        for _, pubkey := range storedPubkeys {
+            if expected[pubkey] {
+                if err := w.CheckStoredValidatorKey(name, pubkey); err != nil {
+                    keystore.InvalidKeys = append(keystore.InvalidKeys, api.InvalidValidatorKey{Pubkey: pubkey, Error: err.Error()})
+                }
+            } else if _, err := w.GetValidatorKeyByPubkey(pubkey); err == nil {
+                keystore.ExtraKeys = append(keystore.ExtraKeys, pubkey)
+            } else {
+                keystore.UnknownKeys = append(keystore.UnknownKeys, pubkey)
+            }
+        }
+
+        // Repair keystore
+        if repair {
+            for _, pubkey := range keystore.MissingKeys {
+                if err := w.RestoreStoredValidatorKey(name, pubkey); err != nil {
+                    return nil, err
+                }
+            }
+            for _, invalidKey := range keystore.InvalidKeys {
+                if err := w.RestoreStoredValidatorKey(name, invalidKey.Pubkey); err != nil {
+                    return nil, err
+                }
+            }
+        }
+
+        // Delete extra keys which have no open minipool or active beacon chain validator
+        // Unknown keys are never deleted as they can't be recovered from the wallet
+        if deleteExtra {
+            for _, pubkey := range keystore.ExtraKeys {
+                deletableErr, checked := deletableErrors[pubkey]
+                if !checked {
+                    deletableErr = checkExtraKeyDeletable(rp, bc, pubkey, currentEpoch)
+                    deletableErrors[pubkey] = deletableErr
+                }
+                if deletableErr != nil {
+                    keystore.RetainedKeys = append(keystore.RetainedKeys, api.InvalidValidatorKey{Pubkey: pubkey, Error: deletableErr.Error()})
+                    continue
+                }
+                if err := w.DeleteStoredValidatorKey(name, pubkey); err != nil {
+                    return nil, err
+                }
+                keystore.DeletedKeys = append(keystore.DeletedKeys, pubkey)
+            }
+        }
+
+        // Add keystore
+        response.Keystores = append(response.Keystores, keystore)
+
+    }
+
+    // Save wallet
+    if repair {
+        if err := w.Save(); err != nil {
+            return nil, err
+        }
+    }
+
+    // Return response
+    return &response, nil
+
+}
+
+
+// Check that an extra validator key can be safely deleted
+// Keys are retained while their minipool is open or their validator is active or pending on the beacon chain
+// Keys for destroyed or withdrawable minipools can be deleted once their validator has exited
+func checkExtraKeyDeletable(rp *rocketpool.RocketPool, bc beacon.Client, pubkey types.ValidatorPubkey, currentEpoch uint64) error {
+
+    // Check for an open minipool
+    minipoolAddress, err := minipool.GetMinipoolByPubkey(rp, pubkey, nil)
+    if err != nil {
+        return err
+    }
+    if minipoolAddress != (common.Address{}) {
+        exists, err := minipool.GetMinipoolExists(rp, minipoolAddress, nil)
+        if err != nil {
+            return err
+        }
+        if exists {
+            mp, err := minipool.NewMinipool(rp, minipoolAddress)
+            if err != nil {
+                return err
+            }
+            status, err := mp.GetStatus(nil)
+            if err != nil {
+                return err
+            }
+            if status != types.Withdrawable {
+                return fmt.Errorf("Minipool %s for the key is %s", minipoolAddress.Hex(), status.String())
+            }
+        }
+    }
+
+    // Check for an active or pending validator on the beacon chain
+    validatorStatus, err := bc.GetValidatorStatus(pubkey, nil)
+    if err != nil {
+        return fmt.Errorf("Could not get validator status: %w", err)
+    }
+    if validatorStatus.Exists && validatorStatus.ExitEpoch > currentEpoch {
+        return errors.New("The validator for the key has not exited the beacon chain")
+    }
+
+    // Return
+    return nil
+
+}
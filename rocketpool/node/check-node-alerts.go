@@ -0,0 +1,217 @@
+package node
+
+import (
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/services/notifier"
+    "github.com/rocket-pool/smartnode/shared/services/wallet"
+    "github.com/rocket-pool/smartnode/shared/utils/log"
+    "github.com/rocket-pool/smartnode/shared/utils/math"
+    "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+
+// Check node alerts task
+type checkNodeAlerts struct {
+    c *cli.Context
+    log log.ColorLogger
+    w *wallet.Wallet
+    rp *rocketpool.RocketPool
+    bc beacon.Client
+    n *notifier.Notifier
+}
+
+
+// Create check node alerts task
+func newCheckNodeAlerts(c *cli.Context, logger log.ColorLogger) (*checkNodeAlerts, error) {
+
+    // Get services
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+    bc, err := services.GetBeaconClient(c)
+    if err != nil { return nil, err }
+    n, err := services.GetNotifier(c)
+    if err != nil { return nil, err }
+
+    // Return task
+    return &checkNodeAlerts{
+        c: c,
+        log: logger,
+        w: w,
+        rp: rp,
+        bc: bc,
+        n: n,
+    }, nil
+
+}
+
+
+// Check for node conditions requiring operator attention and notify them
+func (t *checkNodeAlerts) run() error {
+
+    // Wait for eth clients to sync
+    if err := services.WaitEthClientSynced(t.c, true); err != nil {
+        return err
+    }
+    if err := services.WaitBeaconClientSynced(t.c, true); err != nil {
+        return err
+    }
+
+    // Get node account
+    nodeAccount, err := t.w.GetNodeAccount()
+    if err != nil {
+        return err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var rplStake *big.Int
+    var minimumRplStake *big.Int
+    var minipoolStatuses map[common.Address]types.MinipoolStatus
+
+    // Get data
+    wg.Go(func() error {
+        var err error
+        rplStake, err = node.GetNodeRPLStake(t.rp, nodeAccount.Address, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        minimumRplStake, err = node.GetNodeMinimumRPLStake(t.rp, nodeAccount.Address, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        minipoolStatuses, err = t.getMinipoolStatuses(nodeAccount.Address)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return err
+    }
+
+    // Check RPL stake
+    rplStakeKey := fmt.Sprintf("%s/%s", notifier.RplStakeLow, nodeAccount.Address.Hex())
+    if rplStake.Cmp(minimumRplStake) < 0 {
+        t.notify(notifier.Event{
+            Type: notifier.RplStakeLow,
+            Key: rplStakeKey,
+            Title: "RPL stake below minimum",
+            Message: fmt.Sprintf("The node has %.6f RPL staked, which is below the minimum of %.6f RPL. It cannot create new minipools, and will not receive RPL rewards.", math.RoundDown(eth.WeiToEth(rplStake), 6), math.RoundDown(eth.WeiToEth(minimumRplStake), 6)),
+            Node: nodeAccount.Address.Hex(),
+        })
+    } else {
+        t.n.Resolve(rplStakeKey)
+    }
+
+    // Check for dissolved minipools & get active minipools
+    activeAddresses := []common.Address{}
+    for address, status := range minipoolStatuses {
+        switch status {
+            case types.Dissolved:
+                t.notify(notifier.Event{
+                    Type: notifier.MinipoolDissolved,
+                    Key: fmt.Sprintf("%s/%s", notifier.MinipoolDissolved, address.Hex()),
+                    Title: "Minipool dissolved",
+                    Message: fmt.Sprintf("Minipool %s has been dissolved. It can be closed to recover the deposited ETH.", address.Hex()),
+                    Node: nodeAccount.Address.Hex(),
+                })
+            case types.Staking, types.Withdrawable:
+                activeAddresses = append(activeAddresses, address)
+        }
+    }
+    if len(activeAddresses) == 0 {
+        return nil
+    }
+
+    // Check for slashed validators
+    validators, err := rp.GetMinipoolValidators(t.rp, t.bc, activeAddresses, nil, nil)
+    if err != nil {
+        return err
+    }
+    for address, validator := range validators {
+        if !(validator.Exists && validator.Slashed) { continue }
+        t.notify(notifier.Event{
+            Type: notifier.ValidatorSlashed,
+            Key: fmt.Sprintf("%s/%s", notifier.ValidatorSlashed, validator.Pubkey.Hex()),
+            Title: "Validator slashed",
+            Message: fmt.Sprintf("The validator %s for minipool %s has been slashed.", validator.Pubkey.Hex(), address.Hex()),
+            Node: nodeAccount.Address.Hex(),
+        })
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Publish an event, logging any notification errors
+func (t *checkNodeAlerts) notify(event notifier.Event) {
+    if err := t.n.Notify(event); err != nil {
+        t.log.Println(err)
+    }
+}
+
+
+// Get the node's minipool statuses
+func (t *checkNodeAlerts) getMinipoolStatuses(nodeAddress common.Address) (map[common.Address]types.MinipoolStatus, error) {
+
+    // Get minipool addresses
+    addresses, err := minipool.GetNodeMinipoolAddresses(t.rp, nodeAddress, nil)
+    if err != nil {
+        return map[common.Address]types.MinipoolStatus{}, err
+    }
+
+    // Load minipool statuses in batches
+    statuses := make([]types.MinipoolStatus, len(addresses))
+    for bsi := 0; bsi < len(addresses); bsi += MinipoolStatusBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + MinipoolStatusBatchSize
+        if mei > len(addresses) { mei = len(addresses) }
+
+        // Load statuses
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                mp, err := minipool.NewMinipool(t.rp, addresses[mi])
+                if err != nil {
+                    return err
+                }
+                status, err := mp.GetStatus(nil)
+                if err == nil { statuses[mi] = status }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return map[common.Address]types.MinipoolStatus{}, err
+        }
+
+    }
+
+    // Return
+    minipoolStatuses := make(map[common.Address]types.MinipoolStatus, len(addresses))
+    for mi, address := range addresses {
+        minipoolStatuses[address] = statuses[mi]
+    }
+    return minipoolStatuses, nil
+
+}
@@ -0,0 +1,176 @@
+package node
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/metrics"
+    "github.com/rocket-pool/smartnode/shared/services/wallet"
+    "github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+
+// Settings
+const MinipoolStatusBatchSize = 20
+
+
+// Collect metrics task
+type collectMetrics struct {
+    c *cli.Context
+    log log.ColorLogger
+    w *wallet.Wallet
+    ec *ethclient.Client
+    rp *rocketpool.RocketPool
+}
+
+
+// Create collect metrics task
+func newCollectMetrics(c *cli.Context, logger log.ColorLogger) (*collectMetrics, error) {
+
+    // Get services
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    ec, err := services.GetEthClient(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+
+    // Return task
+    return &collectMetrics{
+        c: c,
+        log: logger,
+        w: w,
+        ec: ec,
+        rp: rp,
+    }, nil
+
+}
+
+
+// Collect node metrics
+func (t *collectMetrics) run() error {
+
+    // Wait for eth client to sync
+    if err := services.WaitEthClientSynced(t.c, false); err != nil {
+        return err
+    }
+
+    // Get node account
+    nodeAccount, err := t.w.GetNodeAccount()
+    if err != nil {
+        return err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var ethBalance float64
+    var rplStake float64
+    var minimumRplStake float64
+    var minipoolStatusCounts map[types.MinipoolStatus]int64
+
+    // Get data
+    wg.Go(func() error {
+        balance, err := t.ec.BalanceAt(context.Background(), nodeAccount.Address, nil)
+        if err == nil {
+            ethBalance = eth.WeiToEth(balance)
+        }
+        return err
+    })
+    wg.Go(func() error {
+        stake, err := node.GetNodeRPLStake(t.rp, nodeAccount.Address, nil)
+        if err == nil {
+            rplStake = eth.WeiToEth(stake)
+        }
+        return err
+    })
+    wg.Go(func() error {
+        minimumStake, err := node.GetNodeMinimumRPLStake(t.rp, nodeAccount.Address, nil)
+        if err == nil {
+            minimumRplStake = eth.WeiToEth(minimumStake)
+        }
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        minipoolStatusCounts, err = t.getMinipoolStatusCounts(nodeAccount.Address)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return fmt.Errorf("Could not collect node metrics: %w", err)
+    }
+
+    // Update metrics
+    metrics.SetGaugeFloat("node/eth_balance", ethBalance)
+    metrics.SetGaugeFloat("node/rpl_stake", rplStake)
+    metrics.SetGaugeFloat("node/rpl_stake_minimum", minimumRplStake)
+    for status, name := range types.MinipoolStatuses {
+        metrics.SetGauge(fmt.Sprintf("node/minipools/%s", name), minipoolStatusCounts[types.MinipoolStatus(status)])
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Get the node's minipool counts by status
+func (t *collectMetrics) getMinipoolStatusCounts(nodeAddress common.Address) (map[types.MinipoolStatus]int64, error) {
+
+    // Get minipool addresses
+    addresses, err := minipool.GetNodeMinipoolAddresses(t.rp, nodeAddress, nil)
+    if err != nil {
+        return map[types.MinipoolStatus]int64{}, err
+    }
+
+    // Load minipool statuses in batches
+    statuses := make([]types.MinipoolStatus, len(addresses))
+    for bsi := 0; bsi < len(addresses); bsi += MinipoolStatusBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + MinipoolStatusBatchSize
+        if mei > len(addresses) { mei = len(addresses) }
+
+        // Load statuses
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                mp, err := minipool.NewMinipool(t.rp, addresses[mi])
+                if err != nil {
+                    return err
+                }
+                status, err := mp.GetStatus(nil)
+                if err == nil { statuses[mi] = status }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return map[types.MinipoolStatus]int64{}, err
+        }
+
+    }
+
+    // Count statuses
+    counts := map[types.MinipoolStatus]int64{}
+    for _, status := range statuses {
+        counts[status]++
+    }
+
+    // Return
+    return counts, nil
+
+}
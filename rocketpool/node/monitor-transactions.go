@@ -0,0 +1,93 @@
+package node
+
+import (
+    "fmt"
+
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/notifier"
+    "github.com/rocket-pool/smartnode/shared/services/wallet"
+    "github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+
+// Monitor transactions task
+type monitorTransactions struct {
+    c *cli.Context
+    log log.ColorLogger
+    w *wallet.Wallet
+    n *notifier.Notifier
+}
+
+
+// Create monitor transactions task
+func newMonitorTransactions(c *cli.Context, logger log.ColorLogger) (*monitorTransactions, error) {
+
+    // Get services
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    n, err := services.GetNotifier(c)
+    if err != nil { return nil, err }
+
+    // Return task
+    return &monitorTransactions{
+        c: c,
+        log: logger,
+        w: w,
+        n: n,
+    }, nil
+
+}
+
+
+// Monitor pending node transactions and re-broadcast stuck transactions
+func (t *monitorTransactions) run() error {
+
+    // Wait for eth client to sync
+    if err := services.WaitEthClientSynced(t.c, true); err != nil {
+        return err
+    }
+
+    // Check pending transactions
+    updates, err := t.w.MonitorTransactions()
+    if err != nil {
+        return err
+    }
+
+    // Log updates
+    for _, update := range updates {
+        tx := update.Transaction
+        switch {
+            case update.Error != nil:
+                t.log.Println(fmt.Errorf("Could not re-broadcast transaction %s with nonce %d: %w", update.PreviousHash.Hex(), tx.Nonce, update.Error))
+            case update.Replaced:
+                t.log.Printlnf("Transaction %s with nonce %d was not mined in time, replaced with %s at %.6f gwei.", update.PreviousHash.Hex(), tx.Nonce, tx.Hash().Hex(), eth.WeiToGwei(tx.GasPrice))
+            case update.Capped:
+                t.log.Printlnf("Transaction %s with nonce %d was not mined in time and can't be replaced above the maximum gas price.", tx.Hash().Hex(), tx.Nonce)
+                t.notifyStuckTransaction(tx)
+            case tx.Status == wallet.TxStatusMined:
+                t.log.Printlnf("Transaction %s with nonce %d was mined.", tx.Hash().Hex(), tx.Nonce)
+            case tx.Status == wallet.TxStatusDropped:
+                t.log.Printlnf("Transaction %s with nonce %d was dropped; its nonce was used by another transaction.", tx.Hash().Hex(), tx.Nonce)
+        }
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Notify that a transaction is stuck below the maximum gas price
+func (t *monitorTransactions) notifyStuckTransaction(tx wallet.PendingTransaction) {
+    if err := t.n.Notify(notifier.Event{
+        Type: notifier.TransactionStuck,
+        Key: fmt.Sprintf("%s/%s/%d", notifier.TransactionStuck, tx.From.Hex(), tx.Nonce),
+        Title: "Transaction stuck",
+        Message: fmt.Sprintf("Transaction %s with nonce %d has not been mined at %.6f gwei, and can't be replaced without exceeding the maximum gas price.", tx.Hash().Hex(), tx.Nonce, eth.WeiToGwei(tx.GasPrice)),
+    }); err != nil {
+        t.log.Println(err)
+    }
+}
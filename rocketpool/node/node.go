@@ -8,18 +8,26 @@ import (
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/metrics"
     "github.com/rocket-pool/smartnode/shared/utils/log"
+    "github.com/rocket-pool/smartnode/shared/utils/scheduler"
 )
 
 
 // Config
 var tasksInterval, _ = time.ParseDuration("5m")
-var taskCooldown, _ = time.ParseDuration("10s")
+var taskTimeout, _ = time.ParseDuration("30m")
+var taskStartDelay, _ = time.ParseDuration("10s")
+var collectMetricsInterval, _ = time.ParseDuration("1m")
+var monitorTransactionsInterval, _ = time.ParseDuration("1m")
 const (
     MaxConcurrentEth1Requests = 200
 
     ClaimRplRewardsColor = color.FgGreen
     StakePrelaunchMinipoolsColor = color.FgBlue
+    CollectMetricsColor = color.FgWhite
+    MonitorTransactionsColor = color.FgYellow
+    CheckNodeAlertsColor = color.FgMagenta
     ErrorColor = color.FgRed
 )
 
@@ -30,6 +38,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
         Name:      name,
         Aliases:   aliases,
         Usage:     "Run Rocket Pool node activity daemon",
+        Flags: []cli.Flag{
+            cli.StringFlag{
+                Name:  "metricsAddress, m",
+                Usage: "Serve Prometheus metrics on the specified `address` (e.g. 0.0.0.0:9102)",
+            },
+        },
         Action: func(c *cli.Context) error {
             return run(c)
         },
@@ -43,6 +57,12 @@ func run(c *cli.Context) error {
     // Configure
     configureHTTP()
 
+    // Start metrics server
+    metricsAddress := c.String("metricsAddress")
+    if metricsAddress != "" {
+        if err := metrics.Start(metricsAddress); err != nil { return err }
+    }
+
     // Wait until node is registered
     if err := services.WaitNodeRegistered(c, true); err != nil { return err }
 
@@ -51,22 +71,66 @@ func run(c *cli.Context) error {
     if err != nil { return err }
     stakePrelaunchMinipools, err := newStakePrelaunchMinipools(c, log.NewColorLogger(StakePrelaunchMinipoolsColor))
     if err != nil { return err }
+    monitorTransactions, err := newMonitorTransactions(c, log.NewColorLogger(MonitorTransactionsColor))
+    if err != nil { return err }
 
     // Initialize error logger
     errorLog := log.NewColorLogger(ErrorColor)
 
-    // Run task loop
-    for {
-        if err := claimRplRewards.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(taskCooldown)
-        if err := stakePrelaunchMinipools.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(tasksInterval)
+    // Initialize scheduler
+    s := scheduler.NewScheduler(errorLog)
+
+    // Record task metrics
+    if metricsAddress != "" {
+        s.AddTaskListener(metrics.RecordTaskRun)
     }
 
+    // Notify repeated task failures
+    n, err := services.GetNotifier(c)
+    if err != nil { return err }
+    if n.Enabled() {
+        s.AddTaskListener(n.RecordTaskRun)
+    }
+
+    // Register tasks
+    if err := s.Register("claimRplRewards", claimRplRewards.run, scheduler.TaskSettings{
+        Interval: tasksInterval,
+        Timeout: taskTimeout,
+    }); err != nil { return err }
+    if err := s.Register("stakePrelaunchMinipools", stakePrelaunchMinipools.run, scheduler.TaskSettings{
+        Interval: tasksInterval,
+        Timeout: taskTimeout,
+        StartDelay: taskStartDelay,
+    }); err != nil { return err }
+    if err := s.Register("monitorTransactions", monitorTransactions.run, scheduler.TaskSettings{
+        Interval: monitorTransactionsInterval,
+        Timeout: taskTimeout,
+    }); err != nil { return err }
+
+    // Register node alerts task if notifications are enabled
+    if n.Enabled() {
+        checkNodeAlerts, err := newCheckNodeAlerts(c, log.NewColorLogger(CheckNodeAlertsColor))
+        if err != nil { return err }
+        if err := s.Register("checkNodeAlerts", checkNodeAlerts.run, scheduler.TaskSettings{
+            Interval: tasksInterval,
+            Timeout: taskTimeout,
+        }); err != nil { return err }
+    }
+
+    // Register metrics collection task if metrics are enabled
+    if metricsAddress != "" {
+        collectMetrics, err := newCollectMetrics(c, log.NewColorLogger(CollectMetricsColor))
+        if err != nil { return err }
+        if err := s.Register("collectMetrics", collectMetrics.run, scheduler.TaskSettings{
+            Interval: collectMetricsInterval,
+            Timeout: taskTimeout,
+        }); err != nil { return err }
+    }
+
+    // Run tasks
+    s.Run()
+    return nil
+
 }
 
 
@@ -1,6 +1,7 @@
 package node
 
 import (
+    "bytes"
     "context"
     "errors"
     "fmt"
@@ -11,6 +12,7 @@ import (
     "github.com/docker/docker/api/types"
     "github.com/docker/docker/client"
     "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
     "github.com/rocket-pool/rocketpool-go/minipool"
     "github.com/rocket-pool/rocketpool-go/rocketpool"
     rptypes "github.com/rocket-pool/rocketpool-go/types"
@@ -20,6 +22,7 @@ import (
     "github.com/rocket-pool/smartnode/shared/services"
     "github.com/rocket-pool/smartnode/shared/services/beacon"
     "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/services/notifier"
     "github.com/rocket-pool/smartnode/shared/services/wallet"
     "github.com/rocket-pool/smartnode/shared/utils/log"
     "github.com/rocket-pool/smartnode/shared/utils/validator"
@@ -29,6 +32,7 @@ import (
 // Settings
 const ValidatorContainerSuffix = "_validator"
 const BeaconContainerSuffix = "_eth2"
+const DepositLookbackBlocks = 10000
 var validatorRestartTimeout, _ = time.ParseDuration("5s")
 
 
@@ -38,9 +42,11 @@ type stakePrelaunchMinipools struct {
     log log.ColorLogger
     cfg config.RocketPoolConfig
     w *wallet.Wallet
+    ec *ethclient.Client
     rp *rocketpool.RocketPool
     bc beacon.Client
     d *client.Client
+    n *notifier.Notifier
 }
 
 
@@ -52,12 +58,16 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
     if err != nil { return nil, err }
     w, err := services.GetWallet(c)
     if err != nil { return nil, err }
+    ec, err := services.GetEthClient(c)
+    if err != nil { return nil, err }
     rp, err := services.GetRocketPool(c)
     if err != nil { return nil, err }
     bc, err := services.GetBeaconClient(c)
     if err != nil { return nil, err }
     d, err := services.GetDocker(c)
     if err != nil { return nil, err }
+    n, err := services.GetNotifier(c)
+    if err != nil { return nil, err }
 
     // Return task
     return &stakePrelaunchMinipools{
@@ -65,9 +75,11 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
         log: logger,
         cfg: cfg,
         w: w,
+        ec: ec,
         rp: rp,
         bc: bc,
         d: d,
+        n: n,
     }, nil
 
 }
@@ -190,8 +202,8 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool,  eth2Conf
         return err
     }
 
-    // Create new validator key
-    validatorKey, err := t.w.CreateValidatorKey()
+    // Get next validator key; it is only stored once the pre-stake checks pass
+    validatorKey, err := t.w.GetNextValidatorKey()
     if err != nil {
         return err
     }
@@ -202,6 +214,37 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool,  eth2Conf
         return err
     }
 
+    // Check deposit data & existing deposits before staking
+    if err := t.checkDepositSafety(mp, depositData, depositDataRoot, withdrawalCredentials, eth2Config); err != nil {
+        var checkErr *stakeCheckUnavailableError
+        if errors.As(err, &checkErr) {
+            t.log.Printlnf("Could not complete pre-stake checks for minipool %s, it will be checked again next cycle.", mp.Address.Hex())
+            return fmt.Errorf("Could not complete pre-stake checks: %w", err)
+        }
+        t.log.Printlnf("Minipool %s failed pre-stake checks and will not be staked.", mp.Address.Hex())
+        if notifyErr := t.n.Notify(notifier.Event{
+            Type: notifier.StakeCheckFailed,
+            Key: fmt.Sprintf("%s/%s", notifier.StakeCheckFailed, mp.Address.Hex()),
+            Title: "Minipool failed pre-stake checks",
+            Message: fmt.Sprintf("Minipool %s was not staked because it failed pre-stake safety checks: %s", mp.Address.Hex(), err.Error()),
+        }); notifyErr != nil {
+            t.log.Println(notifyErr)
+        }
+        return fmt.Errorf("Pre-stake check failed: %w", err)
+    }
+
+    // Store validator key & save wallet, so the key is never reused
+    createdKey, err := t.w.CreateValidatorKey()
+    if err != nil {
+        return err
+    }
+    if !bytes.Equal(createdKey.PublicKey().Marshal(), validatorKey.PublicKey().Marshal()) {
+        return errors.New("The next validator key changed during pre-stake checks")
+    }
+    if err := t.w.Save(); err != nil {
+        return err
+    }
+
     // Get transactor
     opts, err := t.w.GetNodeAccountTransactor()
     if err != nil {
@@ -218,11 +261,6 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool,  eth2Conf
         return err
     }
 
-    // Save wallet
-    if err := t.w.Save(); err != nil {
-        return err
-    }
-
     // Log
     t.log.Printlnf("Successfully staked minipool %s.", mp.Address.Hex())
 
@@ -232,6 +270,65 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool,  eth2Conf
 }
 
 
+// Pre-stake check unavailable error
+// Returned when a pre-stake check could not be completed (e.g. a client request failed), rather than failing
+type stakeCheckUnavailableError struct {
+    err error
+}
+func (e *stakeCheckUnavailableError) Error() string {
+    return e.err.Error()
+}
+func (e *stakeCheckUnavailableError) Unwrap() error {
+    return e.err
+}
+
+
+// Check that deposit data is valid and that no deposit has already been made for the validator pubkey
+// Returns a stakeCheckUnavailableError if the checks could not be completed
+func (t *stakePrelaunchMinipools) checkDepositSafety(mp *minipool.Minipool, depositData validator.DepositData, depositDataRoot common.Hash, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config) error {
+
+    // Verify deposit data
+    if err := validator.VerifyDepositData(depositData, depositDataRoot, withdrawalCredentials, eth2Config); err != nil {
+        return fmt.Errorf("Invalid deposit data: %w", err)
+    }
+    pubkey := rptypes.BytesToValidatorPubkey(depositData.PublicKey)
+
+    // Check for an existing validator on the beacon chain
+    validatorStatus, err := t.bc.GetValidatorStatus(pubkey, nil)
+    if err != nil {
+        return &stakeCheckUnavailableError{fmt.Errorf("Could not get validator %s status: %w", pubkey.Hex(), err)}
+    }
+    if validatorStatus.Exists {
+        return fmt.Errorf("Validator %s already exists on the beacon chain with withdrawal credentials %s", pubkey.Hex(), validatorStatus.WithdrawalCredentials.Hex())
+    }
+
+    // Check for recent deposits not yet processed by the beacon chain
+    depositContractAddress, err := t.rp.GetAddress("casperDeposit")
+    if err != nil {
+        return &stakeCheckUnavailableError{fmt.Errorf("Could not get deposit contract address: %w", err)}
+    }
+    latestBlock, err := t.ec.BlockNumber(context.Background())
+    if err != nil {
+        return &stakeCheckUnavailableError{fmt.Errorf("Could not get latest block number: %w", err)}
+    }
+    var fromBlock uint64
+    if latestBlock > DepositLookbackBlocks {
+        fromBlock = latestBlock - DepositLookbackBlocks
+    }
+    deposits, err := validator.GetValidatorDeposits(t.ec, *depositContractAddress, pubkey, fromBlock, latestBlock)
+    if err != nil {
+        return &stakeCheckUnavailableError{err}
+    }
+    if len(deposits) > 0 {
+        return fmt.Errorf("Validator %s already has a deposit with withdrawal credentials %x", pubkey.Hex(), deposits[0].WithdrawalCredentials)
+    }
+
+    // Return
+    return nil
+
+}
+
+
 // Restart validator process
 func (t *stakePrelaunchMinipools) restartValidator() error {
 
@@ -83,6 +83,10 @@ func main() {
             Name:  "gasLimit, l",
             Usage: "Desired gas limit",
         },
+        cli.BoolFlag{
+            Name:  "dry-run",
+            Usage: "Simulate transactions against the pending state instead of sending them",
+        },
     }
 
     // Register commands
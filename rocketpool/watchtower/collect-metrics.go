@@ -0,0 +1,125 @@
+package watchtower
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/rocket-pool/rocketpool-go/dao/trustednode"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/metrics"
+    "github.com/rocket-pool/smartnode/shared/services/wallet"
+    "github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+
+// Collect metrics task
+type collectMetrics struct {
+    c *cli.Context
+    log log.ColorLogger
+    w *wallet.Wallet
+    ec *ethclient.Client
+    rp *rocketpool.RocketPool
+}
+
+
+// Create collect metrics task
+func newCollectMetrics(c *cli.Context, logger log.ColorLogger) (*collectMetrics, error) {
+
+    // Get services
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    ec, err := services.GetEthClient(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+
+    // Return task
+    return &collectMetrics{
+        c: c,
+        log: logger,
+        w: w,
+        ec: ec,
+        rp: rp,
+    }, nil
+
+}
+
+
+// Collect watchtower metrics
+func (t *collectMetrics) run() error {
+
+    // Wait for eth client to sync
+    if err := services.WaitEthClientSynced(t.c, false); err != nil {
+        return err
+    }
+
+    // Get node account
+    nodeAccount, err := t.w.GetNodeAccount()
+    if err != nil {
+        return err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var ethBalance float64
+    var trustedNode bool
+    var balancesBlock uint64
+    var pricesBlock uint64
+    var currentBlock uint64
+
+    // Get data
+    wg.Go(func() error {
+        balance, err := t.ec.BalanceAt(context.Background(), nodeAccount.Address, nil)
+        if err == nil {
+            ethBalance = eth.WeiToEth(balance)
+        }
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        trustedNode, err = trustednode.GetMemberExists(t.rp, nodeAccount.Address, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        balancesBlock, err = network.GetBalancesBlock(t.rp, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        pricesBlock, err = network.GetPricesBlock(t.rp, nil)
+        return err
+    })
+    wg.Go(func() error {
+        header, err := t.ec.HeaderByNumber(context.Background(), nil)
+        if err == nil {
+            currentBlock = header.Number.Uint64()
+        }
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return fmt.Errorf("Could not collect watchtower metrics: %w", err)
+    }
+
+    // Update metrics
+    // Submission ages show whether the oracle DAO is keeping network balances & prices up to date
+    metrics.SetGaugeFloat("watchtower/eth_balance", ethBalance)
+    metrics.SetGaugeBool("watchtower/trusted_node", trustedNode)
+    metrics.SetGauge("watchtower/network_balances_block", int64(balancesBlock))
+    metrics.SetGauge("watchtower/network_balances_age_blocks", int64(currentBlock) - int64(balancesBlock))
+    metrics.SetGauge("watchtower/rpl_price_block", int64(pricesBlock))
+    metrics.SetGauge("watchtower/rpl_price_age_blocks", int64(currentBlock) - int64(pricesBlock))
+
+    // Return
+    return nil
+
+}
@@ -4,7 +4,6 @@ import (
     "context"
     "fmt"
 
-    "github.com/ethereum/go-ethereum/common"
     "github.com/ethereum/go-ethereum/ethclient"
     "github.com/rocket-pool/rocketpool-go/dao/trustednode"
     "github.com/rocket-pool/rocketpool-go/minipool"
@@ -15,15 +14,12 @@ import (
     "golang.org/x/sync/errgroup"
 
     "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/state"
     "github.com/rocket-pool/smartnode/shared/services/wallet"
     "github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
 
-// Settings
-const MinipoolStatusBatchSize = 20
-
-
 // Dissolve timed out minipools task
 type dissolveTimedOutMinipools struct {
     c *cli.Context
@@ -31,6 +27,7 @@ type dissolveTimedOutMinipools struct {
     w *wallet.Wallet
     ec *ethclient.Client
     rp *rocketpool.RocketPool
+    ss *state.StateStore
 }
 
 
@@ -44,6 +41,8 @@ func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*diss
     if err != nil { return nil, err }
     rp, err := services.GetRocketPool(c)
     if err != nil { return nil, err }
+    ss, err := services.GetStateStore(c)
+    if err != nil { return nil, err }
 
     // Return task
     return &dissolveTimedOutMinipools{
@@ -52,6 +51,7 @@ func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*diss
         w: w,
         ec: ec,
         rp: rp,
+        ss: ss,
     }, nil
 
 }
@@ -111,21 +111,18 @@ func (t *dissolveTimedOutMinipools) run() error {
 // Get timed out minipools
 func (t *dissolveTimedOutMinipools) getTimedOutMinipools() ([]*minipool.Minipool, error) {
 
+    // Update state
+    if err := t.ss.Update(); err != nil {
+        return []*minipool.Minipool{}, fmt.Errorf("Could not update daemon state: %w", err)
+    }
+
     // Data
-    var wg1 errgroup.Group
-    var addresses []common.Address
+    var wg errgroup.Group
     var currentBlock uint64
     var launchTimeout uint64
 
-    // Get minipool addresses
-    wg1.Go(func() error {
-        var err error
-        addresses, err = minipool.GetMinipoolAddresses(t.rp, nil)
-        return err
-    })
-
     // Get current block
-    wg1.Go(func() error {
+    wg.Go(func() error {
         header, err := t.ec.HeaderByNumber(context.Background(), nil)
         if err == nil {
             currentBlock = header.Number.Uint64()
@@ -134,62 +131,29 @@ func (t *dissolveTimedOutMinipools) getTimedOutMinipools() ([]*minipool.Minipool
     })
 
     // Get launch timeout
-    wg1.Go(func() error {
+    wg.Go(func() error {
         var err error
         launchTimeout, err = protocol.GetMinipoolLaunchTimeout(t.rp, nil)
         return err
     })
 
     // Wait for data
-    if err := wg1.Wait(); err != nil {
+    if err := wg.Wait(); err != nil {
         return []*minipool.Minipool{}, err
     }
 
-    // Create minipool contracts
-    minipools := make([]*minipool.Minipool, len(addresses))
-    for mi, address := range addresses {
-        mp, err := minipool.NewMinipool(t.rp, address)
-        if err != nil {
-            return []*minipool.Minipool{}, err
-        }
-        minipools[mi] = mp
-    }
-
-    // Load minipool statuses in batches
-    statuses := make([]minipool.StatusDetails, len(minipools))
-    for bsi := 0; bsi < len(minipools); bsi += MinipoolStatusBatchSize {
-
-        // Get batch start & end index
-        msi := bsi
-        mei := bsi + MinipoolStatusBatchSize
-        if mei > len(minipools) { mei = len(minipools) }
-
-        // Log
-        //t.log.Printlnf("Checking minipools %d - %d of %d for timed out status...", msi + 1, mei, len(minipools))
-
-        // Load statuses
-        var wg errgroup.Group
-        for mi := msi; mi < mei; mi++ {
-            mi := mi
-            wg.Go(func() error {
-                mp := minipools[mi]
-                status, err := mp.GetStatusDetails(nil)
-                if err == nil { statuses[mi] = status }
-                return err
-            })
+    // Filter prelaunch minipools by status block
+    // The current block may be behind the synced state if it comes from a different upstream, so later status blocks are skipped
+    timedOutMinipools := []*minipool.Minipool{}
+    for _, mpState := range t.ss.GetMinipoolsByStatus(types.Prelaunch) {
+        if currentBlock < mpState.StatusBlock || (currentBlock - mpState.StatusBlock) < launchTimeout {
+            continue
         }
-        if err := wg.Wait(); err != nil {
+        mp, err := minipool.NewMinipool(t.rp, mpState.Address)
+        if err != nil {
             return []*minipool.Minipool{}, err
         }
-
-    }
-
-    // Filter minipools by status
-    timedOutMinipools := []*minipool.Minipool{}
-    for mi, mp := range minipools {
-        if statuses[mi].Status == types.Prelaunch && (currentBlock - statuses[mi].StatusBlock) >= launchTimeout {
-            timedOutMinipools = append(timedOutMinipools, mp)
-        }
+        timedOutMinipools = append(timedOutMinipools, mp)
     }
 
     // Return
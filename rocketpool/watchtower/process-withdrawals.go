@@ -1,21 +1,53 @@
 package watchtower
 
 import (
+    "context"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/rocket-pool/rocketpool-go/dao/trustednode"
+    "github.com/rocket-pool/rocketpool-go/minipool"
     "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings/protocol"
+    "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
     "github.com/urfave/cli"
+    "golang.org/x/sync/errgroup"
 
     "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/services/state"
     "github.com/rocket-pool/smartnode/shared/services/wallet"
     "github.com/rocket-pool/smartnode/shared/utils/log"
+    "github.com/rocket-pool/smartnode/shared/utils/math"
+    "github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
 
+// Settings
+const MinipoolWithdrawalDetailsBatchSize = 20
+
+
 // Process withdrawals task
 type processWithdrawals struct {
     c *cli.Context
     log log.ColorLogger
     w *wallet.Wallet
+    ec *ethclient.Client
     rp *rocketpool.RocketPool
+    bc beacon.Client
+    ss *state.StateStore
+}
+
+
+// Minipool withdrawal info
+type minipoolWithdrawalDetails struct {
+    Address common.Address
+    Pubkey types.ValidatorPubkey
+    TotalBalance *big.Int
+    Withdrawable bool
 }
 
 
@@ -25,15 +57,24 @@ func newProcessWithdrawals(c *cli.Context, logger log.ColorLogger) (*processWith
     // Get services
     w, err := services.GetWallet(c)
     if err != nil { return nil, err }
+    ec, err := services.GetEthClient(c)
+    if err != nil { return nil, err }
     rp, err := services.GetRocketPool(c)
     if err != nil { return nil, err }
+    bc, err := services.GetBeaconClient(c)
+    if err != nil { return nil, err }
+    ss, err := services.GetStateStore(c)
+    if err != nil { return nil, err }
 
     // Return task
     return &processWithdrawals{
         c: c,
         log: logger,
         w: w,
+        ec: ec,
         rp: rp,
+        bc: bc,
+        ss: ss,
     }, nil
 
 }
@@ -42,11 +83,276 @@ func newProcessWithdrawals(c *cli.Context, logger log.ColorLogger) (*processWith
 // Process withdrawals
 func (t *processWithdrawals) run() error {
 
-    // Process withdrawals
-    // TODO: implement
+    // Wait for eth clients to sync
+    if err := services.WaitEthClientSynced(t.c, true); err != nil {
+        return err
+    }
+    if err := services.WaitBeaconClientSynced(t.c, true); err != nil {
+        return err
+    }
+
+    // Get node account
+    nodeAccount, err := t.w.GetNodeAccount()
+    if err != nil {
+        return err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var nodeTrusted bool
+    var processWithdrawalsEnabled bool
+
+    // Get data
+    wg.Go(func() error {
+        var err error
+        nodeTrusted, err = trustednode.GetMemberExists(t.rp, nodeAccount.Address, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        processWithdrawalsEnabled, err = protocol.GetProcessWithdrawalsEnabled(t.rp, nil)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return err
+    }
+
+    // Check node trusted status & settings
+    if !(nodeTrusted && processWithdrawalsEnabled) {
+        return nil
+    }
+
+    // Log
+    t.log.Println("Checking for minipool withdrawals to process...")
+
+    // Get minipool withdrawal details
+    minipools, err := t.getNetworkMinipoolWithdrawalDetails()
+    if err != nil {
+        return err
+    }
+    if len(minipools) == 0 {
+        return nil
+    }
+
+    // Log
+    t.log.Printlnf("%d minipool(s) have withdrawals ready to process...", len(minipools))
+
+    // Get the network withdrawal contract balance
+    rocketNetworkWithdrawal, err := t.rp.GetContract("rocketNetworkWithdrawal")
+    if err != nil {
+        return err
+    }
+    withdrawalPoolBalance, err := t.ec.BalanceAt(context.Background(), *(rocketNetworkWithdrawal.Address), nil)
+    if err != nil {
+        return err
+    }
+
+    // Process minipool withdrawals
+    for _, details := range minipools {
+
+        // Check that the withdrawn validator balance has been received
+        if withdrawalPoolBalance.Cmp(details.TotalBalance) < 0 {
+            t.log.Printlnf("Minipool %s withdrawal of %.6f ETH has not been received yet...", details.Address.Hex(), math.RoundDown(eth.WeiToEth(details.TotalBalance), 6))
+            continue
+        }
+
+        // Process withdrawal
+        if err := t.processWithdrawal(rocketNetworkWithdrawal, details); err != nil {
+            t.log.Println(fmt.Errorf("Could not process minipool %s withdrawal: %w", details.Address.Hex(), err))
+            continue
+        }
+        withdrawalPoolBalance.Sub(withdrawalPoolBalance, details.TotalBalance)
+
+    }
 
     // Return
     return nil
 
 }
 
+
+// Get all minipool withdrawal details
+func (t *processWithdrawals) getNetworkMinipoolWithdrawalDetails() ([]minipoolWithdrawalDetails, error) {
+
+    // Data
+    var wg1 errgroup.Group
+    var addresses []common.Address
+    var beaconHead beacon.BeaconHead
+
+    // Get withdrawable minipool addresses
+    wg1.Go(func() error {
+        if err := t.ss.Update(); err != nil {
+            return fmt.Errorf("Could not update daemon state: %w", err)
+        }
+        addresses = t.ss.GetMinipoolAddressesByStatus(types.Withdrawable)
+        return nil
+    })
+
+    // Get beacon head
+    wg1.Go(func() error {
+        var err error
+        beaconHead, err = t.bc.GetBeaconHead()
+        return err
+    })
+
+    // Wait for data
+    if err := wg1.Wait(); err != nil {
+        return []minipoolWithdrawalDetails{}, err
+    }
+
+    // Load details in batches
+    minipools := make([]minipoolWithdrawalDetails, len(addresses))
+    for bsi := 0; bsi < len(addresses); bsi += MinipoolWithdrawalDetailsBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + MinipoolWithdrawalDetailsBatchSize
+        if mei > len(addresses) { mei = len(addresses) }
+
+        // Load details
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                mpDetails, err := t.getMinipoolWithdrawalDetails(addresses[mi])
+                if err == nil { minipools[mi] = mpDetails }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []minipoolWithdrawalDetails{}, err
+        }
+
+    }
+
+    // Filter by withdrawable status
+    withdrawableMinipools := []minipoolWithdrawalDetails{}
+    withdrawableAddresses := []common.Address{}
+    for _, details := range minipools {
+        if details.Withdrawable {
+            withdrawableMinipools = append(withdrawableMinipools, details)
+            withdrawableAddresses = append(withdrawableAddresses, details.Address)
+        }
+    }
+    if len(withdrawableMinipools) == 0 {
+        return []minipoolWithdrawalDetails{}, nil
+    }
+
+    // Get withdrawable minipool validator statuses
+    validators, err := rp.GetMinipoolValidators(t.rp, t.bc, withdrawableAddresses, nil, nil)
+    if err != nil {
+        return []minipoolWithdrawalDetails{}, err
+    }
+
+    // Filter by validator withdrawable status
+    withdrawnMinipools := []minipoolWithdrawalDetails{}
+    for _, details := range withdrawableMinipools {
+        validator := validators[details.Address]
+        if validator.Exists && validator.WithdrawableEpoch < beaconHead.FinalizedEpoch {
+            withdrawnMinipools = append(withdrawnMinipools, details)
+        }
+    }
+
+    // Return
+    return withdrawnMinipools, nil
+
+}
+
+
+// Get minipool withdrawal details
+func (t *processWithdrawals) getMinipoolWithdrawalDetails(minipoolAddress common.Address) (minipoolWithdrawalDetails, error) {
+
+    // Create minipool
+    mp, err := minipool.NewMinipool(t.rp, minipoolAddress)
+    if err != nil {
+        return minipoolWithdrawalDetails{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var status types.MinipoolStatus
+    var withdrawable bool
+    var withdrawalProcessed bool
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        status, err = mp.GetStatus(nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        withdrawable, err = minipool.GetMinipoolWithdrawable(t.rp, minipoolAddress, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        withdrawalProcessed, err = minipool.GetMinipoolWithdrawalProcessed(t.rp, minipoolAddress, nil)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return minipoolWithdrawalDetails{}, err
+    }
+
+    // Check minipool status
+    if status != types.Withdrawable || !withdrawable || withdrawalProcessed {
+        return minipoolWithdrawalDetails{}, nil
+    }
+
+    // Get validator pubkey & withdrawal balance
+    var wg2 errgroup.Group
+    var pubkey types.ValidatorPubkey
+    var totalBalance *big.Int
+    wg2.Go(func() error {
+        var err error
+        pubkey, err = minipool.GetMinipoolPubkey(t.rp, minipoolAddress, nil)
+        return err
+    })
+    wg2.Go(func() error {
+        var err error
+        totalBalance, err = minipool.GetMinipoolWithdrawalTotalBalance(t.rp, minipoolAddress, nil)
+        return err
+    })
+    if err := wg2.Wait(); err != nil {
+        return minipoolWithdrawalDetails{}, err
+    }
+
+    // Return
+    return minipoolWithdrawalDetails{
+        Address: minipoolAddress,
+        Pubkey: pubkey,
+        TotalBalance: totalBalance,
+        Withdrawable: true,
+    }, nil
+
+}
+
+
+// Process a minipool withdrawal
+func (t *processWithdrawals) processWithdrawal(rocketNetworkWithdrawal *rocketpool.Contract, details minipoolWithdrawalDetails) error {
+
+    // Log
+    t.log.Printlnf("Processing minipool %s withdrawal...", details.Address.Hex())
+
+    // Get transactor
+    opts, err := t.w.GetNodeAccountTransactor()
+    if err != nil {
+        return err
+    }
+
+    // Process withdrawal
+    if _, err := rocketNetworkWithdrawal.Transact(opts, "processWithdrawal", details.Pubkey.Bytes()); err != nil {
+        return fmt.Errorf("Could not process validator %s withdrawal: %w", details.Pubkey.Hex(), err)
+    }
+
+    // Log
+    t.log.Printlnf("Successfully processed minipool %s withdrawal.", details.Address.Hex())
+
+    // Return
+    return nil
+
+}
@@ -1,12 +1,15 @@
 package watchtower
 
 import (
+    "fmt"
+
     "github.com/rocket-pool/rocketpool-go/dao/trustednode"
     "github.com/rocket-pool/rocketpool-go/rocketpool"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services"
     "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/services/notifier"
     "github.com/rocket-pool/smartnode/shared/services/wallet"
     "github.com/rocket-pool/smartnode/shared/utils/log"
 )
@@ -19,6 +22,7 @@ type respondChallenges struct {
     cfg config.RocketPoolConfig
     w *wallet.Wallet
     rp *rocketpool.RocketPool
+    n *notifier.Notifier
 }
 
 
@@ -32,6 +36,8 @@ func newRespondChallenges(c *cli.Context, logger log.ColorLogger) (*respondChall
     if err != nil { return nil, err }
     rp, err := services.GetRocketPool(c)
     if err != nil { return nil, err }
+    n, err := services.GetNotifier(c)
+    if err != nil { return nil, err }
 
     // Return task
     return &respondChallenges{
@@ -40,6 +46,7 @@ func newRespondChallenges(c *cli.Context, logger log.ColorLogger) (*respondChall
         cfg: cfg,
         w: w,
         rp: rp,
+        n: n,
     }, nil
 
 }
@@ -76,13 +83,26 @@ func (t *respondChallenges) run() error {
     if err != nil {
         return err
     }
+    challengeKey := fmt.Sprintf("%s/%s", notifier.ChallengeRaised, nodeAccount.Address.Hex())
     if !isChallenged {
+        t.n.Resolve(challengeKey)
         return nil
     }
 
     // Log
     t.log.Printlnf("Node %s has an active challenge against it, responding...", nodeAccount.Address.Hex())
 
+    // Notify
+    if err := t.n.Notify(notifier.Event{
+        Type: notifier.ChallengeRaised,
+        Key: challengeKey,
+        Title: "Challenge raised against trusted node",
+        Message: fmt.Sprintf("Node %s has an active challenge against it. The watchtower is responding to the challenge.", nodeAccount.Address.Hex()),
+        Node: nodeAccount.Address.Hex(),
+    }); err != nil {
+        t.log.Println(err)
+    }
+
     // Get transactor
     opts, err := t.w.GetNodeAccountTransactor()
     if err != nil {
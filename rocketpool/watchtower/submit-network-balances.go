@@ -23,6 +23,8 @@ import (
 
     "github.com/rocket-pool/smartnode/shared/services"
     "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/services/metrics"
+    "github.com/rocket-pool/smartnode/shared/services/state"
     "github.com/rocket-pool/smartnode/shared/services/wallet"
     "github.com/rocket-pool/smartnode/shared/utils/eth2"
     "github.com/rocket-pool/smartnode/shared/utils/log"
@@ -43,6 +45,7 @@ type submitNetworkBalances struct {
     ec *ethclient.Client
     rp *rocketpool.RocketPool
     bc beacon.Client
+    ss *state.StateStore
 }
 
 
@@ -73,6 +76,8 @@ func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger) (*submitNe
     if err != nil { return nil, err }
     bc, err := services.GetBeaconClient(c)
     if err != nil { return nil, err }
+    ss, err := services.GetStateStore(c)
+    if err != nil { return nil, err }
 
     // Return task
     return &submitNetworkBalances{
@@ -82,6 +87,7 @@ func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger) (*submitNe
         ec: ec,
         rp: rp,
         bc: bc,
+        ss: ss,
     }, nil
 
 }
@@ -329,8 +335,15 @@ func (t *submitNetworkBalances) getNetworkMinipoolBalanceDetails(opts *bind.Call
     var beaconHead beacon.BeaconHead
     var blockTime uint64
 
-    // Get minipool addresses
+    // Get minipool addresses at block; fall back to the minipool manager if the state can't provide them
     wg1.Go(func() error {
+        if err := t.ss.Update(); err != nil {
+            return fmt.Errorf("Could not update daemon state: %w", err)
+        }
+        var ok bool
+        if addresses, ok = t.ss.GetMinipoolAddressesAtBlock(opts.BlockNumber.Uint64()); ok {
+            return nil
+        }
         var err error
         addresses, err = minipool.GetMinipoolAddresses(t.rp, opts)
         return err
@@ -550,6 +563,9 @@ func (t *submitNetworkBalances) submitBalances(balances networkBalances) error {
     // Log
     t.log.Printlnf("Successfully submitted network balances for block %d.", balances.Block)
 
+    // Update metrics
+    metrics.SetGauge("watchtower/balances_submitted_block", int64(balances.Block))
+
     // Return
     return nil
 
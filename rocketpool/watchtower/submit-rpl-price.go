@@ -0,0 +1,318 @@
+package watchtower
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/rocket-pool/rocketpool-go/dao/trustednode"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings/protocol"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+    "github.com/urfave/cli"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/services/contracts"
+    "github.com/rocket-pool/smartnode/shared/services/metrics"
+    "github.com/rocket-pool/smartnode/shared/services/wallet"
+    "github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+
+// Submit RPL price task
+type submitRplPrice struct {
+    c *cli.Context
+    log log.ColorLogger
+    cfg config.RocketPoolConfig
+    w *wallet.Wallet
+    ec *ethclient.Client
+    rp *rocketpool.RocketPool
+}
+
+
+// Create submit RPL price task
+func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger) (*submitRplPrice, error) {
+
+    // Get services
+    cfg, err := services.GetConfig(c)
+    if err != nil { return nil, err }
+    w, err := services.GetWallet(c)
+    if err != nil { return nil, err }
+    ec, err := services.GetEthClient(c)
+    if err != nil { return nil, err }
+    rp, err := services.GetRocketPool(c)
+    if err != nil { return nil, err }
+
+    // Return task
+    return &submitRplPrice{
+        c: c,
+        log: logger,
+        cfg: cfg,
+        w: w,
+        ec: ec,
+        rp: rp,
+    }, nil
+
+}
+
+
+// Check whether an RPL price source is configured
+func (t *submitRplPrice) isConfigured() bool {
+    return t.cfg.Rocketpool.RPLPricePairAddress != ""
+}
+
+
+// Submit RPL price
+func (t *submitRplPrice) run() error {
+
+    // Wait for eth client to sync
+    if err := services.WaitEthClientSynced(t.c, true); err != nil {
+        return err
+    }
+
+    // Get node account
+    nodeAccount, err := t.w.GetNodeAccount()
+    if err != nil {
+        return err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var nodeTrusted bool
+    var submitPricesEnabled bool
+
+    // Get data
+    wg.Go(func() error {
+        var err error
+        nodeTrusted, err = trustednode.GetMemberExists(t.rp, nodeAccount.Address, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        submitPricesEnabled, err = protocol.GetSubmitPricesEnabled(t.rp, nil)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return err
+    }
+
+    // Check node trusted status & settings
+    if !(nodeTrusted && submitPricesEnabled) {
+        return nil
+    }
+
+    // Log
+    t.log.Println("Checking for RPL price checkpoint...")
+
+    // Get block to submit price for
+    blockNumber, err := t.getLatestReportableBlock()
+    if err != nil {
+        return err
+    }
+
+    // Check if price for block can be submitted by node
+    canSubmit, err := t.canSubmitBlockPrice(nodeAccount.Address, blockNumber)
+    if err != nil {
+        return err
+    }
+    if !canSubmit {
+        return nil
+    }
+
+    // Log
+    t.log.Printlnf("Getting RPL price for block %d...", blockNumber)
+
+    // Get RPL price at block
+    rplPrice, err := t.getRplPrice(blockNumber)
+    if err != nil {
+        return err
+    }
+
+    // Log
+    t.log.Printlnf("RPL price: %.6f ETH", eth.WeiToEth(rplPrice))
+
+    // Submit RPL price
+    if err := t.submitRplPrice(blockNumber, rplPrice); err != nil {
+        return fmt.Errorf("Could not submit RPL price: %w", err)
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Get the latest block number to report RPL price for
+func (t *submitRplPrice) getLatestReportableBlock() (uint64, error) {
+
+    // Data
+    var wg errgroup.Group
+    var currentBlock uint64
+    var submitPricesFrequency uint64
+
+    // Get current block
+    wg.Go(func() error {
+        header, err := t.ec.HeaderByNumber(context.Background(), nil)
+        if err == nil {
+            currentBlock = header.Number.Uint64()
+        }
+        return err
+    })
+
+    // Get price submission frequency
+    wg.Go(func() error {
+        var err error
+        submitPricesFrequency, err = protocol.GetSubmitPricesFrequency(t.rp, nil)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return 0, err
+    }
+
+    // Calculate and return
+    return (currentBlock / submitPricesFrequency) * submitPricesFrequency, nil
+
+}
+
+
+// Check whether the RPL price for a block can be submitted by the node
+func (t *submitRplPrice) canSubmitBlockPrice(nodeAddress common.Address, blockNumber uint64) (bool, error) {
+
+    // Data
+    var wg errgroup.Group
+    var currentPricesBlock uint64
+    var nodeSubmittedBlock bool
+
+    // Get data
+    wg.Go(func() error {
+        var err error
+        currentPricesBlock, err = network.GetPricesBlock(t.rp, nil)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        blockNumberBuf := make([]byte, 32)
+        big.NewInt(int64(blockNumber)).FillBytes(blockNumberBuf)
+        nodeSubmittedBlock, err = t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("network.prices.submitted.node"), nodeAddress.Bytes(), blockNumberBuf))
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return false, err
+    }
+
+    // Return
+    return (blockNumber > currentPricesBlock && !nodeSubmittedBlock), nil
+
+}
+
+
+// Get the RPL price in ETH at a specific block from the configured RPL / ETH pair
+func (t *submitRplPrice) getRplPrice(blockNumber uint64) (*big.Int, error) {
+
+    // Check price source
+    if !t.isConfigured() {
+        return nil, errors.New("RPL price pair address not set")
+    }
+
+    // Initialize call options
+    opts := &bind.CallOpts{
+        BlockNumber: big.NewInt(int64(blockNumber)),
+    }
+
+    // Create pair contract
+    pair, err := contracts.NewUniswapPair(common.HexToAddress(t.cfg.Rocketpool.RPLPricePairAddress), t.ec)
+    if err != nil {
+        return nil, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var rplAddress *common.Address
+    var token0 common.Address
+    var token1 common.Address
+    var reserves contracts.UniswapPairReserves
+
+    // Get data
+    wg.Go(func() error {
+        var err error
+        rplAddress, err = t.rp.GetAddress("rocketTokenRPL")
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        token0, token1, err = pair.GetTokens(opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        reserves, err = pair.GetReserves(opts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return nil, err
+    }
+
+    // Get RPL & ETH reserves
+    var rplReserve, ethReserve *big.Int
+    switch *rplAddress {
+        case token0:
+            rplReserve, ethReserve = reserves.Reserve0, reserves.Reserve1
+        case token1:
+            rplReserve, ethReserve = reserves.Reserve1, reserves.Reserve0
+        default:
+            return nil, fmt.Errorf("RPL price pair %s does not contain the RPL token %s", pair.Address.Hex(), rplAddress.Hex())
+    }
+    if rplReserve.Cmp(big.NewInt(0)) == 0 {
+        return nil, fmt.Errorf("RPL price pair %s has no RPL liquidity at block %d", pair.Address.Hex(), blockNumber)
+    }
+
+    // Calculate & return price in wei per RPL
+    rplPrice := new(big.Int).Mul(ethReserve, eth.EthToWei(1))
+    return rplPrice.Div(rplPrice, rplReserve), nil
+
+}
+
+
+// Submit RPL price
+func (t *submitRplPrice) submitRplPrice(blockNumber uint64, rplPrice *big.Int) error {
+
+    // Log
+    t.log.Printlnf("Submitting RPL price for block %d...", blockNumber)
+
+    // Get transactor
+    opts, err := t.w.GetNodeAccountTransactor()
+    if err != nil {
+        return err
+    }
+
+    // Submit RPL price
+    if _, err := network.SubmitPrices(t.rp, blockNumber, rplPrice, opts); err != nil {
+        return err
+    }
+
+    // Log
+    t.log.Printlnf("Successfully submitted RPL price for block %d.", blockNumber)
+
+    // Update metrics
+    metrics.SetGauge("watchtower/prices_submitted_block", int64(blockNumber))
+
+    // Return
+    return nil
+
+}
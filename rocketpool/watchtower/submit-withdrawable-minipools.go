@@ -17,6 +17,7 @@ import (
 
     "github.com/rocket-pool/smartnode/shared/services"
     "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/services/state"
     "github.com/rocket-pool/smartnode/shared/services/wallet"
     "github.com/rocket-pool/smartnode/shared/utils/eth2"
     "github.com/rocket-pool/smartnode/shared/utils/log"
@@ -35,6 +36,7 @@ type submitWithdrawableMinipools struct {
     w *wallet.Wallet
     rp *rocketpool.RocketPool
     bc beacon.Client
+    ss *state.StateStore
 }
 
 
@@ -57,6 +59,8 @@ func newSubmitWithdrawableMinipools(c *cli.Context, logger log.ColorLogger) (*su
     if err != nil { return nil, err }
     bc, err := services.GetBeaconClient(c)
     if err != nil { return nil, err }
+    ss, err := services.GetStateStore(c)
+    if err != nil { return nil, err }
 
     // Return task
     return &submitWithdrawableMinipools{
@@ -65,6 +69,7 @@ func newSubmitWithdrawableMinipools(c *cli.Context, logger log.ColorLogger) (*su
         w: w,
         rp: rp,
         bc: bc,
+        ss: ss,
     }, nil
 
 }
@@ -151,11 +156,13 @@ func (t *submitWithdrawableMinipools) getNetworkMinipoolWithdrawableDetails(node
     var eth2Config beacon.Eth2Config
     var beaconHead beacon.BeaconHead
 
-    // Get minipool addresses
+    // Get staking minipool addresses
     wg1.Go(func() error {
-        var err error
-        addresses, err = minipool.GetMinipoolAddresses(t.rp, nil)
-        return err
+        if err := t.ss.Update(); err != nil {
+            return fmt.Errorf("Could not update daemon state: %w", err)
+        }
+        addresses = t.ss.GetMinipoolAddressesByStatus(types.Staking)
+        return nil
     })
 
     // Get eth2 config
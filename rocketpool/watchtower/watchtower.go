@@ -8,22 +8,30 @@ import (
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services"
+    "github.com/rocket-pool/smartnode/shared/services/metrics"
     "github.com/rocket-pool/smartnode/shared/utils/log"
+    "github.com/rocket-pool/smartnode/shared/utils/scheduler"
 )
 
 
 // Config
 var tasksInterval, _ = time.ParseDuration("5m")
-var taskCooldown, _ = time.ParseDuration("10s")
+var taskTimeout, _ = time.ParseDuration("30m")
+var respondChallengesInterval, _ = time.ParseDuration("1m")
+var respondChallengesTimeout, _ = time.ParseDuration("5m")
+var newBlockPollInterval, _ = time.ParseDuration("5s")
+var collectMetricsInterval, _ = time.ParseDuration("1m")
 const (
     MaxConcurrentEth1Requests = 200
 
     RespondChallengesColor = color.FgWhite
     ClaimRplRewardsColor = color.FgGreen
     SubmitNetworkBalancesColor = color.FgYellow
+    SubmitRplPriceColor = color.FgHiYellow
     SubmitWithdrawableMinipoolsColor = color.FgBlue
     DissolveTimedOutMinipoolsColor = color.FgMagenta
     ProcessWithdrawalsColor = color.FgCyan
+    CollectMetricsColor = color.FgHiBlue
     ErrorColor = color.FgRed
 )
 
@@ -34,6 +42,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
         Name:      name,
         Aliases:   aliases,
         Usage:     "Run Rocket Pool watchtower activity daemon",
+        Flags: []cli.Flag{
+            cli.StringFlag{
+                Name:  "metricsAddress, m",
+                Usage: "Serve Prometheus metrics on the specified `address` (e.g. 0.0.0.0:9102)",
+            },
+        },
         Action: func(c *cli.Context) error {
             return run(c)
         },
@@ -47,6 +61,12 @@ func run(c *cli.Context) error {
     // Configure
     configureHTTP()
 
+    // Start metrics server
+    metricsAddress := c.String("metricsAddress")
+    if metricsAddress != "" {
+        if err := metrics.Start(metricsAddress); err != nil { return err }
+    }
+
     // Wait until node is registered
     if err := services.WaitNodeRegistered(c, true); err != nil { return err }
 
@@ -57,6 +77,8 @@ func run(c *cli.Context) error {
     if err != nil { return err }
     submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor))
     if err != nil { return err }
+    submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor))
+    if err != nil { return err }
     submitWithdrawableMinipools, err := newSubmitWithdrawableMinipools(c, log.NewColorLogger(SubmitWithdrawableMinipoolsColor))
     if err != nil { return err }
     dissolveTimedOutMinipools, err := newDissolveTimedOutMinipools(c, log.NewColorLogger(DissolveTimedOutMinipoolsColor))
@@ -67,34 +89,80 @@ func run(c *cli.Context) error {
     // Initialize error logger
     errorLog := log.NewColorLogger(ErrorColor)
 
-    // Run task loop
-    for {
-        if err := respondChallenges.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(taskCooldown)
-        if err := claimRplRewards.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(taskCooldown)
-        if err := submitNetworkBalances.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(taskCooldown)
-        if err := submitWithdrawableMinipools.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(taskCooldown)
-        if err := dissolveTimedOutMinipools.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(taskCooldown)
-        if err := processWithdrawals.run(); err != nil {
-            errorLog.Println(err)
-        }
-        time.Sleep(tasksInterval)
+    // Get eth client for new block triggers
+    ec, err := services.GetEthClient(c)
+    if err != nil { return err }
+
+    // Initialize scheduler
+    s := scheduler.NewScheduler(errorLog)
+
+    // Record task metrics
+    if metricsAddress != "" {
+        s.AddTaskListener(metrics.RecordTaskRun)
+    }
+
+    // Notify repeated task failures
+    n, err := services.GetNotifier(c)
+    if err != nil { return err }
+    if n.Enabled() {
+        s.AddTaskListener(n.RecordTaskRun)
+    }
+
+    // Register tasks
+    // Challenge responses are time-critical, so they run on a short interval and are triggered by each new block
+    if err := s.Register("respondChallenges", respondChallenges.run, scheduler.TaskSettings{
+        Interval: respondChallengesInterval,
+        Timeout: respondChallengesTimeout,
+        Concurrency: scheduler.QueueIfRunning,
+    }); err != nil { return err }
+    if err := s.Register("claimRplRewards", claimRplRewards.run, scheduler.TaskSettings{
+        Interval: tasksInterval,
+        Timeout: taskTimeout,
+    }); err != nil { return err }
+    if err := s.Register("submitNetworkBalances", submitNetworkBalances.run, scheduler.TaskSettings{
+        Interval: tasksInterval,
+        Timeout: taskTimeout,
+    }); err != nil { return err }
+    if err := s.Register("submitWithdrawableMinipools", submitWithdrawableMinipools.run, scheduler.TaskSettings{
+        Interval: tasksInterval,
+        Timeout: taskTimeout,
+    }); err != nil { return err }
+    if err := s.Register("dissolveTimedOutMinipools", dissolveTimedOutMinipools.run, scheduler.TaskSettings{
+        Interval: tasksInterval,
+        Timeout: taskTimeout,
+    }); err != nil { return err }
+    if err := s.Register("processWithdrawals", processWithdrawals.run, scheduler.TaskSettings{
+        Interval: tasksInterval,
+        Timeout: taskTimeout,
+    }); err != nil { return err }
+
+    // RPL price submission is only registered when a price source is configured
+    if submitRplPrice.isConfigured() {
+        if err := s.Register("submitRplPrice", submitRplPrice.run, scheduler.TaskSettings{
+            Interval: tasksInterval,
+            Timeout: taskTimeout,
+        }); err != nil { return err }
+    } else {
+        submitRplPrice.log.Println("RPL price pair address not set, RPL price submission is disabled.")
     }
 
+    // Register metrics collection task if metrics are enabled
+    if metricsAddress != "" {
+        collectMetrics, err := newCollectMetrics(c, log.NewColorLogger(CollectMetricsColor))
+        if err != nil { return err }
+        if err := s.Register("collectMetrics", collectMetrics.run, scheduler.TaskSettings{
+            Interval: collectMetricsInterval,
+            Timeout: taskTimeout,
+        }); err != nil { return err }
+    }
+
+    // Register chain event triggers
+    if err := s.TriggerOnNewBlocks(ec, newBlockPollInterval, "respondChallenges"); err != nil { return err }
+
+    // Run tasks
+    s.Run()
+    return nil
+
 }
 
 
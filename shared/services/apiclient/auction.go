@@ -0,0 +1,89 @@
+package apiclient
+
+import (
+    "context"
+    "math/big"
+    "strconv"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get RPL auction status
+func (c *Client) AuctionStatus(ctx context.Context) (api.AuctionStatusResponse, error) {
+    var response api.AuctionStatusResponse
+    err := c.call(ctx, &response, "auction", "status")
+    return response, err
+}
+
+
+// Get RPL lots for auction
+func (c *Client) AuctionLots(ctx context.Context) (api.AuctionLotsResponse, error) {
+    var response api.AuctionLotsResponse
+    err := c.call(ctx, &response, "auction", "lots")
+    return response, err
+}
+
+
+// Check whether the node can create a new lot
+func (c *Client) CanCreateLot(ctx context.Context) (api.CanCreateLotResponse, error) {
+    var response api.CanCreateLotResponse
+    err := c.call(ctx, &response, "auction", "can-create-lot")
+    return response, err
+}
+
+
+// Create a new lot
+func (c *Client) CreateLot(ctx context.Context) (api.CreateLotResponse, error) {
+    var response api.CreateLotResponse
+    err := c.call(ctx, &response, "auction", "create-lot")
+    return response, err
+}
+
+
+// Check whether the node can bid on a lot
+func (c *Client) CanBidOnLot(ctx context.Context, lotIndex uint64) (api.CanBidOnLotResponse, error) {
+    var response api.CanBidOnLotResponse
+    err := c.call(ctx, &response, "auction", "can-bid-lot", strconv.FormatUint(lotIndex, 10))
+    return response, err
+}
+
+
+// Bid on a lot
+func (c *Client) BidOnLot(ctx context.Context, lotIndex uint64, amountWei *big.Int) (api.BidOnLotResponse, error) {
+    var response api.BidOnLotResponse
+    err := c.call(ctx, &response, "auction", "bid-lot", strconv.FormatUint(lotIndex, 10), amountWei.String())
+    return response, err
+}
+
+
+// Check whether the node can claim RPL from a lot
+func (c *Client) CanClaimFromLot(ctx context.Context, lotIndex uint64) (api.CanClaimFromLotResponse, error) {
+    var response api.CanClaimFromLotResponse
+    err := c.call(ctx, &response, "auction", "can-claim-lot", strconv.FormatUint(lotIndex, 10))
+    return response, err
+}
+
+
+// Claim RPL from a lot
+func (c *Client) ClaimFromLot(ctx context.Context, lotIndex uint64) (api.ClaimFromLotResponse, error) {
+    var response api.ClaimFromLotResponse
+    err := c.call(ctx, &response, "auction", "claim-lot", strconv.FormatUint(lotIndex, 10))
+    return response, err
+}
+
+
+// Check whether the node can recover unclaimed RPL from a lot
+func (c *Client) CanRecoverUnclaimedRPLFromLot(ctx context.Context, lotIndex uint64) (api.CanRecoverRPLFromLotResponse, error) {
+    var response api.CanRecoverRPLFromLotResponse
+    err := c.call(ctx, &response, "auction", "can-recover-lot", strconv.FormatUint(lotIndex, 10))
+    return response, err
+}
+
+
+// Recover unclaimed RPL from a lot (returning it to the auction contract)
+func (c *Client) RecoverUnclaimedRPLFromLot(ctx context.Context, lotIndex uint64) (api.RecoverRPLFromLotResponse, error) {
+    var response api.RecoverRPLFromLotResponse
+    err := c.call(ctx, &response, "auction", "recover-lot", strconv.FormatUint(lotIndex, 10))
+    return response, err
+}
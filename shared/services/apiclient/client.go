@@ -0,0 +1,122 @@
+package apiclient
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "strings"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// API transport
+// Sends an API request and returns the encoded API response
+type Transport interface {
+    Call(ctx context.Context, request api.APIRequest) ([]byte, error)
+}
+
+
+// Transport error
+// Returned when a request could not be sent or its response could not be read
+type TransportError struct {
+    Command string
+    Err error
+}
+func (e *TransportError) Error() string {
+    return fmt.Sprintf("Could not run API command '%s': %s", e.Command, e.Err.Error())
+}
+func (e *TransportError) Unwrap() error {
+    return e.Err
+}
+
+
+// API error
+// Returned when the API responds with an error status
+type APIError struct {
+    Command string
+    Message string
+}
+func (e *APIError) Error() string {
+    return fmt.Sprintf("API command '%s' failed: %s", e.Command, e.Message)
+}
+
+
+// Dry run error
+// Returned in place of a transaction response when a transaction is simulated in dry run mode
+type DryRunError struct {
+    Command string
+    Result api.DryRunResult
+}
+func (e *DryRunError) Error() string {
+    return e.Result.String()
+}
+
+
+// Smartnode API client
+// Provides a typed method for each API command
+type Client struct {
+    transport Transport
+    gasPrice string
+    gasLimit string
+    dryRun bool
+}
+
+
+// Create new API client
+func NewClient(transport Transport) *Client {
+    return &Client{
+        transport: transport,
+    }
+}
+
+
+// Set the gas price (in gwei) & gas limit for transactions, overriding the smartnode config
+// Empty values use the config
+func (c *Client) SetGasOptions(gasPrice, gasLimit string) {
+    c.gasPrice = gasPrice
+    c.gasLimit = gasLimit
+}
+
+
+// Set whether transactions are simulated instead of sent
+func (c *Client) SetDryRun(dryRun bool) {
+    c.dryRun = dryRun
+}
+
+
+// Run an API command and decode its response
+// response must be a pointer to an API response type
+func (c *Client) call(ctx context.Context, response interface{}, args ...string) error {
+    command := strings.Join(args, " ")
+
+    // Send request
+    responseBytes, err := c.transport.Call(ctx, api.APIRequest{
+        Args: args,
+        GasPrice: c.gasPrice,
+        GasLimit: c.gasLimit,
+        DryRun: c.dryRun,
+    })
+    if err != nil {
+        return &TransportError{Command: command, Err: err}
+    }
+
+    // Check response status
+    var status api.DryRunResponse
+    if err := json.Unmarshal(responseBytes, &status); err != nil {
+        return &TransportError{Command: command, Err: fmt.Errorf("Could not decode API response: %w", err)}
+    }
+    if status.DryRun != nil {
+        return &DryRunError{Command: command, Result: *status.DryRun}
+    }
+    if status.Error != "" {
+        return &APIError{Command: command, Message: status.Error}
+    }
+
+    // Decode response
+    if err := json.Unmarshal(responseBytes, response); err != nil {
+        return &TransportError{Command: command, Err: fmt.Errorf("Could not decode API response: %w", err)}
+    }
+    return nil
+
+}
@@ -0,0 +1,31 @@
+package apiclient
+
+import (
+    "context"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get faucet status
+func (c *Client) FaucetStatus(ctx context.Context) (api.FaucetStatusResponse, error) {
+    var response api.FaucetStatusResponse
+    err := c.call(ctx, &response, "faucet", "status")
+    return response, err
+}
+
+
+// Check whether the node can withdraw RPL from the faucet
+func (c *Client) CanFaucetWithdrawRpl(ctx context.Context) (api.CanFaucetWithdrawRplResponse, error) {
+    var response api.CanFaucetWithdrawRplResponse
+    err := c.call(ctx, &response, "faucet", "can-withdraw-rpl")
+    return response, err
+}
+
+
+// Withdraw RPL from the faucet
+func (c *Client) FaucetWithdrawRpl(ctx context.Context) (api.FaucetWithdrawRplResponse, error) {
+    var response api.FaucetWithdrawRplResponse
+    err := c.call(ctx, &response, "faucet", "withdraw-rpl")
+    return response, err
+}
@@ -0,0 +1,119 @@
+package apiclient
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "net"
+    "net/http"
+    "strings"
+    "time"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Config
+const (
+    ServerPath = "/api"
+    UnixSocketPrefix = "unix://"
+)
+var DefaultRequestTimeout, _ = time.ParseDuration("5m")
+
+
+// Dial function for API server connections
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+
+// HTTP API transport
+// Sends requests to a smartnode API server at a URL (http://host:port) or Unix socket (unix:///path)
+type HTTPTransport struct {
+    url string
+    token string
+    client *http.Client
+}
+
+
+// Create new HTTP API transport
+// A nil dial function uses direct network connections; a zero timeout uses the default request timeout
+func NewHTTPTransport(serverUrl, token string, dial DialFunc, timeout time.Duration) *HTTPTransport {
+
+    // Get request timeout
+    if timeout <= 0 {
+        timeout = DefaultRequestTimeout
+    }
+
+    // Get request URL & connection address
+    requestUrl := strings.TrimSuffix(serverUrl, "/") + ServerPath
+    var network, address string
+    if strings.HasPrefix(serverUrl, UnixSocketPrefix) {
+        requestUrl = "http://unix" + ServerPath
+        network, address = "unix", strings.TrimPrefix(serverUrl, UnixSocketPrefix)
+    }
+
+    // Get dial function
+    if dial == nil {
+        var dialer net.Dialer
+        dial = dialer.DialContext
+    }
+    transportDial := func(ctx context.Context, dialNetwork, dialAddress string) (net.Conn, error) {
+        if address != "" {
+            dialNetwork, dialAddress = network, address
+        }
+        return dial(ctx, dialNetwork, dialAddress)
+    }
+
+    // Create & return transport
+    return &HTTPTransport{
+        url: requestUrl,
+        token: token,
+        client: &http.Client{
+            Transport: &http.Transport{DialContext: transportDial},
+            Timeout: timeout,
+        },
+    }
+
+}
+
+
+// Create new API client using an HTTP transport
+func NewHTTPClient(serverUrl, token string, timeout time.Duration) *Client {
+    return NewClient(NewHTTPTransport(serverUrl, token, nil, timeout))
+}
+
+
+// Send an API request to the server
+func (t *HTTPTransport) Call(ctx context.Context, request api.APIRequest) ([]byte, error) {
+
+    // Encode request
+    requestBytes, err := json.Marshal(request)
+    if err != nil {
+        return []byte{}, fmt.Errorf("Could not encode API request: %w", err)
+    }
+    httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(requestBytes))
+    if err != nil {
+        return []byte{}, fmt.Errorf("Could not create API request: %w", err)
+    }
+    httpRequest.Header.Set("Content-Type", "application/json")
+    httpRequest.Header.Set("Authorization", "Bearer " + t.token)
+
+    // Send request
+    response, err := t.client.Do(httpRequest)
+    if err != nil {
+        return []byte{}, err
+    }
+    defer response.Body.Close()
+
+    // Read & return response
+    responseBytes, err := ioutil.ReadAll(response.Body)
+    if err != nil {
+        return []byte{}, fmt.Errorf("Could not read API server response: %w", err)
+    }
+    if response.StatusCode != http.StatusOK {
+        return []byte{}, fmt.Errorf("The API server responded with status code %d: %s", response.StatusCode, strings.TrimSpace(string(responseBytes)))
+    }
+    return responseBytes, nil
+
+}
@@ -0,0 +1,106 @@
+package apiclient
+
+import (
+    "context"
+    "strconv"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get minipool status
+func (c *Client) MinipoolStatus(ctx context.Context) (api.MinipoolStatusResponse, error) {
+    var response api.MinipoolStatusResponse
+    err := c.call(ctx, &response, "minipool", "status")
+    return response, err
+}
+
+
+// Get minipool validator performance over an epoch range
+func (c *Client) MinipoolPerformance(ctx context.Context, startEpoch, endEpoch uint64) (api.MinipoolPerformanceResponse, error) {
+    var response api.MinipoolPerformanceResponse
+    err := c.call(ctx, &response, "minipool", "performance", strconv.FormatUint(startEpoch, 10), strconv.FormatUint(endEpoch, 10))
+    return response, err
+}
+
+
+// Check whether a minipool is eligible for a refund
+func (c *Client) CanRefundMinipool(ctx context.Context, address common.Address) (api.CanRefundMinipoolResponse, error) {
+    var response api.CanRefundMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "can-refund", address.Hex())
+    return response, err
+}
+
+
+// Refund ETH from a minipool
+func (c *Client) RefundMinipool(ctx context.Context, address common.Address) (api.RefundMinipoolResponse, error) {
+    var response api.RefundMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "refund", address.Hex())
+    return response, err
+}
+
+
+// Check whether a minipool can be dissolved
+func (c *Client) CanDissolveMinipool(ctx context.Context, address common.Address) (api.CanDissolveMinipoolResponse, error) {
+    var response api.CanDissolveMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "can-dissolve", address.Hex())
+    return response, err
+}
+
+
+// Dissolve a minipool
+func (c *Client) DissolveMinipool(ctx context.Context, address common.Address) (api.DissolveMinipoolResponse, error) {
+    var response api.DissolveMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "dissolve", address.Hex())
+    return response, err
+}
+
+
+// Check whether a minipool can be exited
+func (c *Client) CanExitMinipool(ctx context.Context, address common.Address) (api.CanExitMinipoolResponse, error) {
+    var response api.CanExitMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "can-exit", address.Hex())
+    return response, err
+}
+
+
+// Exit a minipool
+func (c *Client) ExitMinipool(ctx context.Context, address common.Address) (api.ExitMinipoolResponse, error) {
+    var response api.ExitMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "exit", address.Hex())
+    return response, err
+}
+
+
+// Check whether a minipool can be withdrawn
+func (c *Client) CanWithdrawMinipool(ctx context.Context, address common.Address) (api.CanWithdrawMinipoolResponse, error) {
+    var response api.CanWithdrawMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "can-withdraw", address.Hex())
+    return response, err
+}
+
+
+// Withdraw a minipool
+func (c *Client) WithdrawMinipool(ctx context.Context, address common.Address) (api.WithdrawMinipoolResponse, error) {
+    var response api.WithdrawMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "withdraw", address.Hex())
+    return response, err
+}
+
+
+// Check whether a minipool can be closed
+func (c *Client) CanCloseMinipool(ctx context.Context, address common.Address) (api.CanCloseMinipoolResponse, error) {
+    var response api.CanCloseMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "can-close", address.Hex())
+    return response, err
+}
+
+
+// Close a minipool
+func (c *Client) CloseMinipool(ctx context.Context, address common.Address) (api.CloseMinipoolResponse, error) {
+    var response api.CloseMinipoolResponse
+    err := c.call(ctx, &response, "minipool", "close", address.Hex())
+    return response, err
+}
@@ -0,0 +1,23 @@
+package apiclient
+
+import (
+    "context"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get network node fee
+func (c *Client) NodeFee(ctx context.Context) (api.NodeFeeResponse, error) {
+    var response api.NodeFeeResponse
+    err := c.call(ctx, &response, "network", "node-fee")
+    return response, err
+}
+
+
+// Get network RPL price
+func (c *Client) RplPrice(ctx context.Context) (api.RplPriceResponse, error) {
+    var response api.RplPriceResponse
+    err := c.call(ctx, &response, "network", "rpl-price")
+    return response, err
+}
@@ -0,0 +1,163 @@
+package apiclient
+
+import (
+    "context"
+    "math/big"
+    "strconv"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get node status
+func (c *Client) NodeStatus(ctx context.Context) (api.NodeStatusResponse, error) {
+    var response api.NodeStatusResponse
+    err := c.call(ctx, &response, "node", "status")
+    return response, err
+}
+
+
+// Get node pending transactions
+func (c *Client) NodePendingTxs(ctx context.Context) (api.NodePendingTxsResponse, error) {
+    var response api.NodePendingTxsResponse
+    err := c.call(ctx, &response, "node", "pending-txs")
+    return response, err
+}
+
+
+// Get a report of the node's rewards over a time range
+func (c *Client) NodeRewardsReport(ctx context.Context, startTime, endTime int64) (api.NodeRewardsReportResponse, error) {
+    var response api.NodeRewardsReportResponse
+    err := c.call(ctx, &response, "node", "rewards-report", strconv.FormatInt(startTime, 10), strconv.FormatInt(endTime, 10))
+    return response, err
+}
+
+
+// Check whether the node can be registered
+func (c *Client) CanRegisterNode(ctx context.Context) (api.CanRegisterNodeResponse, error) {
+    var response api.CanRegisterNodeResponse
+    err := c.call(ctx, &response, "node", "can-register")
+    return response, err
+}
+
+
+// Register the node
+func (c *Client) RegisterNode(ctx context.Context, timezoneLocation string) (api.RegisterNodeResponse, error) {
+    var response api.RegisterNodeResponse
+    err := c.call(ctx, &response, "node", "register", timezoneLocation)
+    return response, err
+}
+
+
+// Set the node's withdrawal address
+func (c *Client) SetNodeWithdrawalAddress(ctx context.Context, withdrawalAddress common.Address) (api.SetNodeWithdrawalAddressResponse, error) {
+    var response api.SetNodeWithdrawalAddressResponse
+    err := c.call(ctx, &response, "node", "set-withdrawal-address", withdrawalAddress.Hex())
+    return response, err
+}
+
+
+// Set the node's timezone location
+func (c *Client) SetNodeTimezone(ctx context.Context, timezoneLocation string) (api.SetNodeTimezoneResponse, error) {
+    var response api.SetNodeTimezoneResponse
+    err := c.call(ctx, &response, "node", "set-timezone", timezoneLocation)
+    return response, err
+}
+
+
+// Check whether the node can swap RPL tokens
+func (c *Client) CanNodeSwapRpl(ctx context.Context, amountWei *big.Int) (api.CanNodeSwapRplResponse, error) {
+    var response api.CanNodeSwapRplResponse
+    err := c.call(ctx, &response, "node", "can-swap-rpl", amountWei.String())
+    return response, err
+}
+
+
+// Swap node's old RPL tokens for new RPL tokens
+func (c *Client) NodeSwapRpl(ctx context.Context, amountWei *big.Int) (api.NodeSwapRplResponse, error) {
+    var response api.NodeSwapRplResponse
+    err := c.call(ctx, &response, "node", "swap-rpl", amountWei.String())
+    return response, err
+}
+
+
+// Check whether the node can stake RPL
+func (c *Client) CanNodeStakeRpl(ctx context.Context, amountWei *big.Int) (api.CanNodeStakeRplResponse, error) {
+    var response api.CanNodeStakeRplResponse
+    err := c.call(ctx, &response, "node", "can-stake-rpl", amountWei.String())
+    return response, err
+}
+
+
+// Stake RPL against the node
+func (c *Client) NodeStakeRpl(ctx context.Context, amountWei *big.Int) (api.NodeStakeRplResponse, error) {
+    var response api.NodeStakeRplResponse
+    err := c.call(ctx, &response, "node", "stake-rpl", amountWei.String())
+    return response, err
+}
+
+
+// Check whether the node can withdraw RPL
+func (c *Client) CanNodeWithdrawRpl(ctx context.Context, amountWei *big.Int) (api.CanNodeWithdrawRplResponse, error) {
+    var response api.CanNodeWithdrawRplResponse
+    err := c.call(ctx, &response, "node", "can-withdraw-rpl", amountWei.String())
+    return response, err
+}
+
+
+// Withdraw RPL staked against the node
+func (c *Client) NodeWithdrawRpl(ctx context.Context, amountWei *big.Int) (api.NodeWithdrawRplResponse, error) {
+    var response api.NodeWithdrawRplResponse
+    err := c.call(ctx, &response, "node", "withdraw-rpl", amountWei.String())
+    return response, err
+}
+
+
+// Check whether the node can make a deposit
+func (c *Client) CanNodeDeposit(ctx context.Context, amountWei *big.Int) (api.CanNodeDepositResponse, error) {
+    var response api.CanNodeDepositResponse
+    err := c.call(ctx, &response, "node", "can-deposit", amountWei.String())
+    return response, err
+}
+
+
+// Make a node deposit
+func (c *Client) NodeDeposit(ctx context.Context, amountWei *big.Int, minFee float64) (api.NodeDepositResponse, error) {
+    var response api.NodeDepositResponse
+    err := c.call(ctx, &response, "node", "deposit", amountWei.String(), strconv.FormatFloat(minFee, 'f', -1, 64))
+    return response, err
+}
+
+
+// Check whether the node can send tokens
+func (c *Client) CanNodeSend(ctx context.Context, amountWei *big.Int, token string) (api.CanNodeSendResponse, error) {
+    var response api.CanNodeSendResponse
+    err := c.call(ctx, &response, "node", "can-send", amountWei.String(), token)
+    return response, err
+}
+
+
+// Send tokens from the node to an address
+func (c *Client) NodeSend(ctx context.Context, amountWei *big.Int, token string, toAddress common.Address) (api.NodeSendResponse, error) {
+    var response api.NodeSendResponse
+    err := c.call(ctx, &response, "node", "send", amountWei.String(), token, toAddress.Hex())
+    return response, err
+}
+
+
+// Check whether the node can burn tokens
+func (c *Client) CanNodeBurn(ctx context.Context, amountWei *big.Int, token string) (api.CanNodeBurnResponse, error) {
+    var response api.CanNodeBurnResponse
+    err := c.call(ctx, &response, "node", "can-burn", amountWei.String(), token)
+    return response, err
+}
+
+
+// Burn tokens owned by the node for ETH
+func (c *Client) NodeBurn(ctx context.Context, amountWei *big.Int, token string) (api.NodeBurnResponse, error) {
+    var response api.NodeBurnResponse
+    err := c.call(ctx, &response, "node", "burn", amountWei.String(), token)
+    return response, err
+}
@@ -0,0 +1,283 @@
+package apiclient
+
+import (
+    "context"
+    "math/big"
+    "strconv"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get oracle DAO status
+func (c *Client) TNDAOStatus(ctx context.Context) (api.TNDAOStatusResponse, error) {
+    var response api.TNDAOStatusResponse
+    err := c.call(ctx, &response, "odao", "status")
+    return response, err
+}
+
+
+// Get oracle DAO members
+func (c *Client) TNDAOMembers(ctx context.Context) (api.TNDAOMembersResponse, error) {
+    var response api.TNDAOMembersResponse
+    err := c.call(ctx, &response, "odao", "members")
+    return response, err
+}
+
+
+// Get oracle DAO proposals
+func (c *Client) TNDAOProposals(ctx context.Context) (api.TNDAOProposalsResponse, error) {
+    var response api.TNDAOProposalsResponse
+    err := c.call(ctx, &response, "odao", "proposals")
+    return response, err
+}
+
+
+// Check whether the node can propose inviting a new member
+func (c *Client) CanProposeInviteToTNDAO(ctx context.Context, memberAddress common.Address) (api.CanProposeTNDAOInviteResponse, error) {
+    var response api.CanProposeTNDAOInviteResponse
+    err := c.call(ctx, &response, "odao", "can-propose-invite", memberAddress.Hex())
+    return response, err
+}
+
+
+// Propose inviting a new member
+func (c *Client) ProposeInviteToTNDAO(ctx context.Context, memberAddress common.Address, memberId, memberEmail string) (api.ProposeTNDAOInviteResponse, error) {
+    var response api.ProposeTNDAOInviteResponse
+    err := c.call(ctx, &response, "odao", "propose-invite", memberAddress.Hex(), memberId, memberEmail)
+    return response, err
+}
+
+
+// Check whether the node can propose leaving the oracle DAO
+func (c *Client) CanProposeLeaveTNDAO(ctx context.Context) (api.CanProposeTNDAOLeaveResponse, error) {
+    var response api.CanProposeTNDAOLeaveResponse
+    err := c.call(ctx, &response, "odao", "can-propose-leave")
+    return response, err
+}
+
+
+// Propose leaving the oracle DAO
+func (c *Client) ProposeLeaveTNDAO(ctx context.Context) (api.ProposeTNDAOLeaveResponse, error) {
+    var response api.ProposeTNDAOLeaveResponse
+    err := c.call(ctx, &response, "odao", "propose-leave")
+    return response, err
+}
+
+
+// Check whether the node can propose replacing its position with a new member
+func (c *Client) CanProposeReplaceTNDAOMember(ctx context.Context, memberAddress common.Address) (api.CanProposeTNDAOReplaceResponse, error) {
+    var response api.CanProposeTNDAOReplaceResponse
+    err := c.call(ctx, &response, "odao", "can-propose-replace", memberAddress.Hex())
+    return response, err
+}
+
+
+// Propose replacing the node's position with a new member
+func (c *Client) ProposeReplaceTNDAOMember(ctx context.Context, memberAddress common.Address, memberId, memberEmail string) (api.ProposeTNDAOReplaceResponse, error) {
+    var response api.ProposeTNDAOReplaceResponse
+    err := c.call(ctx, &response, "odao", "propose-replace", memberAddress.Hex(), memberId, memberEmail)
+    return response, err
+}
+
+
+// Check whether the node can propose kicking a member
+func (c *Client) CanProposeKickFromTNDAO(ctx context.Context, memberAddress common.Address, fineAmountWei *big.Int) (api.CanProposeTNDAOKickResponse, error) {
+    var response api.CanProposeTNDAOKickResponse
+    err := c.call(ctx, &response, "odao", "can-propose-kick", memberAddress.Hex(), fineAmountWei.String())
+    return response, err
+}
+
+
+// Propose kicking a member
+func (c *Client) ProposeKickFromTNDAO(ctx context.Context, memberAddress common.Address, fineAmountWei *big.Int) (api.ProposeTNDAOKickResponse, error) {
+    var response api.ProposeTNDAOKickResponse
+    err := c.call(ctx, &response, "odao", "propose-kick", memberAddress.Hex(), fineAmountWei.String())
+    return response, err
+}
+
+
+// Check whether the node can cancel a proposal
+func (c *Client) CanCancelTNDAOProposal(ctx context.Context, proposalId uint64) (api.CanCancelTNDAOProposalResponse, error) {
+    var response api.CanCancelTNDAOProposalResponse
+    err := c.call(ctx, &response, "odao", "can-cancel-proposal", strconv.FormatUint(proposalId, 10))
+    return response, err
+}
+
+
+// Cancel a proposal made by the node
+func (c *Client) CancelTNDAOProposal(ctx context.Context, proposalId uint64) (api.CancelTNDAOProposalResponse, error) {
+    var response api.CancelTNDAOProposalResponse
+    err := c.call(ctx, &response, "odao", "cancel-proposal", strconv.FormatUint(proposalId, 10))
+    return response, err
+}
+
+
+// Check whether the node can vote on a proposal
+func (c *Client) CanVoteOnTNDAOProposal(ctx context.Context, proposalId uint64) (api.CanVoteOnTNDAOProposalResponse, error) {
+    var response api.CanVoteOnTNDAOProposalResponse
+    err := c.call(ctx, &response, "odao", "can-vote-proposal", strconv.FormatUint(proposalId, 10))
+    return response, err
+}
+
+
+// Vote on a proposal
+func (c *Client) VoteOnTNDAOProposal(ctx context.Context, proposalId uint64, support bool) (api.VoteOnTNDAOProposalResponse, error) {
+    var response api.VoteOnTNDAOProposalResponse
+    err := c.call(ctx, &response, "odao", "vote-proposal", strconv.FormatUint(proposalId, 10), strconv.FormatBool(support))
+    return response, err
+}
+
+
+// Check whether the node can execute a proposal
+func (c *Client) CanExecuteTNDAOProposal(ctx context.Context, proposalId uint64) (api.CanExecuteTNDAOProposalResponse, error) {
+    var response api.CanExecuteTNDAOProposalResponse
+    err := c.call(ctx, &response, "odao", "can-execute-proposal", strconv.FormatUint(proposalId, 10))
+    return response, err
+}
+
+
+// Execute a proposal
+func (c *Client) ExecuteTNDAOProposal(ctx context.Context, proposalId uint64) (api.ExecuteTNDAOProposalResponse, error) {
+    var response api.ExecuteTNDAOProposalResponse
+    err := c.call(ctx, &response, "odao", "execute-proposal", strconv.FormatUint(proposalId, 10))
+    return response, err
+}
+
+
+// Check whether the node can join the oracle DAO
+func (c *Client) CanJoinTNDAO(ctx context.Context) (api.CanJoinTNDAOResponse, error) {
+    var response api.CanJoinTNDAOResponse
+    err := c.call(ctx, &response, "odao", "can-join")
+    return response, err
+}
+
+
+// Join the oracle DAO (requires an executed invite proposal)
+func (c *Client) JoinTNDAO(ctx context.Context) (api.JoinTNDAOResponse, error) {
+    var response api.JoinTNDAOResponse
+    err := c.call(ctx, &response, "odao", "join")
+    return response, err
+}
+
+
+// Check whether the node can leave the oracle DAO
+func (c *Client) CanLeaveTNDAO(ctx context.Context) (api.CanLeaveTNDAOResponse, error) {
+    var response api.CanLeaveTNDAOResponse
+    err := c.call(ctx, &response, "odao", "can-leave")
+    return response, err
+}
+
+
+// Leave the oracle DAO (requires an executed leave proposal)
+func (c *Client) LeaveTNDAO(ctx context.Context, bondRefundAddress common.Address) (api.LeaveTNDAOResponse, error) {
+    var response api.LeaveTNDAOResponse
+    err := c.call(ctx, &response, "odao", "leave", bondRefundAddress.Hex())
+    return response, err
+}
+
+
+// Check whether the node can replace its position in the oracle DAO
+func (c *Client) CanReplaceTNDAOMember(ctx context.Context) (api.CanReplaceTNDAOPositionResponse, error) {
+    var response api.CanReplaceTNDAOPositionResponse
+    err := c.call(ctx, &response, "odao", "can-replace")
+    return response, err
+}
+
+
+// Replace the node's position in the oracle DAO (requires an executed replace proposal)
+func (c *Client) ReplaceTNDAOMember(ctx context.Context) (api.ReplaceTNDAOPositionResponse, error) {
+    var response api.ReplaceTNDAOPositionResponse
+    err := c.call(ctx, &response, "odao", "replace")
+    return response, err
+}
+
+
+// Check whether the node can propose a setting update
+func (c *Client) CanProposeTNDAOSetting(ctx context.Context) (api.CanProposeTNDAOSettingResponse, error) {
+    var response api.CanProposeTNDAOSettingResponse
+    err := c.call(ctx, &response, "odao", "can-propose-setting")
+    return response, err
+}
+
+
+// Propose updating the members.quorum setting
+func (c *Client) ProposeTNDAOSettingMembersQuorum(ctx context.Context, quorum float64) (api.ProposeTNDAOSettingMembersQuorumResponse, error) {
+    var response api.ProposeTNDAOSettingMembersQuorumResponse
+    err := c.call(ctx, &response, "odao", "propose-members-quorum", strconv.FormatFloat(quorum, 'f', -1, 64))
+    return response, err
+}
+
+
+// Propose updating the members.rplbond setting
+func (c *Client) ProposeTNDAOSettingMembersRplBond(ctx context.Context, bondAmountWei *big.Int) (api.ProposeTNDAOSettingMembersRplBondResponse, error) {
+    var response api.ProposeTNDAOSettingMembersRplBondResponse
+    err := c.call(ctx, &response, "odao", "propose-members-rplbond", bondAmountWei.String())
+    return response, err
+}
+
+
+// Propose updating the members.minipool.unbonded.max setting
+func (c *Client) ProposeTNDAOSettingMinipoolUnbondedMax(ctx context.Context, unbondedMinipoolMax uint64) (api.ProposeTNDAOSettingMinipoolUnbondedMaxResponse, error) {
+    var response api.ProposeTNDAOSettingMinipoolUnbondedMaxResponse
+    err := c.call(ctx, &response, "odao", "propose-members-minipool-unbonded-max", strconv.FormatUint(unbondedMinipoolMax, 10))
+    return response, err
+}
+
+
+// Propose updating the proposal.cooldown setting
+func (c *Client) ProposeTNDAOSettingProposalCooldown(ctx context.Context, proposalCooldownBlocks uint64) (api.ProposeTNDAOSettingProposalCooldownResponse, error) {
+    var response api.ProposeTNDAOSettingProposalCooldownResponse
+    err := c.call(ctx, &response, "odao", "propose-proposal-cooldown", strconv.FormatUint(proposalCooldownBlocks, 10))
+    return response, err
+}
+
+
+// Propose updating the proposal.vote.blocks setting
+func (c *Client) ProposeTNDAOSettingProposalVoteBlocks(ctx context.Context, proposalVoteBlocks uint64) (api.ProposeTNDAOSettingProposalVoteBlocksResponse, error) {
+    var response api.ProposeTNDAOSettingProposalVoteBlocksResponse
+    err := c.call(ctx, &response, "odao", "propose-proposal-vote-blocks", strconv.FormatUint(proposalVoteBlocks, 10))
+    return response, err
+}
+
+
+// Propose updating the proposal.vote.delay.blocks setting
+func (c *Client) ProposeTNDAOSettingProposalVoteDelayBlocks(ctx context.Context, proposalDelayBlocks uint64) (api.ProposeTNDAOSettingProposalVoteDelayBlocksResponse, error) {
+    var response api.ProposeTNDAOSettingProposalVoteDelayBlocksResponse
+    err := c.call(ctx, &response, "odao", "propose-proposal-vote-delay-blocks", strconv.FormatUint(proposalDelayBlocks, 10))
+    return response, err
+}
+
+
+// Propose updating the proposal.execute.blocks setting
+func (c *Client) ProposeTNDAOSettingProposalExecuteBlocks(ctx context.Context, proposalExecuteBlocks uint64) (api.ProposeTNDAOSettingProposalExecuteBlocksResponse, error) {
+    var response api.ProposeTNDAOSettingProposalExecuteBlocksResponse
+    err := c.call(ctx, &response, "odao", "propose-proposal-execute-blocks", strconv.FormatUint(proposalExecuteBlocks, 10))
+    return response, err
+}
+
+
+// Propose updating the proposal.action.blocks setting
+func (c *Client) ProposeTNDAOSettingProposalActionBlocks(ctx context.Context, proposalActionBlocks uint64) (api.ProposeTNDAOSettingProposalActionBlocksResponse, error) {
+    var response api.ProposeTNDAOSettingProposalActionBlocksResponse
+    err := c.call(ctx, &response, "odao", "propose-proposal-action-blocks", strconv.FormatUint(proposalActionBlocks, 10))
+    return response, err
+}
+
+
+// Get the member settings
+func (c *Client) GetTNDAOMemberSettings(ctx context.Context) (api.GetTNDAOMemberSettingsResponse, error) {
+    var response api.GetTNDAOMemberSettingsResponse
+    err := c.call(ctx, &response, "odao", "get-member-settings")
+    return response, err
+}
+
+
+// Get the proposal settings
+func (c *Client) GetTNDAOProposalSettings(ctx context.Context) (api.GetTNDAOProposalSettingsResponse, error) {
+    var response api.GetTNDAOProposalSettingsResponse
+    err := c.call(ctx, &response, "odao", "get-proposal-settings")
+    return response, err
+}
@@ -0,0 +1,31 @@
+package apiclient
+
+import (
+    "context"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get queue status
+func (c *Client) QueueStatus(ctx context.Context) (api.QueueStatusResponse, error) {
+    var response api.QueueStatusResponse
+    err := c.call(ctx, &response, "queue", "status")
+    return response, err
+}
+
+
+// Check whether the queue can be processed
+func (c *Client) CanProcessQueue(ctx context.Context) (api.CanProcessQueueResponse, error) {
+    var response api.CanProcessQueueResponse
+    err := c.call(ctx, &response, "queue", "can-process")
+    return response, err
+}
+
+
+// Process the queue
+func (c *Client) ProcessQueue(ctx context.Context) (api.ProcessQueueResponse, error) {
+    var response api.ProcessQueueResponse
+    err := c.call(ctx, &response, "queue", "process")
+    return response, err
+}
@@ -0,0 +1,88 @@
+package apiclient
+
+import (
+    "context"
+    "strconv"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Get wallet status
+func (c *Client) WalletStatus(ctx context.Context) (api.WalletStatusResponse, error) {
+    var response api.WalletStatusResponse
+    err := c.call(ctx, &response, "wallet", "status")
+    return response, err
+}
+
+
+// Set wallet password
+func (c *Client) SetPassword(ctx context.Context, password string) (api.SetPasswordResponse, error) {
+    var response api.SetPasswordResponse
+    err := c.call(ctx, &response, "wallet", "set-password", password)
+    return response, err
+}
+
+
+// Change wallet password
+func (c *Client) ChangePassword(ctx context.Context, currentPassword, newPassword string) (api.ChangePasswordResponse, error) {
+    var response api.ChangePasswordResponse
+    err := c.call(ctx, &response, "wallet", "change-password", currentPassword, newPassword)
+    return response, err
+}
+
+
+// Initialize wallet
+func (c *Client) InitWallet(ctx context.Context) (api.InitWalletResponse, error) {
+    var response api.InitWalletResponse
+    err := c.call(ctx, &response, "wallet", "init")
+    return response, err
+}
+
+
+// Recover wallet
+func (c *Client) RecoverWallet(ctx context.Context, mnemonic string) (api.RecoverWalletResponse, error) {
+    var response api.RecoverWalletResponse
+    err := c.call(ctx, &response, "wallet", "recover", mnemonic)
+    return response, err
+}
+
+
+// Rebuild wallet
+func (c *Client) RebuildWallet(ctx context.Context) (api.RebuildWalletResponse, error) {
+    var response api.RebuildWalletResponse
+    err := c.call(ctx, &response, "wallet", "rebuild")
+    return response, err
+}
+
+
+// Export wallet
+func (c *Client) ExportWallet(ctx context.Context) (api.ExportWalletResponse, error) {
+    var response api.ExportWalletResponse
+    err := c.call(ctx, &response, "wallet", "export")
+    return response, err
+}
+
+
+// Prepare slashing protection history exported by a validator client to a file in the validator keychain folder
+func (c *Client) ExportSlashingProtection(ctx context.Context, file string) (api.ExportSlashingProtectionResponse, error) {
+    var response api.ExportSlashingProtectionResponse
+    err := c.call(ctx, &response, "wallet", "export-slashing-protection", file)
+    return response, err
+}
+
+
+// Check a slashing protection history file in the validator keychain folder before a validator client imports it
+func (c *Client) ImportSlashingProtection(ctx context.Context, file string) (api.ImportSlashingProtectionResponse, error) {
+    var response api.ImportSlashingProtectionResponse
+    err := c.call(ctx, &response, "wallet", "import-slashing-protection", file)
+    return response, err
+}
+
+
+// Verify validator keystores, optionally repairing them and deleting extra keys
+func (c *Client) VerifyValidatorKeys(ctx context.Context, repair, deleteExtra bool) (api.VerifyValidatorKeysResponse, error) {
+    var response api.VerifyValidatorKeysResponse
+    err := c.call(ctx, &response, "wallet", "verify-keys", strconv.FormatBool(repair), strconv.FormatBool(deleteExtra))
+    return response, err
+}
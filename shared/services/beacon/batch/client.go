@@ -0,0 +1,77 @@
+package batch
+
+import (
+    "github.com/rocket-pool/rocketpool-go/types"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+
+// Batching beacon client
+// Wraps a beacon client, splitting large validator status queries into batches which are requested in parallel
+type Client struct {
+    beacon.Client
+    batchSize int
+    concurrency int
+}
+
+
+// Create new batching beacon client
+func NewClient(client beacon.Client, batchSize int, concurrency int) *Client {
+    if batchSize < 1 { batchSize = 1 }
+    if concurrency < 1 { concurrency = 1 }
+    return &Client{
+        Client: client,
+        batchSize: batchSize,
+        concurrency: concurrency,
+    }
+}
+
+
+// Get multiple validators' statuses
+// Batch results are merged into a single status map
+func (c *Client) GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
+
+    // Request statuses directly if a single batch is required
+    if len(pubkeys) <= c.batchSize {
+        return c.Client.GetValidatorStatuses(pubkeys, opts)
+    }
+
+    // Load validator statuses in batches, limiting concurrent requests
+    batchCount := (len(pubkeys) + c.batchSize - 1) / c.batchSize
+    batchStatuses := make([]map[types.ValidatorPubkey]beacon.ValidatorStatus, batchCount)
+    requests := make(chan struct{}, c.concurrency)
+    var wg errgroup.Group
+    for bi := 0; bi < batchCount; bi++ {
+
+        // Get batch start & end index
+        vsi := bi * c.batchSize
+        vei := vsi + c.batchSize
+        if vei > len(pubkeys) { vei = len(pubkeys) }
+
+        // Load statuses
+        bi, batch := bi, pubkeys[vsi:vei]
+        wg.Go(func() error {
+            requests <- struct{}{}
+            defer func() { <-requests }()
+            statuses, err := c.Client.GetValidatorStatuses(batch, opts)
+            if err == nil { batchStatuses[bi] = statuses }
+            return err
+        })
+
+    }
+    if err := wg.Wait(); err != nil {
+        return map[types.ValidatorPubkey]beacon.ValidatorStatus{}, err
+    }
+
+    // Merge & return
+    statuses := make(map[types.ValidatorPubkey]beacon.ValidatorStatus, len(pubkeys))
+    for _, batch := range batchStatuses {
+        for pubkey, status := range batch {
+            statuses[pubkey] = status
+        }
+    }
+    return statuses, nil
+
+}
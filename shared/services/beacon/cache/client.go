@@ -0,0 +1,274 @@
+package cache
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/rocket-pool/rocketpool-go/types"
+    "golang.org/x/sync/singleflight"
+
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+
+// Caching beacon client
+// Wraps a beacon client, memoizing immutable data and coalescing concurrent identical requests
+type Client struct {
+    client beacon.Client
+    requests singleflight.Group
+
+    // Immutable data
+    eth2Config *beacon.Eth2Config
+    validatorIndices map[types.ValidatorPubkey]uint64
+
+    // Per-slot data
+    beaconHead beacon.BeaconHead
+    beaconHeadSlot uint64
+    beaconHeadCached bool
+
+    lock sync.RWMutex
+}
+
+
+// Create new caching beacon client
+func NewClient(client beacon.Client) *Client {
+    return &Client{
+        client: client,
+        validatorIndices: make(map[types.ValidatorPubkey]uint64),
+    }
+}
+
+
+// Close the client connection
+func (c *Client) Close() {
+    c.client.Close()
+}
+
+
+// Get the beacon client type
+func (c *Client) GetClientType() beacon.BeaconClientType {
+    return c.client.GetClientType()
+}
+
+
+// Get the node's sync status
+func (c *Client) GetSyncStatus() (beacon.SyncStatus, error) {
+    syncStatus, err, _ := c.requests.Do("syncStatus", func() (interface{}, error) {
+        return c.client.GetSyncStatus()
+    })
+    if err != nil {
+        return beacon.SyncStatus{}, err
+    }
+    return syncStatus.(beacon.SyncStatus), nil
+}
+
+
+// Get the eth2 config
+// The config is fetched once and cached for the life of the client
+func (c *Client) GetEth2Config() (beacon.Eth2Config, error) {
+
+    // Check cache
+    c.lock.RLock()
+    eth2Config := c.eth2Config
+    c.lock.RUnlock()
+    if eth2Config != nil {
+        return *eth2Config, nil
+    }
+
+    // Get config
+    response, err, _ := c.requests.Do("eth2Config", func() (interface{}, error) {
+        return c.client.GetEth2Config()
+    })
+    if err != nil {
+        return beacon.Eth2Config{}, err
+    }
+    config := response.(beacon.Eth2Config)
+
+    // Cache & return
+    c.lock.Lock()
+    c.eth2Config = &config
+    c.lock.Unlock()
+    return config, nil
+
+}
+
+
+// Get the beacon head
+// The head is cached until the start of the next slot
+func (c *Client) GetBeaconHead() (beacon.BeaconHead, error) {
+
+    // Get the current slot; the head is not cached if it is unknown
+    slot, slotKnown := c.getCurrentSlot()
+
+    // Check cache
+    if slotKnown {
+        c.lock.RLock()
+        head, cached := c.beaconHead, c.beaconHeadCached && c.beaconHeadSlot == slot
+        c.lock.RUnlock()
+        if cached {
+            return head, nil
+        }
+    }
+
+    // Get head
+    response, err, _ := c.requests.Do("beaconHead", func() (interface{}, error) {
+        return c.client.GetBeaconHead()
+    })
+    if err != nil {
+        return beacon.BeaconHead{}, err
+    }
+    head := response.(beacon.BeaconHead)
+
+    // Cache & return
+    if slotKnown {
+        c.lock.Lock()
+        c.beaconHead = head
+        c.beaconHeadSlot = slot
+        c.beaconHeadCached = true
+        c.lock.Unlock()
+    }
+    return head, nil
+
+}
+
+
+// Get a validator's status
+// Concurrent requests for the same validator and epoch share a single beacon request
+func (c *Client) GetValidatorStatus(pubkey types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+    status, err, _ := c.requests.Do("validatorStatus/" + getStatusOptionsKey(opts) + "/" + pubkey.Hex(), func() (interface{}, error) {
+        return c.client.GetValidatorStatus(pubkey, opts)
+    })
+    if err != nil {
+        return beacon.ValidatorStatus{}, err
+    }
+    return status.(beacon.ValidatorStatus), nil
+}
+
+
+// Get multiple validators' statuses
+// Concurrent requests for the same set of validators and epoch share a single beacon request
+func (c *Client) GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
+
+    // Get request key from sorted pubkeys
+    pubkeyHexes := make([]string, len(pubkeys))
+    for pi, pubkey := range pubkeys {
+        pubkeyHexes[pi] = pubkey.Hex()
+    }
+    sort.Strings(pubkeyHexes)
+    key := "validatorStatuses/" + getStatusOptionsKey(opts) + "/" + strings.Join(pubkeyHexes, ",")
+
+    // Get statuses
+    response, err, _ := c.requests.Do(key, func() (interface{}, error) {
+        return c.client.GetValidatorStatuses(pubkeys, opts)
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    // Return a copy, as the result may be shared between callers
+    statuses := response.(map[types.ValidatorPubkey]beacon.ValidatorStatus)
+    statusesCopy := make(map[types.ValidatorPubkey]beacon.ValidatorStatus, len(statuses))
+    for pubkey, status := range statuses {
+        statusesCopy[pubkey] = status
+    }
+    return statusesCopy, nil
+
+}
+
+
+// Get a validator's index
+// Indices never change once assigned, so they are cached for the life of the client
+func (c *Client) GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error) {
+
+    // Check cache
+    c.lock.RLock()
+    index, cached := c.validatorIndices[pubkey]
+    c.lock.RUnlock()
+    if cached {
+        return index, nil
+    }
+
+    // Get index
+    response, err, _ := c.requests.Do("validatorIndex/" + pubkey.Hex(), func() (interface{}, error) {
+        return c.client.GetValidatorIndex(pubkey)
+    })
+    if err != nil {
+        return 0, err
+    }
+    index = response.(uint64)
+
+    // Cache & return
+    c.lock.Lock()
+    c.validatorIndices[pubkey] = index
+    c.lock.Unlock()
+    return index, nil
+
+}
+
+
+// Get multiple validators' balances at the start of an epoch
+func (c *Client) GetValidatorBalances(indices []uint64, epoch uint64) (map[uint64]uint64, error) {
+    return c.client.GetValidatorBalances(indices, epoch)
+}
+
+
+// Get attestation duties for multiple validators in an epoch
+func (c *Client) GetAttesterDuties(indices []uint64, epoch uint64) ([]beacon.AttesterDuty, error) {
+    return c.client.GetAttesterDuties(indices, epoch)
+}
+
+
+// Get block proposal duties for multiple validators in an epoch
+func (c *Client) GetProposerDuties(indices []uint64, epoch uint64) ([]beacon.ProposerDuty, error) {
+    return c.client.GetProposerDuties(indices, epoch)
+}
+
+
+// Get the beacon block at a slot
+func (c *Client) GetBeaconBlock(slot uint64) (beacon.BeaconBlock, bool, error) {
+    return c.client.GetBeaconBlock(slot)
+}
+
+
+// Get domain data for a domain type at a given epoch
+func (c *Client) GetDomainData(domainType []byte, epoch uint64) ([]byte, error) {
+    return c.client.GetDomainData(domainType, epoch)
+}
+
+
+// Perform a voluntary exit on a validator
+func (c *Client) ExitValidator(validatorIndex, epoch uint64, signature types.ValidatorSignature) error {
+    return c.client.ExitValidator(validatorIndex, epoch, signature)
+}
+
+
+// Get the current slot from the cached eth2 config
+func (c *Client) getCurrentSlot() (uint64, bool) {
+
+    // Get eth2 config
+    eth2Config, err := c.GetEth2Config()
+    if err != nil || eth2Config.SlotsPerEpoch == 0 || eth2Config.SecondsPerEpoch < eth2Config.SlotsPerEpoch {
+        return 0, false
+    }
+
+    // Get current slot
+    secondsPerSlot := eth2Config.SecondsPerEpoch / eth2Config.SlotsPerEpoch
+    now := uint64(time.Now().Unix())
+    if now < eth2Config.GenesisTime {
+        return 0, false
+    }
+    return (now - eth2Config.GenesisTime) / secondsPerSlot, true
+
+}
+
+
+// Get a request key component for validator status options
+func getStatusOptionsKey(opts *beacon.ValidatorStatusOptions) string {
+    if opts == nil {
+        return "head"
+    }
+    return fmt.Sprintf("%d", opts.Epoch)
+}
@@ -1,6 +1,8 @@
 package beacon
 
 import (
+    "fmt"
+
     "github.com/ethereum/go-ethereum/common"
     "github.com/rocket-pool/rocketpool-go/types"
 )
@@ -22,6 +24,7 @@ type Eth2Config struct {
     GenesisEpoch uint64
     GenesisTime uint64
     SecondsPerEpoch uint64
+    SlotsPerEpoch uint64
 }
 type BeaconHead struct {
     Epoch uint64
@@ -42,6 +45,26 @@ type ValidatorStatus struct {
     WithdrawableEpoch uint64
     Exists bool
 }
+type AttesterDuty struct {
+    ValidatorIndex uint64
+    Slot uint64
+    CommitteeIndex uint64
+    CommitteePosition uint64
+}
+type ProposerDuty struct {
+    ValidatorIndex uint64
+    Slot uint64
+}
+type BeaconBlock struct {
+    Slot uint64
+    ProposerIndex uint64
+    Attestations []Attestation
+}
+type Attestation struct {
+    Slot uint64
+    CommitteeIndex uint64
+    AggregationBits []byte
+}
 
 
 // Beacon client type
@@ -59,6 +82,17 @@ const(
 )
 
 
+// Beacon node HTTP status error
+// Returned when a beacon node responds to a request with an unexpected status code
+type HttpStatusError struct {
+    StatusCode int
+    Body string
+}
+func (e *HttpStatusError) Error() string {
+    return fmt.Sprintf("HTTP status %d; response body: '%s'", e.StatusCode, e.Body)
+}
+
+
 // Beacon client interface
 type Client interface {
     GetClientType() (BeaconClientType)
@@ -68,8 +102,34 @@ type Client interface {
     GetValidatorStatus(pubkey types.ValidatorPubkey, opts *ValidatorStatusOptions) (ValidatorStatus, error)
     GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *ValidatorStatusOptions) (map[types.ValidatorPubkey]ValidatorStatus, error)
     GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error)
+    GetValidatorBalances(indices []uint64, epoch uint64) (map[uint64]uint64, error)
+    GetAttesterDuties(indices []uint64, epoch uint64) ([]AttesterDuty, error)
+    GetProposerDuties(indices []uint64, epoch uint64) ([]ProposerDuty, error)
+    GetBeaconBlock(slot uint64) (BeaconBlock, bool, error)
     GetDomainData(domainType []byte, epoch uint64) ([]byte, error)
     ExitValidator(validatorIndex, epoch uint64, signature types.ValidatorSignature) error
     Close()
 }
 
+
+
+// Check whether a validator's aggregation bit is set in an attestation
+// Aggregation bits are an SSZ bitlist, terminated by a length delimiter bit
+func (a Attestation) HasAttested(committeePosition uint64) bool {
+    if len(a.AggregationBits) == 0 {
+        return false
+    }
+    lastByte := a.AggregationBits[len(a.AggregationBits) - 1]
+    if lastByte == 0 {
+        return false
+    }
+    length := uint64(len(a.AggregationBits) - 1) * 8
+    for lastByte > 1 {
+        lastByte >>= 1
+        length++
+    }
+    if committeePosition >= length {
+        return false
+    }
+    return a.AggregationBits[committeePosition / 8] & (1 << (committeePosition % 8)) != 0
+}
@@ -8,6 +8,7 @@ import (
     "net/http"
     "strconv"
     "strings"
+    "sync/atomic"
     "time"
 
     "github.com/ethereum/go-ethereum/common"
@@ -33,15 +34,21 @@ const (
     RequestFinalityCheckpointsPath = "/eth/v1/beacon/states/%s/finality_checkpoints"
     RequestForkPath = "/eth/v1/beacon/states/%s/fork"
     RequestValidatorsPath = "/eth/v1/beacon/states/%s/validators"
+    RequestValidatorBalancesPath = "/eth/v1/beacon/states/%s/validator_balances"
+    RequestCommitteesPath = "/eth/v1/beacon/states/%s/committees"
+    RequestProposerDutiesPath = "/eth/v1/validator/duties/proposer/%d"
+    RequestBeaconBlockPath = "/eth/v2/beacon/blocks/%s"
     RequestVoluntaryExitPath = "/eth/v1/beacon/pool/voluntary_exits"
 
     MaxRequestValidatorsCount = 600
+    PostValidatorsRetryInterval = 10 * time.Minute
 )
 
 
 // Lighthouse client
 type Client struct {
     providerAddress string
+    postValidatorsRetryTime int64
 }
 
 
@@ -114,6 +121,7 @@ func (c *Client) GetEth2Config() (beacon.Eth2Config, error) {
         GenesisEpoch: 0,
         GenesisTime: uint64(genesis.Data.GenesisTime),
         SecondsPerEpoch: uint64(eth2Config.Data.SecondsPerSlot * eth2Config.Data.SlotsPerEpoch),
+        SlotsPerEpoch: uint64(eth2Config.Data.SlotsPerEpoch),
     }, nil
 
 }
@@ -246,6 +254,137 @@ func (c *Client) GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error)
 }
 
 
+// Get multiple validators' balances at the start of an epoch
+func (c *Client) GetValidatorBalances(indices []uint64, epoch uint64) (map[uint64]uint64, error) {
+
+    // Return if no indices defined
+    balances := make(map[uint64]uint64, len(indices))
+    if len(indices) == 0 {
+        return balances, nil
+    }
+
+    // Get state ID
+    stateId, err := c.getEpochStateId(epoch)
+    if err != nil {
+        return map[uint64]uint64{}, err
+    }
+
+    // Load validator balances in batches
+    for bsi := 0; bsi < len(indices); bsi += MaxRequestValidatorsCount {
+
+        // Get batch start & end index
+        vsi := bsi
+        vei := bsi + MaxRequestValidatorsCount
+        if vei > len(indices) { vei = len(indices) }
+
+        // Get validator indices for batch request
+        ids := make([]string, vei - vsi)
+        for vi := vsi; vi < vei; vi++ {
+            ids[vi - vsi] = strconv.FormatUint(indices[vi], 10)
+        }
+
+        // Get & add balances
+        validatorBalances, err := c.getValidatorBalances(stateId, ids)
+        if err != nil {
+            return map[uint64]uint64{}, err
+        }
+        for _, balance := range validatorBalances.Data {
+            balances[uint64(balance.Index)] = uint64(balance.Balance)
+        }
+
+    }
+
+    // Return
+    return balances, nil
+
+}
+
+
+// Get attestation duties for multiple validators in an epoch
+func (c *Client) GetAttesterDuties(indices []uint64, epoch uint64) ([]beacon.AttesterDuty, error) {
+
+    // Get state ID
+    stateId, err := c.getEpochStateId(epoch)
+    if err != nil {
+        return []beacon.AttesterDuty{}, err
+    }
+
+    // Get committees
+    committees, err := c.getCommittees(stateId, epoch)
+    if err != nil {
+        return []beacon.AttesterDuty{}, err
+    }
+
+    // Build & return duties
+    validatorIndices := getIndexSet(indices)
+    duties := []beacon.AttesterDuty{}
+    for _, committee := range committees.Data {
+        for position, validatorIndex := range committee.Validators {
+            if !validatorIndices[uint64(validatorIndex)] { continue }
+            duties = append(duties, beacon.AttesterDuty{
+                ValidatorIndex: uint64(validatorIndex),
+                Slot: uint64(committee.Slot),
+                CommitteeIndex: uint64(committee.Index),
+                CommitteePosition: uint64(position),
+            })
+        }
+    }
+    return duties, nil
+
+}
+
+
+// Get block proposal duties for multiple validators in an epoch
+func (c *Client) GetProposerDuties(indices []uint64, epoch uint64) ([]beacon.ProposerDuty, error) {
+
+    // Get proposer duties
+    proposerDuties, err := c.getProposerDuties(epoch)
+    if err != nil {
+        return []beacon.ProposerDuty{}, err
+    }
+
+    // Build & return duties
+    validatorIndices := getIndexSet(indices)
+    duties := []beacon.ProposerDuty{}
+    for _, duty := range proposerDuties.Data {
+        if !validatorIndices[uint64(duty.ValidatorIndex)] { continue }
+        duties = append(duties, beacon.ProposerDuty{
+            ValidatorIndex: uint64(duty.ValidatorIndex),
+            Slot: uint64(duty.Slot),
+        })
+    }
+    return duties, nil
+
+}
+
+
+// Get the beacon block at a slot; returns false if no block was proposed
+func (c *Client) GetBeaconBlock(slot uint64) (beacon.BeaconBlock, bool, error) {
+
+    // Get block
+    block, exists, err := c.getBeaconBlock(strconv.FormatUint(slot, 10))
+    if err != nil || !exists {
+        return beacon.BeaconBlock{}, false, err
+    }
+
+    // Build & return block
+    attestations := make([]beacon.Attestation, len(block.Data.Message.Body.Attestations))
+    for ai, attestation := range block.Data.Message.Body.Attestations {
+        attestations[ai] = beacon.Attestation{
+            Slot: uint64(attestation.Data.Slot),
+            CommitteeIndex: uint64(attestation.Data.Index),
+            AggregationBits: attestation.AggregationBits,
+        }
+    }
+    return beacon.BeaconBlock{
+        Slot: uint64(block.Data.Message.Slot),
+        ProposerIndex: uint64(block.Data.Message.ProposerIndex),
+        Attestations: attestations,
+    }, true, nil
+
+}
+
+
 // Get domain data for a domain type at a given epoch
 func (c *Client) GetDomainData(domainType []byte, epoch uint64) ([]byte, error) {
 
@@ -307,7 +446,7 @@ func (c *Client) getSyncStatus() (SyncStatusResponse, error) {
     if err != nil {
         return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: %w", err)
     } else if status != http.StatusOK {
-        return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: HTTP status %d; response body: '%s'", status, string(responseBody))
+        return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: %w", &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
     }
     var syncStatus SyncStatusResponse
     if err := json.Unmarshal(responseBody, &syncStatus); err != nil {
@@ -323,7 +462,7 @@ func (c *Client) getEth2Config() (Eth2ConfigResponse, error) {
     if err != nil {
         return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %w", err)
     } else if status != http.StatusOK {
-        return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: HTTP status %d; response body: '%s'", status, string(responseBody))
+        return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %w", &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
     }
     var eth2Config Eth2ConfigResponse
     if err := json.Unmarshal(responseBody, &eth2Config); err != nil {
@@ -339,7 +478,7 @@ func (c *Client) getGenesis() (GenesisResponse, error) {
     if err != nil {
         return GenesisResponse{}, fmt.Errorf("Could not get genesis data: %w", err)
     } else if status != http.StatusOK {
-        return GenesisResponse{}, fmt.Errorf("Could not get genesis data: HTTP status %d; response body: '%s'", status, string(responseBody))
+        return GenesisResponse{}, fmt.Errorf("Could not get genesis data: %w", &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
     }
     var genesis GenesisResponse
     if err := json.Unmarshal(responseBody, &genesis); err != nil {
@@ -355,7 +494,7 @@ func (c *Client) getFinalityCheckpoints(stateId string) (FinalityCheckpointsResp
     if err != nil {
         return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: %w", err)
     } else if status != http.StatusOK {
-        return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: HTTP status %d; response body: '%s'", status, string(responseBody))
+        return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: %w", &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
     }
     var finalityCheckpoints FinalityCheckpointsResponse
     if err := json.Unmarshal(responseBody, &finalityCheckpoints); err != nil {
@@ -371,7 +510,7 @@ func (c *Client) getFork(stateId string) (ForkResponse, error) {
     if err != nil {
         return ForkResponse{}, fmt.Errorf("Could not get fork data: %w", err)
     } else if status != http.StatusOK {
-        return ForkResponse{}, fmt.Errorf("Could not get fork data: HTTP status %d; response body: '%s'", status, string(responseBody))
+        return ForkResponse{}, fmt.Errorf("Could not get fork data: %w", &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
     }
     var fork ForkResponse
     if err := json.Unmarshal(responseBody, &fork); err != nil {
@@ -382,16 +521,31 @@ func (c *Client) getFork(stateId string) (ForkResponse, error) {
 
 
 // Get validators
+// Validators are requested by POST where supported by the node, to avoid URL length limits; otherwise they are requested by GET
+// If the node rejects POST requests, GET is used until POST is retried after an interval, in case the node is upgraded or replaced
 func (c *Client) getValidators(stateId string, pubkeys []string) (ValidatorsResponse, error) {
-    var query string
-    if len(pubkeys) > 0 {
-        query = fmt.Sprintf("?id=%s", strings.Join(pubkeys, ","))
+    var responseBody []byte
+    var status int
+    var err error
+    usePost := len(pubkeys) > 0 && time.Now().UnixNano() >= atomic.LoadInt64(&c.postValidatorsRetryTime)
+    if usePost {
+        responseBody, status, err = c.postRequest(fmt.Sprintf(RequestValidatorsPath, stateId), ValidatorsRequest{Ids: pubkeys})
+        if err == nil && (status == http.StatusNotFound || status == http.StatusMethodNotAllowed) {
+            atomic.StoreInt64(&c.postValidatorsRetryTime, time.Now().Add(PostValidatorsRetryInterval).UnixNano())
+            usePost = false
+        }
+    }
+    if !usePost {
+        var query string
+        if len(pubkeys) > 0 {
+            query = fmt.Sprintf("?id=%s", strings.Join(pubkeys, ","))
+        }
+        responseBody, status, err = c.getRequest(fmt.Sprintf(RequestValidatorsPath, stateId) + query)
     }
-    responseBody, status, err := c.getRequest(fmt.Sprintf(RequestValidatorsPath, stateId) + query)
     if err != nil {
         return ValidatorsResponse{}, fmt.Errorf("Could not get validators: %w", err)
     } else if status != http.StatusOK {
-        return ValidatorsResponse{}, fmt.Errorf("Could not get validators: HTTP status %d; response body: '%s'", status, string(responseBody))
+        return ValidatorsResponse{}, fmt.Errorf("Could not get validators: %w", &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
     }
     var validators ValidatorsResponse
     if err := json.Unmarshal(responseBody, &validators); err != nil {
@@ -450,13 +604,89 @@ func (c *Client) getValidatorsByOpts(pubkeys []types.ValidatorPubkey, opts *beac
 }
 
 
+// Get validator balances
+func (c *Client) getValidatorBalances(stateId string, indices []string) (ValidatorBalancesResponse, error) {
+    responseBody, status, err := c.getRequest(fmt.Sprintf(RequestValidatorBalancesPath, stateId) + fmt.Sprintf("?id=%s", strings.Join(indices, ",")))
+    if err != nil {
+        return ValidatorBalancesResponse{}, fmt.Errorf("Could not get validator balances: %w", err)
+    } else if status != http.StatusOK {
+        return ValidatorBalancesResponse{}, fmt.Errorf("Could not get validator balances: %w", &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
+    }
+    var balances ValidatorBalancesResponse
+    if err := json.Unmarshal(responseBody, &balances); err != nil {
+        return ValidatorBalancesResponse{}, fmt.Errorf("Could not decode validator balances: %w", err)
+    }
+    return balances, nil
+}
+
+
+// Get beacon committees for an epoch
+func (c *Client) getCommittees(stateId string, epoch uint64) (CommitteesResponse, error) {
+    responseBody, status, err := c.getRequest(fmt.Sprintf(RequestCommitteesPath, stateId) + fmt.Sprintf("?epoch=%d", epoch))
+    if err != nil {
+        return CommitteesResponse{}, fmt.Errorf("Could not get committees for epoch %d: %w", epoch, err)
+    } else if status != http.StatusOK {
+        return CommitteesResponse{}, fmt.Errorf("Could not get committees for epoch %d: %w", epoch, &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
+    }
+    var committees CommitteesResponse
+    if err := json.Unmarshal(responseBody, &committees); err != nil {
+        return CommitteesResponse{}, fmt.Errorf("Could not decode committees: %w", err)
+    }
+    return committees, nil
+}
+
+
+// Get block proposer duties for an epoch
+func (c *Client) getProposerDuties(epoch uint64) (ProposerDutiesResponse, error) {
+    responseBody, status, err := c.getRequest(fmt.Sprintf(RequestProposerDutiesPath, epoch))
+    if err != nil {
+        return ProposerDutiesResponse{}, fmt.Errorf("Could not get proposer duties for epoch %d: %w", epoch, err)
+    } else if status != http.StatusOK {
+        return ProposerDutiesResponse{}, fmt.Errorf("Could not get proposer duties for epoch %d: %w", epoch, &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
+    }
+    var duties ProposerDutiesResponse
+    if err := json.Unmarshal(responseBody, &duties); err != nil {
+        return ProposerDutiesResponse{}, fmt.Errorf("Could not decode proposer duties: %w", err)
+    }
+    return duties, nil
+}
+
+
+// Get a beacon block; returns false if the block was not found
+func (c *Client) getBeaconBlock(blockId string) (BeaconBlockResponse, bool, error) {
+    responseBody, status, err := c.getRequest(fmt.Sprintf(RequestBeaconBlockPath, blockId))
+    if err != nil {
+        return BeaconBlockResponse{}, false, fmt.Errorf("Could not get beacon block %s: %w", blockId, err)
+    } else if status == http.StatusNotFound {
+        return BeaconBlockResponse{}, false, nil
+    } else if status != http.StatusOK {
+        return BeaconBlockResponse{}, false, fmt.Errorf("Could not get beacon block %s: %w", blockId, &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
+    }
+    var block BeaconBlockResponse
+    if err := json.Unmarshal(responseBody, &block); err != nil {
+        return BeaconBlockResponse{}, false, fmt.Errorf("Could not decode beacon block: %w", err)
+    }
+    return block, true, nil
+}
+
+
+// Get the state ID for the first slot in an epoch
+func (c *Client) getEpochStateId(epoch uint64) (string, error) {
+    eth2Config, err := c.getEth2Config()
+    if err != nil {
+        return "", err
+    }
+    return strconv.FormatUint(epoch * uint64(eth2Config.Data.SlotsPerEpoch), 10), nil
+}
+
+
 // Send voluntary exit request
 func (c *Client) postVoluntaryExit(request VoluntaryExitRequest) error {
     responseBody, status, err := c.postRequest(RequestVoluntaryExitPath, request)
     if err != nil {
         return fmt.Errorf("Could not broadcast exit for validator at index %d: %w", request.Message.ValidatorIndex, err)
     } else if status != http.StatusOK {
-        return fmt.Errorf("Could not broadcast exit for validator at index %d: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
+        return fmt.Errorf("Could not broadcast exit for validator at index %d: %w", request.Message.ValidatorIndex, &beacon.HttpStatusError{StatusCode: status, Body: string(responseBody)})
     }
     return nil
 }
@@ -512,3 +742,12 @@ func (c *Client) postRequest(requestPath string, requestBody interface{}) ([]byt
 
 }
 
+
+// Get a set of validator indices
+func getIndexSet(indices []uint64) map[uint64]bool {
+    indexSet := make(map[uint64]bool, len(indices))
+    for _, index := range indices {
+        indexSet[index] = true
+    }
+    return indexSet
+}
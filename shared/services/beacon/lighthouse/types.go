@@ -18,6 +18,9 @@ type VoluntaryExitMessage struct {
     Epoch uinteger                      `json:"epoch"`
     ValidatorIndex uinteger             `json:"validator_index"`
 }
+type ValidatorsRequest struct {
+    Ids []string                        `json:"ids"`
+}
 
 
 // Response types
@@ -79,6 +82,45 @@ type Validator struct {
         WithdrawableEpoch uinteger          `json:"withdrawable_epoch"`
     }                                   `json:"validator"`
 }
+type ValidatorBalancesResponse struct {
+    Data []ValidatorBalance             `json:"data"`
+}
+type ValidatorBalance struct {
+    Index uinteger                      `json:"index"`
+    Balance uinteger                    `json:"balance"`
+}
+type CommitteesResponse struct {
+    Data []Committee                    `json:"data"`
+}
+type Committee struct {
+    Index uinteger                      `json:"index"`
+    Slot uinteger                       `json:"slot"`
+    Validators []uinteger               `json:"validators"`
+}
+type ProposerDutiesResponse struct {
+    Data []ProposerDuty                 `json:"data"`
+}
+type ProposerDuty struct {
+    ValidatorIndex uinteger             `json:"validator_index"`
+    Slot uinteger                       `json:"slot"`
+}
+type BeaconBlockResponse struct {
+    Data struct {
+        Message struct {
+            Slot uinteger                       `json:"slot"`
+            ProposerIndex uinteger              `json:"proposer_index"`
+            Body struct {
+                Attestations []struct {
+                    AggregationBits byteArray           `json:"aggregation_bits"`
+                    Data struct {
+                        Slot uinteger                       `json:"slot"`
+                        Index uinteger                      `json:"index"`
+                    }                                   `json:"data"`
+                }                                   `json:"attestations"`
+            }                                   `json:"body"`
+        }                                   `json:"message"`
+    }                                   `json:"data"`
+}
 
 
 // Unsigned integer type
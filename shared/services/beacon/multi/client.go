@@ -0,0 +1,364 @@
+package multi
+
+import (
+    "errors"
+    "fmt"
+    "io"
+    "log"
+    "net"
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+
+    "github.com/rocket-pool/rocketpool-go/types"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+
+// Config
+const HealthCheckInterval = 30 * time.Second
+
+
+// Beacon node
+type beaconNode struct {
+    id int
+    client beacon.Client
+    healthy bool
+}
+
+
+// Multi beacon client
+// Calls are routed to the first synced, healthy beacon node, and fail over to the remaining nodes on error
+type Client struct {
+    nodes []*beaconNode
+    lastChecked time.Time
+    lock sync.Mutex
+}
+
+
+// Create new multi beacon client
+// Clients are used in priority order, and are assumed healthy until checked
+func NewClient(clients []beacon.Client) *Client {
+    nodes := make([]*beaconNode, len(clients))
+    for ci, client := range clients {
+        nodes[ci] = &beaconNode{
+            id: ci + 1,
+            client: client,
+            healthy: true,
+        }
+    }
+    return &Client{
+        nodes: nodes,
+    }
+}
+
+
+// Close the client connections
+func (c *Client) Close() {
+    for _, node := range c.nodes {
+        node.client.Close()
+    }
+}
+
+
+// Get the beacon client type
+// All beacon nodes run the same client
+func (c *Client) GetClientType() beacon.BeaconClientType {
+    return c.nodes[0].client.GetClientType()
+}
+
+
+// Get the sync status
+// The nodes are synced if any node is synced
+func (c *Client) GetSyncStatus() (beacon.SyncStatus, error) {
+    var syncStatus beacon.SyncStatus
+    var responded bool
+    var errs []string
+    for _, node := range c.getNodes() {
+        status, err := node.client.GetSyncStatus()
+        if err != nil {
+            c.markFailed(node, err)
+            errs = append(errs, fmt.Sprintf("beacon node %d: %s", node.id, err.Error()))
+            continue
+        }
+        if !status.Syncing {
+            return status, nil
+        }
+        syncStatus, responded = status, true
+    }
+    if responded {
+        return syncStatus, nil
+    }
+    return beacon.SyncStatus{}, getNodesError(errs)
+}
+
+
+// Get the eth2 config
+func (c *Client) GetEth2Config() (beacon.Eth2Config, error) {
+    var eth2Config beacon.Eth2Config
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        eth2Config, err = client.GetEth2Config()
+        return err
+    })
+    return eth2Config, err
+}
+
+
+// Get the beacon head
+func (c *Client) GetBeaconHead() (beacon.BeaconHead, error) {
+    var head beacon.BeaconHead
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        head, err = client.GetBeaconHead()
+        return err
+    })
+    return head, err
+}
+
+
+// Get a validator's status
+func (c *Client) GetValidatorStatus(pubkey types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
+    var status beacon.ValidatorStatus
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        status, err = client.GetValidatorStatus(pubkey, opts)
+        return err
+    })
+    return status, err
+}
+
+
+// Get multiple validators' statuses
+func (c *Client) GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
+    var statuses map[types.ValidatorPubkey]beacon.ValidatorStatus
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        statuses, err = client.GetValidatorStatuses(pubkeys, opts)
+        return err
+    })
+    return statuses, err
+}
+
+
+// Get a validator's index
+func (c *Client) GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error) {
+    var index uint64
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        index, err = client.GetValidatorIndex(pubkey)
+        return err
+    })
+    return index, err
+}
+
+
+// Get multiple validators' balances at the start of an epoch
+func (c *Client) GetValidatorBalances(indices []uint64, epoch uint64) (map[uint64]uint64, error) {
+    var balances map[uint64]uint64
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        balances, err = client.GetValidatorBalances(indices, epoch)
+        return err
+    })
+    return balances, err
+}
+
+
+// Get attestation duties for multiple validators in an epoch
+func (c *Client) GetAttesterDuties(indices []uint64, epoch uint64) ([]beacon.AttesterDuty, error) {
+    var duties []beacon.AttesterDuty
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        duties, err = client.GetAttesterDuties(indices, epoch)
+        return err
+    })
+    return duties, err
+}
+
+
+// Get block proposal duties for multiple validators in an epoch
+func (c *Client) GetProposerDuties(indices []uint64, epoch uint64) ([]beacon.ProposerDuty, error) {
+    var duties []beacon.ProposerDuty
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        duties, err = client.GetProposerDuties(indices, epoch)
+        return err
+    })
+    return duties, err
+}
+
+
+// Get the beacon block at a slot
+func (c *Client) GetBeaconBlock(slot uint64) (beacon.BeaconBlock, bool, error) {
+    var block beacon.BeaconBlock
+    var exists bool
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        block, exists, err = client.GetBeaconBlock(slot)
+        return err
+    })
+    return block, exists, err
+}
+
+
+// Get domain data for a domain type at a given epoch
+func (c *Client) GetDomainData(domainType []byte, epoch uint64) ([]byte, error) {
+    var domainData []byte
+    err := c.do(func(client beacon.Client) error {
+        var err error
+        domainData, err = client.GetDomainData(domainType, epoch)
+        return err
+    })
+    return domainData, err
+}
+
+
+// Perform a voluntary exit on a validator
+// The exit is broadcast through the first node to accept it
+func (c *Client) ExitValidator(validatorIndex, epoch uint64, signature types.ValidatorSignature) error {
+    return c.do(func(client beacon.Client) error {
+        return client.ExitValidator(validatorIndex, epoch, signature)
+    })
+}
+
+
+// Run a request against the beacon nodes until one succeeds
+// Application errors (e.g. a validator which does not exist) are returned directly; only node failures fail over
+func (c *Client) do(request func(client beacon.Client) error) error {
+    var errs []string
+    for _, node := range c.getNodes() {
+        err := request(node.client)
+        if err == nil {
+            return nil
+        }
+        if !isNodeFailure(err) {
+            return err
+        }
+        c.markFailed(node, err)
+        errs = append(errs, fmt.Sprintf("beacon node %d: %s", node.id, err.Error()))
+    }
+    return getNodesError(errs)
+}
+
+
+// Get beacon nodes in the order they should be tried
+// Healthy nodes are tried first, followed by unhealthy nodes as a last resort
+func (c *Client) getNodes() []*beaconNode {
+
+    // Check node health if due
+    c.lock.Lock()
+    checkDue := time.Since(c.lastChecked) >= HealthCheckInterval
+    if checkDue {
+        c.lastChecked = time.Now()
+    }
+    c.lock.Unlock()
+    if checkDue {
+        c.checkHealth()
+    }
+
+    // Order nodes
+    c.lock.Lock()
+    defer c.lock.Unlock()
+    healthy := []*beaconNode{}
+    unhealthy := []*beaconNode{}
+    for _, node := range c.nodes {
+        if node.healthy {
+            healthy = append(healthy, node)
+        } else {
+            unhealthy = append(unhealthy, node)
+        }
+    }
+    return append(healthy, unhealthy...)
+
+}
+
+
+// Check beacon node health
+// Nodes are unhealthy if they fail to respond or are syncing
+func (c *Client) checkHealth() {
+
+    // Check nodes
+    errs := make([]error, len(c.nodes))
+    var wg sync.WaitGroup
+    for ni, node := range c.nodes {
+        ni, client := ni, node.client
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            syncStatus, err := client.GetSyncStatus()
+            if err == nil && syncStatus.Syncing {
+                err = errors.New("Beacon node is syncing")
+            }
+            errs[ni] = err
+        }()
+    }
+    wg.Wait()
+
+    // Update node health
+    c.lock.Lock()
+    defer c.lock.Unlock()
+    for ni, node := range c.nodes {
+        wasHealthy := node.healthy
+        node.healthy = (errs[ni] == nil)
+        if node.healthy != wasHealthy {
+            if node.healthy {
+                log.Printf("Beacon node %d is healthy\n", node.id)
+            } else {
+                log.Printf("Beacon node %d is unhealthy: %s\n", node.id, errs[ni].Error())
+            }
+        }
+    }
+
+}
+
+
+// Mark a beacon node as unhealthy after a failed request
+// It is restored by the next successful health check
+func (c *Client) markFailed(node *beaconNode, err error) {
+    c.lock.Lock()
+    defer c.lock.Unlock()
+    if node.healthy {
+        log.Printf("Beacon node %d failed and has been marked unhealthy: %s\n", node.id, err.Error())
+    }
+    node.healthy = false
+}
+
+
+// Check whether a request error was caused by a beacon node failure
+// Transport errors, server errors and unavailable gRPC services are node failures
+func isNodeFailure(err error) bool {
+
+    // HTTP status errors
+    var statusErr *beacon.HttpStatusError
+    if errors.As(err, &statusErr) {
+        return statusErr.StatusCode >= http.StatusInternalServerError || statusErr.StatusCode == http.StatusTooManyRequests
+    }
+
+    // gRPC status errors
+    var grpcErr interface{ GRPCStatus() *status.Status }
+    if errors.As(err, &grpcErr) {
+        switch grpcErr.GRPCStatus().Code() {
+            case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Aborted: return true
+            default: return false
+        }
+    }
+
+    // Transport errors
+    var netErr net.Error
+    if errors.As(err, &netErr) {
+        return true
+    }
+    return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+
+}
+
+
+// Get an error for a request which no beacon node could serve
+func getNodesError(errs []string) error {
+    return fmt.Errorf("No beacon node could serve the request (%s)", strings.Join(errs, "; "))
+}
@@ -3,6 +3,7 @@ package nimbus
 import (
     "fmt"
     "strconv"
+    "strings"
     "time"
 
     "github.com/ethereum/go-ethereum/common"
@@ -24,6 +25,10 @@ const (
     RequestFinalityCheckpointsMethod = "get_v1_beacon_states_finality_checkpoints"
     RequestForkMethod                = "get_v1_beacon_states_fork"
     RequestValidatorsMethod          = "get_v1_beacon_states_stateId_validators"
+    RequestValidatorBalancesMethod   = "get_v1_beacon_states_stateId_validator_balances"
+    RequestCommitteesMethod          = "get_v1_beacon_states_stateId_committees_epoch"
+    RequestProposerDutiesMethod      = "get_v1_validator_duties_proposer"
+    RequestBeaconBlockMethod         = "get_v1_beacon_blocks_blockId"
     RequestVoluntaryExitMethod       = "post_v1_beacon_pool_voluntary_exits"
 
     MaxRequestValidatorsCount = 30
@@ -107,6 +112,7 @@ func (c *Client) GetEth2Config() (beacon.Eth2Config, error) {
         GenesisEpoch:          0,
         GenesisTime:           uint64(genesis.GenesisTime),
         SecondsPerEpoch:       uint64(eth2Config.SecondsPerSlot * eth2Config.SlotsPerEpoch),
+        SlotsPerEpoch:         uint64(eth2Config.SlotsPerEpoch),
     }, nil
 
 }
@@ -234,6 +240,133 @@ func (c *Client) GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error)
 
 }
 
+// Get multiple validators' balances at the start of an epoch
+func (c *Client) GetValidatorBalances(indices []uint64, epoch uint64) (map[uint64]uint64, error) {
+
+    // Return if no indices defined
+    balances := make(map[uint64]uint64, len(indices))
+    if len(indices) == 0 {
+        return balances, nil
+    }
+
+    // Get state ID
+    stateId, err := c.getEpochStateId(epoch)
+    if err != nil {
+        return map[uint64]uint64{}, err
+    }
+
+    // Load validator balances in batches
+    for bsi := 0; bsi < len(indices); bsi += MaxRequestValidatorsCount {
+
+        // Get batch start & end index
+        vsi := bsi
+        vei := bsi + MaxRequestValidatorsCount
+        if vei > len(indices) { vei = len(indices) }
+
+        // Get validator indices for batch request
+        ids := make([]string, vei - vsi)
+        for vi := vsi; vi < vei; vi++ {
+            ids[vi - vsi] = strconv.FormatUint(indices[vi], 10)
+        }
+
+        // Get & add balances
+        validatorBalances, err := c.getValidatorBalances(stateId, ids)
+        if err != nil {
+            return map[uint64]uint64{}, err
+        }
+        for _, balance := range validatorBalances {
+            balances[uint64(balance.Index)] = uint64(balance.Balance)
+        }
+
+    }
+
+    // Return
+    return balances, nil
+
+}
+
+// Get attestation duties for multiple validators in an epoch
+func (c *Client) GetAttesterDuties(indices []uint64, epoch uint64) ([]beacon.AttesterDuty, error) {
+
+    // Get state ID
+    stateId, err := c.getEpochStateId(epoch)
+    if err != nil {
+        return []beacon.AttesterDuty{}, err
+    }
+
+    // Get committees
+    committees, err := c.getCommittees(stateId, epoch)
+    if err != nil {
+        return []beacon.AttesterDuty{}, err
+    }
+
+    // Build & return duties
+    validatorIndices := getIndexSet(indices)
+    duties := []beacon.AttesterDuty{}
+    for _, committee := range committees {
+        for position, validatorIndex := range committee.Validators {
+            if !validatorIndices[uint64(validatorIndex)] { continue }
+            duties = append(duties, beacon.AttesterDuty{
+                ValidatorIndex:    uint64(validatorIndex),
+                Slot:              uint64(committee.Slot),
+                CommitteeIndex:    uint64(committee.Index),
+                CommitteePosition: uint64(position),
+            })
+        }
+    }
+    return duties, nil
+
+}
+
+// Get block proposal duties for multiple validators in an epoch
+func (c *Client) GetProposerDuties(indices []uint64, epoch uint64) ([]beacon.ProposerDuty, error) {
+
+    // Get proposer duties
+    proposerDuties, err := c.getProposerDuties(epoch)
+    if err != nil {
+        return []beacon.ProposerDuty{}, err
+    }
+
+    // Build & return duties
+    validatorIndices := getIndexSet(indices)
+    duties := []beacon.ProposerDuty{}
+    for _, duty := range proposerDuties {
+        if !validatorIndices[uint64(duty.ValidatorIndex)] { continue }
+        duties = append(duties, beacon.ProposerDuty{
+            ValidatorIndex: uint64(duty.ValidatorIndex),
+            Slot:           uint64(duty.Slot),
+        })
+    }
+    return duties, nil
+
+}
+
+// Get the beacon block at a slot; returns false if no block was proposed
+func (c *Client) GetBeaconBlock(slot uint64) (beacon.BeaconBlock, bool, error) {
+
+    // Get block
+    block, exists, err := c.getBeaconBlock(strconv.FormatUint(slot, 10))
+    if err != nil || !exists {
+        return beacon.BeaconBlock{}, false, err
+    }
+
+    // Build & return block
+    attestations := make([]beacon.Attestation, len(block.Message.Body.Attestations))
+    for ai, attestation := range block.Message.Body.Attestations {
+        attestations[ai] = beacon.Attestation{
+            Slot:            uint64(attestation.Data.Slot),
+            CommitteeIndex:  uint64(attestation.Data.Index),
+            AggregationBits: attestation.AggregationBits,
+        }
+    }
+    return beacon.BeaconBlock{
+        Slot:          uint64(block.Message.Slot),
+        ProposerIndex: uint64(block.Message.ProposerIndex),
+        Attestations:  attestations,
+    }, true, nil
+
+}
+
 // Get domain data for a domain type at a given epoch
 func (c *Client) GetDomainData(domainType []byte, epoch uint64) ([]byte, error) {
 
@@ -291,8 +424,7 @@ func (c *Client) ExitValidator(validatorIndex, epoch uint64, signature types.Val
 func (c *Client) getSyncStatus() (bool, error) {
     var syncStatus bool
     if err := c.client.Call(&syncStatus, RequestSyncStatusMethod); err != nil {
-        message := c.getErrorString(err)
-        return false, fmt.Errorf("Could not get node sync status: %s", message)
+        return false, fmt.Errorf("Could not get node sync status: %w", c.getError(err))
     }
     return syncStatus, nil
 }
@@ -301,8 +433,7 @@ func (c *Client) getSyncStatus() (bool, error) {
 func (c *Client) getEth2Config() (Eth2ConfigResponse, error) {
     var eth2Config Eth2ConfigResponse
     if err := c.client.Call(&eth2Config, RequestEth2ConfigMethod); err != nil {
-        message := c.getErrorString(err)
-        return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %s", message)
+        return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %w", c.getError(err))
     }
     return eth2Config, nil
 }
@@ -311,8 +442,7 @@ func (c *Client) getEth2Config() (Eth2ConfigResponse, error) {
 func (c *Client) getGenesis() (GenesisResponse, error) {
     var genesis GenesisResponse
     if err := c.client.Call(&genesis, RequestGenesisMethod); err != nil {
-        message := c.getErrorString(err)
-        return GenesisResponse{}, fmt.Errorf("Could not get genesis data: %s", message)
+        return GenesisResponse{}, fmt.Errorf("Could not get genesis data: %w", c.getError(err))
     }
     return genesis, nil
 }
@@ -321,8 +451,7 @@ func (c *Client) getGenesis() (GenesisResponse, error) {
 func (c *Client) getFinalityCheckpoints(stateId string) (FinalityCheckpointsResponse, error) {
     var finalityCheckpoints FinalityCheckpointsResponse
     if err := c.client.Call(&finalityCheckpoints, RequestFinalityCheckpointsMethod, stateId); err != nil {
-        message := c.getErrorString(err)
-        return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: %s", message)
+        return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: %w", c.getError(err))
     }
     return finalityCheckpoints, nil
 }
@@ -331,8 +460,7 @@ func (c *Client) getFinalityCheckpoints(stateId string) (FinalityCheckpointsResp
 func (c *Client) getFork(stateId string) (ForkResponse, error) {
     var fork ForkResponse
     if err := c.client.Call(&fork, RequestForkMethod, stateId); err != nil {
-        message := c.getErrorString(err)
-        return ForkResponse{}, fmt.Errorf("Could not get fork data: %s", message)
+        return ForkResponse{}, fmt.Errorf("Could not get fork data: %w", c.getError(err))
     }
     return fork, nil
 }
@@ -341,8 +469,7 @@ func (c *Client) getFork(stateId string) (ForkResponse, error) {
 func (c *Client) getValidators(stateId string, pubkeys []string) ([]Validator, error) {
     var validators []Validator
     if err := c.client.Call(&validators, RequestValidatorsMethod, stateId, pubkeys); err != nil {
-        message := c.getErrorString(err)
-        return []Validator{}, fmt.Errorf("Could not get validators: %s", message)
+        return []Validator{}, fmt.Errorf("Could not get validators: %w", c.getError(err))
     }
     return validators, nil
 }
@@ -395,17 +522,80 @@ func (c *Client) getValidatorsByOpts(pubkeys []types.ValidatorPubkey, opts *beac
 
 }
 
+// Get validator balances
+func (c *Client) getValidatorBalances(stateId string, indices []string) ([]ValidatorBalance, error) {
+    var balances []ValidatorBalance
+    if err := c.client.Call(&balances, RequestValidatorBalancesMethod, stateId, indices); err != nil {
+        return []ValidatorBalance{}, fmt.Errorf("Could not get validator balances: %w", c.getError(err))
+    }
+    return balances, nil
+}
+
+// Get beacon committees for an epoch
+func (c *Client) getCommittees(stateId string, epoch uint64) ([]Committee, error) {
+    var committees []Committee
+    if err := c.client.Call(&committees, RequestCommitteesMethod, stateId, epoch); err != nil {
+        return []Committee{}, fmt.Errorf("Could not get committees for epoch %d: %w", epoch, c.getError(err))
+    }
+    return committees, nil
+}
+
+// Get block proposer duties for an epoch
+func (c *Client) getProposerDuties(epoch uint64) ([]ProposerDuty, error) {
+    var duties []ProposerDuty
+    if err := c.client.Call(&duties, RequestProposerDutiesMethod, epoch); err != nil {
+        return []ProposerDuty{}, fmt.Errorf("Could not get proposer duties for epoch %d: %w", epoch, c.getError(err))
+    }
+    return duties, nil
+}
+
+// Get a beacon block; returns false if the block was not found
+func (c *Client) getBeaconBlock(blockId string) (BeaconBlockResponse, bool, error) {
+    var block BeaconBlockResponse
+    if err := c.client.Call(&block, RequestBeaconBlockMethod, blockId); err != nil {
+        callErr := c.getError(err)
+        if strings.Contains(strings.ToLower(callErr.Error()), "not found") {
+            return BeaconBlockResponse{}, false, nil
+        }
+        return BeaconBlockResponse{}, false, fmt.Errorf("Could not get beacon block %s: %w", blockId, callErr)
+    }
+    return block, true, nil
+}
+
+// Get the state ID for the first slot in an epoch
+func (c *Client) getEpochStateId(epoch uint64) (string, error) {
+    eth2Config, err := c.getEth2Config()
+    if err != nil {
+        return "", err
+    }
+    return strconv.FormatUint(epoch*uint64(eth2Config.SlotsPerEpoch), 10), nil
+}
+
 // Send voluntary exit request
 func (c *Client) postVoluntaryExit(request VoluntaryExitRequest) error {
     if err := c.client.Call(nil, RequestVoluntaryExitMethod, request); err != nil {
-        message := c.getErrorString(err)
-        return fmt.Errorf("Could not broadcast exit for validator at index %d: %s", request.Message.ValidatorIndex, message)
+        return fmt.Errorf("Could not broadcast exit for validator at index %d: %w", request.Message.ValidatorIndex, c.getError(err))
     }
     return nil
 }
 
-// Format an error from Nimbus into a string
-func (c *Client) getErrorString(err error) string {
+// Nimbus RPC call error
+// Reports the formatted Nimbus error message while preserving the underlying RPC client error
+type callError struct {
+    message string
+    err     error
+}
+
+func (e *callError) Error() string {
+    return e.message
+}
+
+func (e *callError) Unwrap() error {
+    return e.err
+}
+
+// Format an error from Nimbus
+func (c *Client) getError(err error) error {
     var message string
 
     // Check if this is a JSON error response
@@ -418,9 +608,27 @@ func (c *Client) getErrorString(err error) string {
         if err, ok := err.(rpc.Error); ok {
             message += " (code " + fmt.Sprintf("%d", err.ErrorCode()) + ")"
         }
-    } else {
-        message = err.Error()
+        return &callError{message: message, err: err}
+    }
+
+    // The RPC client reports unsuccessful HTTP responses by their status text (e.g. "503 Service Unavailable")
+    message = err.Error()
+    if _, isRpcError := err.(rpc.Error); !isRpcError {
+        if fields := strings.Fields(message); len(fields) > 0 {
+            if statusCode, parseErr := strconv.Atoi(fields[0]); parseErr == nil && statusCode >= 100 && statusCode < 600 {
+                return &callError{message: message, err: &beacon.HttpStatusError{StatusCode: statusCode, Body: message}}
+            }
+        }
     }
 
-    return message
+    return &callError{message: message, err: err}
+}
+
+// Get a set of validator indices
+func getIndexSet(indices []uint64) map[uint64]bool {
+    indexSet := make(map[uint64]bool, len(indices))
+    for _, index := range indices {
+        indexSet[index] = true
+    }
+    return indexSet
 }
@@ -60,6 +60,34 @@ type Validator struct {
         WithdrawableEpoch          int64     `json:"withdrawable_epoch"` // Same here
     } `json:"validator"`
 }
+type ValidatorBalance struct {
+    Index   uint64 `json:"index"`
+    Balance uint64 `json:"balance"`
+}
+type Committee struct {
+    Index      uint64   `json:"index"`
+    Slot       uint64   `json:"slot"`
+    Validators []uint64 `json:"validators"`
+}
+type ProposerDuty struct {
+    ValidatorIndex uint64 `json:"validator_index"`
+    Slot           uint64 `json:"slot"`
+}
+type BeaconBlockResponse struct {
+    Message struct {
+        Slot          uint64 `json:"slot"`
+        ProposerIndex uint64 `json:"proposer_index"`
+        Body          struct {
+            Attestations []struct {
+                AggregationBits byteArray `json:"aggregation_bits"`
+                Data            struct {
+                    Slot  uint64 `json:"slot"`
+                    Index uint64 `json:"index"`
+                } `json:"data"`
+            } `json:"attestations"`
+        } `json:"body"`
+    } `json:"message"`
+}
 
 // Unsigned integer type
 type uinteger uint64
@@ -110,6 +110,7 @@ func (c *Client) GetEth2Config() (beacon.Eth2Config, error) {
         GenesisEpoch: genesisEpoch,
         GenesisTime: uint64(genesis.GenesisTime.Seconds),
         SecondsPerEpoch: secondsPerSlot * slotsPerEpoch,
+        SlotsPerEpoch: slotsPerEpoch,
     }, nil
 
 }
@@ -302,6 +303,133 @@ func (c *Client) GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error)
 }
 
 
+// Get multiple validators' balances at the start of an epoch
+func (c *Client) GetValidatorBalances(indices []uint64, epoch uint64) (map[uint64]uint64, error) {
+
+    // Return if no indices defined
+    balances := make(map[uint64]uint64, len(indices))
+    if len(indices) == 0 {
+        return balances, nil
+    }
+
+    // Build validator balances request
+    balancesRequest := &pb.ListValidatorBalancesRequest{
+        QueryFilter: &pb.ListValidatorBalancesRequest_Epoch{Epoch: epoch},
+        Indices: indices,
+    }
+
+    // Load validator balances in pages
+    for {
+
+        // Get & add balances
+        response, err := c.bc.ListValidatorBalances(context.Background(), balancesRequest)
+        if err != nil {
+            return map[uint64]uint64{}, fmt.Errorf("Could not get validator balances: %w", err)
+        }
+        for _, balance := range response.Balances {
+            balances[balance.Index] = balance.Balance
+        }
+
+        // Update request page token; break on last page
+        if response.NextPageToken == "" || response.NextPageToken == "0" { break }
+        balancesRequest.PageToken = response.NextPageToken
+
+    }
+
+    // Return
+    return balances, nil
+
+}
+
+
+// Get attestation duties for multiple validators in an epoch
+func (c *Client) GetAttesterDuties(indices []uint64, epoch uint64) ([]beacon.AttesterDuty, error) {
+
+    // Get validator assignments
+    assignments, err := c.getValidatorAssignments(indices, epoch)
+    if err != nil {
+        return []beacon.AttesterDuty{}, err
+    }
+
+    // Build & return duties
+    duties := []beacon.AttesterDuty{}
+    for _, assignment := range assignments {
+        for position, validatorIndex := range assignment.BeaconCommittees {
+            if validatorIndex != assignment.ValidatorIndex { continue }
+            duties = append(duties, beacon.AttesterDuty{
+                ValidatorIndex: assignment.ValidatorIndex,
+                Slot: assignment.AttesterSlot,
+                CommitteeIndex: assignment.CommitteeIndex,
+                CommitteePosition: uint64(position),
+            })
+            break
+        }
+    }
+    return duties, nil
+
+}
+
+
+// Get block proposal duties for multiple validators in an epoch
+func (c *Client) GetProposerDuties(indices []uint64, epoch uint64) ([]beacon.ProposerDuty, error) {
+
+    // Get validator assignments
+    assignments, err := c.getValidatorAssignments(indices, epoch)
+    if err != nil {
+        return []beacon.ProposerDuty{}, err
+    }
+
+    // Build & return duties
+    duties := []beacon.ProposerDuty{}
+    for _, assignment := range assignments {
+        for _, slot := range assignment.ProposerSlots {
+            duties = append(duties, beacon.ProposerDuty{
+                ValidatorIndex: assignment.ValidatorIndex,
+                Slot: slot,
+            })
+        }
+    }
+    return duties, nil
+
+}
+
+
+// Get the beacon block at a slot; returns false if no block was proposed
+func (c *Client) GetBeaconBlock(slot uint64) (beacon.BeaconBlock, bool, error) {
+
+    // Get blocks at slot
+    response, err := c.bc.ListBlocks(context.Background(), &pb.ListBlocksRequest{
+        QueryFilter: &pb.ListBlocksRequest_Slot{Slot: slot},
+    })
+    if err != nil {
+        return beacon.BeaconBlock{}, false, fmt.Errorf("Could not get beacon block at slot %d: %w", slot, err)
+    }
+    if len(response.BlockContainers) == 0 || response.BlockContainers[0].Block == nil || response.BlockContainers[0].Block.Block == nil {
+        return beacon.BeaconBlock{}, false, nil
+    }
+    block := response.BlockContainers[0].Block.Block
+
+    // Build & return block
+    attestations := []beacon.Attestation{}
+    if block.Body != nil {
+        for _, attestation := range block.Body.Attestations {
+            if attestation.Data == nil { continue }
+            attestations = append(attestations, beacon.Attestation{
+                Slot: attestation.Data.Slot,
+                CommitteeIndex: attestation.Data.CommitteeIndex,
+                AggregationBits: attestation.AggregationBits,
+            })
+        }
+    }
+    return beacon.BeaconBlock{
+        Slot: block.Slot,
+        ProposerIndex: block.ProposerIndex,
+        Attestations: attestations,
+    }, true, nil
+
+}
+
+
 // Get domain data for a domain type at a given epoch
 func (c *Client) GetDomainData(domainType []byte, epoch uint64) ([]byte, error) {
     domainData, err := c.vc.DomainData(context.Background(), &pb.DomainRequest{Domain: domainType, Epoch: epoch})
@@ -335,3 +463,39 @@ func (c *Client) ExitValidator(validatorIndex, epoch uint64, signature types.Val
 
 }
 
+
+// Get committee assignments for multiple validators in an epoch
+func (c *Client) getValidatorAssignments(indices []uint64, epoch uint64) ([]*pb.ValidatorAssignments_CommitteeAssignment, error) {
+
+    // Return if no indices defined
+    if len(indices) == 0 {
+        return []*pb.ValidatorAssignments_CommitteeAssignment{}, nil
+    }
+
+    // Build validator assignments request
+    assignmentsRequest := &pb.ListValidatorAssignmentsRequest{
+        QueryFilter: &pb.ListValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+        Indices: indices,
+    }
+
+    // Load validator assignments in pages
+    assignments := make([]*pb.ValidatorAssignments_CommitteeAssignment, 0, len(indices))
+    for {
+
+        // Get & add assignments
+        response, err := c.bc.ListValidatorAssignments(context.Background(), assignmentsRequest)
+        if err != nil {
+            return []*pb.ValidatorAssignments_CommitteeAssignment{}, fmt.Errorf("Could not get validator assignments for epoch %d: %w", epoch, err)
+        }
+        assignments = append(assignments, response.Assignments...)
+
+        // Update request page token; break on last page
+        if response.NextPageToken == "" || response.NextPageToken == "0" { break }
+        assignmentsRequest.PageToken = response.NextPageToken
+
+    }
+
+    // Return
+    return assignments, nil
+
+}
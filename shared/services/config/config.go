@@ -1,11 +1,15 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/imdario/mergo"
 	"github.com/urfave/cli"
@@ -14,36 +18,109 @@ import (
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 )
 
+// Config
+const DefaultStateFilename = "state.json"
+const DefaultPendingTxsFilename = "pending-txs.json"
+const DefaultApiTokenFilename = "api-token"
+const DefaultTxTimeout = "5m"
+const DefaultNotificationRepeatInterval = "24h"
+const DefaultValidatorBatchSize = 500
+const DefaultValidatorBatchConcurrency = 4
+var GasStrategies = []string{"fixed", "oracle", "basefee"}
+const (
+    LocalValidatorKeystore = "local"
+    RemoteSignerValidatorKeystore = "remote-signer"
+)
+var ValidatorKeystores = []string{LocalValidatorKeystore, RemoteSignerValidatorKeystore}
+// Password providers
+// The prompt provider holds the password in memory only, so each process prompts for it when it starts
+// Commands are run with the password held by the API server, so it requires the API server (the smartnode apiAddress setting)
+const (
+    FilePasswordProvider = "file"
+    PromptPasswordProvider = "prompt"
+    EnvPasswordProvider = "env"
+    FdPasswordProvider = "fd"
+    VaultPasswordProvider = "vault"
+    DefaultPasswordEnv = "ROCKETPOOL_PASSWORD"
+)
+var PasswordProviders = []string{FilePasswordProvider, PromptPasswordProvider, EnvPasswordProvider, FdPasswordProvider, VaultPasswordProvider}
+
+
 // Rocket Pool config
 type RocketPoolConfig struct {
     Rocketpool struct {
         StorageAddress string           `yaml:"storageAddress,omitempty"`
         RPLFaucetAddress string         `yaml:"rplFaucetAddress,omitempty"`
+        RPLPricePairAddress string      `yaml:"rplPricePairAddress,omitempty"`
     }                                   `yaml:"rocketpool,omitempty"`
     Smartnode struct {
         ProjectName string              `yaml:"projectName,omitempty"`
         GraffitiVersion string          `yaml:"graffitiVersion,omitempty"`
         Image string                    `yaml:"image,omitempty"`
         PasswordPath string             `yaml:"passwordPath,omitempty"`
+        PasswordProvider string         `yaml:"passwordProvider,omitempty"`
+        PasswordEnv string              `yaml:"passwordEnv,omitempty"`
+        PasswordFd string               `yaml:"passwordFd,omitempty"`
         WalletPath string               `yaml:"walletPath,omitempty"`
+        StatePath string                `yaml:"statePath,omitempty"`
+        PendingTxsPath string           `yaml:"pendingTxsPath,omitempty"`
         ValidatorKeychainPath string    `yaml:"validatorKeychainPath,omitempty"`
         ValidatorRestartCommand string  `yaml:"validatorRestartCommand,omitempty"`
         GasPrice string                 `yaml:"gasPrice,omitempty"`
         GasLimit string                 `yaml:"gasLimit,omitempty"`
+        GasStrategy string              `yaml:"gasStrategy,omitempty"`
+        GasMultiplier string            `yaml:"gasMultiplier,omitempty"`
+        MaxGasPrice string              `yaml:"maxGasPrice,omitempty"`
+        PriorityFee string              `yaml:"priorityFee,omitempty"`
+        GasLimitMultiplier string       `yaml:"gasLimitMultiplier,omitempty"`
+        TxTimeout string                `yaml:"txTimeout,omitempty"`
+        ValidatorBatchSize string       `yaml:"validatorBatchSize,omitempty"`
+        ValidatorBatchConcurrency string `yaml:"validatorBatchConcurrency,omitempty"`
+        ApiAddress string               `yaml:"apiAddress,omitempty"`
+        ApiUrl string                   `yaml:"apiUrl,omitempty"`
+        ApiTokenPath string             `yaml:"apiTokenPath,omitempty"`
+        ValidatorKeystore string        `yaml:"validatorKeystore,omitempty"`
     }                                   `yaml:"smartnode,omitempty"`
+    PasswordVault struct {
+        Url string                      `yaml:"url,omitempty"`
+        TokenPath string                `yaml:"tokenPath,omitempty"`
+        SecretPath string               `yaml:"secretPath,omitempty"`
+        SecretField string              `yaml:"secretField,omitempty"`
+    }                                   `yaml:"passwordVault,omitempty"`
+    RemoteSigner struct {
+        Url string                      `yaml:"url,omitempty"`
+        TokenPath string                `yaml:"tokenPath,omitempty"`
+    }                                   `yaml:"remoteSigner,omitempty"`
     Chains struct {
         Eth1 Chain                      `yaml:"eth1,omitempty"`
         Eth2 Chain                      `yaml:"eth2,omitempty"`
     }                                   `yaml:"chains,omitempty"`
+    Notifications struct {
+        Webhook struct {
+            Url string                      `yaml:"url,omitempty"`
+            Headers map[string]string       `yaml:"headers,omitempty"`
+        }                               `yaml:"webhook,omitempty"`
+        Smtp struct {
+            Host string                     `yaml:"host,omitempty"`
+            Port string                     `yaml:"port,omitempty"`
+            Username string                 `yaml:"username,omitempty"`
+            Password string                 `yaml:"password,omitempty"`
+            From string                     `yaml:"from,omitempty"`
+            To []string                     `yaml:"to,omitempty"`
+        }                               `yaml:"smtp,omitempty"`
+        RepeatInterval string           `yaml:"repeatInterval,omitempty"`
+    }                                   `yaml:"notifications,omitempty"`
 }
 type Chain struct {
     Provider string                     `yaml:"provider,omitempty"`
+    FallbackProviders []string          `yaml:"fallbackProviders,omitempty"`
     WsProvider string                   `yaml:"wsProvider,omitempty"`
     ChainID string                      `yaml:"chainID,omitempty"`
     Client struct {
         Options []ClientOption          `yaml:"options,omitempty"`
         Selected string                 `yaml:"selected,omitempty"`
         Params []UserParam              `yaml:"params,omitempty"`
+        MigrateFrom string              `yaml:"migrateFrom,omitempty"`
     }                                   `yaml:"client,omitempty"`
 }
 type ClientOption struct {
@@ -55,6 +132,10 @@ type ClientOption struct {
     ValidatorImage string               `yaml:"validatorImage,omitempty"`
     Link string                         `yaml:"link,omitempty"`
     Params []ClientParam                `yaml:"params,omitempty"`
+    SlashingProtection struct {
+        Export string                   `yaml:"export,omitempty"`
+        Import string                   `yaml:"import,omitempty"`
+    }                                   `yaml:"slashingProtection,omitempty"`
 }
 type ClientParam struct {
     Name string                         `yaml:"name,omitempty"`
@@ -81,8 +162,14 @@ func (config *RocketPoolConfig) GetSelectedEth2Client() *ClientOption {
     return config.Chains.Eth2.GetSelectedClient()
 }
 func (chain *Chain) GetSelectedClient() *ClientOption {
+    return chain.GetClient(chain.Client.Selected)
+}
+
+
+// Get a client option by ID
+func (chain *Chain) GetClient(id string) *ClientOption {
     for _, option := range chain.Client.Options {
-        if option.ID == chain.Client.Selected {
+        if option.ID == id {
             return &option
         }
     }
@@ -90,6 +177,21 @@ func (chain *Chain) GetSelectedClient() *ClientOption {
 }
 
 
+// Get the chain providers in priority order; the primary provider is followed by any fallback providers
+func (chain *Chain) GetProviders() []string {
+    providers := []string{}
+    if chain.Provider != "" {
+        providers = append(providers, chain.Provider)
+    }
+    for _, provider := range chain.FallbackProviders {
+        if provider != "" && provider != chain.Provider {
+            providers = append(providers, provider)
+        }
+    }
+    return providers
+}
+
+
 // Get the beacon & validator images for a client
 func (client *ClientOption) GetBeaconImage() string {
     if client.BeaconImage != "" {
@@ -107,6 +209,44 @@ func (client *ClientOption) GetValidatorImage() string {
 }
 
 
+// Check whether slashing protection history can be exported from or imported into a client's validator database
+// The commands are run in the client's validator container, and read or write the interchange file at $INTERCHANGE_FILE,
+// a path relative to the validator keychain folder
+func (client *ClientOption) CanExportSlashingProtection() bool {
+    return client.SlashingProtection.Export != ""
+}
+func (client *ClientOption) CanImportSlashingProtection() bool {
+    return client.SlashingProtection.Import != ""
+}
+
+
+// Get the daemon state file path; defaults to the wallet folder
+func (config *RocketPoolConfig) GetStatePath() string {
+    if config.Smartnode.StatePath != "" {
+        return os.ExpandEnv(config.Smartnode.StatePath)
+    }
+    return filepath.Join(filepath.Dir(os.ExpandEnv(config.Smartnode.WalletPath)), DefaultStateFilename)
+}
+
+
+// Get the pending transactions file path; defaults to the wallet folder
+func (config *RocketPoolConfig) GetPendingTxsPath() string {
+    if config.Smartnode.PendingTxsPath != "" {
+        return os.ExpandEnv(config.Smartnode.PendingTxsPath)
+    }
+    return filepath.Join(filepath.Dir(os.ExpandEnv(config.Smartnode.WalletPath)), DefaultPendingTxsFilename)
+}
+
+
+// Get the API server token file path; defaults to the wallet folder
+func (config *RocketPoolConfig) GetApiTokenPath() string {
+    if config.Smartnode.ApiTokenPath != "" {
+        return os.ExpandEnv(config.Smartnode.ApiTokenPath)
+    }
+    return filepath.Join(filepath.Dir(os.ExpandEnv(config.Smartnode.WalletPath)), DefaultApiTokenFilename)
+}
+
+
 // Serialize a config to yaml bytes
 func (config *RocketPoolConfig) Serialize() ([]byte, error) {
     bytes, err := yaml.Marshal(config)
@@ -283,3 +423,221 @@ func (config *RocketPoolConfig) GetGasLimit() (uint64, error) {
 
 }
 
+
+
+// Parse and return the gas pricing strategy
+func (config *RocketPoolConfig) GetGasStrategy() (string, error) {
+
+    // Default to fixed pricing
+    if config.Smartnode.GasStrategy == "" {
+        return "fixed", nil
+    }
+
+    // Check strategy
+    for _, strategy := range GasStrategies {
+        if config.Smartnode.GasStrategy == strategy {
+            return strategy, nil
+        }
+    }
+    return "", fmt.Errorf("Invalid gas strategy '%s': must be one of %s", config.Smartnode.GasStrategy, strings.Join(GasStrategies, ", "))
+
+}
+
+
+// Parse and return the password provider type
+func (config *RocketPoolConfig) GetPasswordProvider() (string, error) {
+
+    // Default to a password file
+    if config.Smartnode.PasswordProvider == "" {
+        return FilePasswordProvider, nil
+    }
+
+    // Check provider type
+    for _, provider := range PasswordProviders {
+        if config.Smartnode.PasswordProvider == provider {
+            if provider == VaultPasswordProvider && (config.PasswordVault.Url == "" || config.PasswordVault.SecretPath == "") {
+                return "", errors.New("A vault URL and secret path are required to use the vault password provider")
+            }
+            if provider == PromptPasswordProvider && config.Smartnode.ApiAddress == "" {
+                return "", errors.New("The prompt password provider holds the password in memory only, so the API server must be enabled with the smartnode apiAddress setting to use it")
+            }
+            return provider, nil
+        }
+    }
+    return "", fmt.Errorf("Invalid password provider '%s': must be one of %s", config.Smartnode.PasswordProvider, strings.Join(PasswordProviders, ", "))
+
+}
+
+
+// Get the name of the environment variable holding the password
+func (config *RocketPoolConfig) GetPasswordEnv() string {
+    if config.Smartnode.PasswordEnv == "" {
+        return DefaultPasswordEnv
+    }
+    return config.Smartnode.PasswordEnv
+}
+
+
+// Parse and return the file descriptor the password is read from
+func (config *RocketPoolConfig) GetPasswordFd() (uintptr, error) {
+    if config.Smartnode.PasswordFd == "" {
+        return 0, errors.New("A password file descriptor is required to use the fd password provider")
+    }
+    fd, err := strconv.ParseUint(config.Smartnode.PasswordFd, 10, 32)
+    if err != nil {
+        return 0, fmt.Errorf("Invalid password file descriptor '%s': %w", config.Smartnode.PasswordFd, err)
+    }
+    return uintptr(fd), nil
+}
+
+
+// Parse and return the validator keystore type
+func (config *RocketPoolConfig) GetValidatorKeystore() (string, error) {
+
+    // Default to local keystores
+    if config.Smartnode.ValidatorKeystore == "" {
+        return LocalValidatorKeystore, nil
+    }
+
+    // Check keystore type
+    for _, keystore := range ValidatorKeystores {
+        if config.Smartnode.ValidatorKeystore == keystore {
+            if keystore == RemoteSignerValidatorKeystore && config.RemoteSigner.Url == "" {
+                return "", errors.New("A remote signer URL is required to use the remote signer validator keystore")
+            }
+            return keystore, nil
+        }
+    }
+    return "", fmt.Errorf("Invalid validator keystore '%s': must be one of %s", config.Smartnode.ValidatorKeystore, strings.Join(ValidatorKeystores, ", "))
+
+}
+
+
+// Check whether validator keys are held by a remote signer
+func (config *RocketPoolConfig) UsesRemoteSigner() bool {
+    keystore, err := config.GetValidatorKeystore()
+    return err == nil && keystore == RemoteSignerValidatorKeystore
+}
+
+
+// Parse and return the gas price multiplier
+func (config *RocketPoolConfig) GetGasMultiplier() (float64, error) {
+    return parsePositiveFloat("gas multiplier", config.Smartnode.GasMultiplier, 1)
+}
+
+
+// Parse and return the maximum gas price in wei
+func (config *RocketPoolConfig) GetMaxGasPrice() (*big.Int, error) {
+    return parseGwei("maximum gas price", config.Smartnode.MaxGasPrice)
+}
+
+
+// Parse and return the priority fee added to the base fee by the basefee gas strategy, in wei
+func (config *RocketPoolConfig) GetPriorityFee() (*big.Int, error) {
+    return parseGwei("priority fee", config.Smartnode.PriorityFee)
+}
+
+
+// Parse and return the multiplier applied to gas limit estimates
+// Gas limits are only estimated if a multiplier is set; it must be at least 1
+func (config *RocketPoolConfig) GetGasLimitMultiplier() (float64, error) {
+    multiplier, err := parsePositiveFloat("gas limit multiplier", config.Smartnode.GasLimitMultiplier, 0)
+    if err != nil {
+        return 0, err
+    }
+    if config.Smartnode.GasLimitMultiplier != "" && multiplier < 1 {
+        return 0, fmt.Errorf("Invalid gas limit multiplier '%s': must be at least 1", config.Smartnode.GasLimitMultiplier)
+    }
+    return multiplier, nil
+}
+
+
+// Parse and return the time to wait for a transaction to be mined before it is re-broadcast
+func (config *RocketPoolConfig) GetTxTimeout() (time.Duration, error) {
+    value := config.Smartnode.TxTimeout
+    if value == "" {
+        value = DefaultTxTimeout
+    }
+    timeout, err := time.ParseDuration(value)
+    if err != nil {
+        return 0, fmt.Errorf("Invalid transaction timeout '%s': %w", value, err)
+    }
+    if timeout <= 0 {
+        return 0, fmt.Errorf("Invalid transaction timeout '%s': must be greater than zero", value)
+    }
+    return timeout, nil
+}
+
+
+// Parse and return the interval after which a persistent notification condition is notified again
+func (config *RocketPoolConfig) GetNotificationRepeatInterval() (time.Duration, error) {
+    value := config.Notifications.RepeatInterval
+    if value == "" {
+        value = DefaultNotificationRepeatInterval
+    }
+    interval, err := time.ParseDuration(value)
+    if err != nil {
+        return 0, fmt.Errorf("Invalid notification repeat interval '%s': %w", value, err)
+    }
+    return interval, nil
+}
+
+
+// Parse and return the number of validators to request from the beacon node at once
+func (config *RocketPoolConfig) GetValidatorBatchSize() (int, error) {
+    return parsePositiveInt("validator batch size", config.Smartnode.ValidatorBatchSize, DefaultValidatorBatchSize)
+}
+
+
+// Parse and return the number of validator batches to request from the beacon node concurrently
+func (config *RocketPoolConfig) GetValidatorBatchConcurrency() (int, error) {
+    return parsePositiveInt("validator batch concurrency", config.Smartnode.ValidatorBatchConcurrency, DefaultValidatorBatchConcurrency)
+}
+
+
+// Parse a positive integer value, or return a default if not set
+func parsePositiveInt(name, value string, defaultValue int) (int, error) {
+    if value == "" {
+        return defaultValue, nil
+    }
+    parsed, err := strconv.Atoi(value)
+    if err != nil {
+        return 0, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+    }
+    if parsed <= 0 {
+        return 0, fmt.Errorf("Invalid %s '%s': must be greater than zero", name, value)
+    }
+    return parsed, nil
+}
+
+
+// Parse a positive float value, or return a default if not set
+func parsePositiveFloat(name, value string, defaultValue float64) (float64, error) {
+    if value == "" {
+        return defaultValue, nil
+    }
+    parsed, err := strconv.ParseFloat(value, 64)
+    if err != nil {
+        return 0, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+    }
+    if parsed <= 0 {
+        return 0, fmt.Errorf("Invalid %s '%s': must be greater than zero", name, value)
+    }
+    return parsed, nil
+}
+
+
+// Parse a gwei value and return it in wei, or nil if not set
+func parseGwei(name, value string) (*big.Int, error) {
+    if value == "" {
+        return nil, nil
+    }
+    parsed, err := strconv.ParseFloat(value, 64)
+    if err != nil {
+        return nil, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+    }
+    if parsed <= 0 {
+        return nil, nil
+    }
+    return eth.GweiToWei(parsed), nil
+}
@@ -0,0 +1,68 @@
+package contracts
+
+import (
+    "fmt"
+    "math/big"
+    "strings"
+
+    "github.com/ethereum/go-ethereum/accounts/abi"
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+)
+
+
+// UniswapPairABI is the subset of the Uniswap V2 pair ABI used to read token reserves
+const UniswapPairABI = `[{"constant":true,"inputs":[],"name":"getReserves","outputs":[{"name":"_reserve0","type":"uint112"},{"name":"_reserve1","type":"uint112"},{"name":"_blockTimestampLast","type":"uint32"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[],"name":"token0","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[],"name":"token1","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"}]`
+
+
+// Uniswap V2 style token pair
+type UniswapPair struct {
+    Address common.Address
+    contract *bind.BoundContract
+}
+
+
+// Uniswap pair reserves
+type UniswapPairReserves struct {
+    Reserve0 *big.Int
+    Reserve1 *big.Int
+}
+
+
+// Create new Uniswap pair binding
+func NewUniswapPair(address common.Address, caller bind.ContractCaller) (*UniswapPair, error) {
+    pairAbi, err := abi.JSON(strings.NewReader(UniswapPairABI))
+    if err != nil {
+        return nil, fmt.Errorf("Could not parse Uniswap pair ABI: %w", err)
+    }
+    return &UniswapPair{
+        Address: address,
+        contract: bind.NewBoundContract(address, pairAbi, caller, nil, nil),
+    }, nil
+}
+
+
+// Get the pair token addresses
+func (p *UniswapPair) GetTokens(opts *bind.CallOpts) (common.Address, common.Address, error) {
+    var token0, token1 []interface{}
+    if err := p.contract.Call(opts, &token0, "token0"); err != nil {
+        return common.Address{}, common.Address{}, fmt.Errorf("Could not get pair token0: %w", err)
+    }
+    if err := p.contract.Call(opts, &token1, "token1"); err != nil {
+        return common.Address{}, common.Address{}, fmt.Errorf("Could not get pair token1: %w", err)
+    }
+    return *abi.ConvertType(token0[0], new(common.Address)).(*common.Address), *abi.ConvertType(token1[0], new(common.Address)).(*common.Address), nil
+}
+
+
+// Get the pair token reserves
+func (p *UniswapPair) GetReserves(opts *bind.CallOpts) (UniswapPairReserves, error) {
+    var reserves []interface{}
+    if err := p.contract.Call(opts, &reserves, "getReserves"); err != nil {
+        return UniswapPairReserves{}, fmt.Errorf("Could not get pair reserves: %w", err)
+    }
+    return UniswapPairReserves{
+        Reserve0: *abi.ConvertType(reserves[0], new(*big.Int)).(**big.Int),
+        Reserve1: *abi.ConvertType(reserves[1], new(*big.Int)).(**big.Int),
+    }, nil
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+    "fmt"
+    "net"
+    "net/http"
+    "sync"
+    "time"
+
+    gethmetrics "github.com/ethereum/go-ethereum/metrics"
+    "github.com/ethereum/go-ethereum/metrics/prometheus"
+)
+
+
+// Config
+const (
+    MetricsPath = "/metrics"
+    MetricsPrefix = "rocketpool/"
+)
+
+
+// Metrics registry
+var registry = gethmetrics.NewRegistry()
+var startLock sync.Mutex
+var started bool
+
+
+// Start the metrics HTTP server
+// Metrics are only recorded once the server has been started
+func Start(address string) error {
+    startLock.Lock()
+    defer startLock.Unlock()
+
+    // Check server state
+    if started {
+        return fmt.Errorf("The metrics server has already been started")
+    }
+
+    // Enable metrics collection
+    // This must be set before any metrics are created, or they will be created as no-ops
+    gethmetrics.Enabled = true
+
+    // Listen on address
+    listener, err := net.Listen("tcp", address)
+    if err != nil {
+        return fmt.Errorf("Could not start metrics server on %s: %w", address, err)
+    }
+
+    // Initialize handler
+    mux := http.NewServeMux()
+    mux.Handle(MetricsPath, prometheus.Handler(registry))
+
+    // Serve metrics
+    go http.Serve(listener, mux)
+    started = true
+
+    // Return
+    return nil
+
+}
+
+
+// Set a gauge value
+func SetGauge(name string, value int64) {
+    if !gethmetrics.Enabled { return }
+    gethmetrics.GetOrRegisterGauge(MetricsPrefix + name, registry).Update(value)
+}
+
+
+// Set a floating point gauge value
+func SetGaugeFloat(name string, value float64) {
+    if !gethmetrics.Enabled { return }
+    gethmetrics.GetOrRegisterGaugeFloat64(MetricsPrefix + name, registry).Update(value)
+}
+
+
+// Set a boolean gauge value
+func SetGaugeBool(name string, value bool) {
+    if value {
+        SetGauge(name, 1)
+    } else {
+        SetGauge(name, 0)
+    }
+}
+
+
+// Increment a counter
+func IncCounter(name string, amount int64) {
+    if !gethmetrics.Enabled { return }
+    gethmetrics.GetOrRegisterCounter(MetricsPrefix + name, registry).Inc(amount)
+}
+
+
+// Record a timer duration
+func UpdateTimer(name string, duration time.Duration) {
+    if !gethmetrics.Enabled { return }
+    gethmetrics.GetOrRegisterTimer(MetricsPrefix + name, registry).Update(duration)
+}
+
+
+// Record a completed daemon task run
+func RecordTaskRun(name string, duration time.Duration, err error) {
+    UpdateTimer(fmt.Sprintf("task/%s/duration", name), duration)
+    IncCounter(fmt.Sprintf("task/%s/runs", name), 1)
+    if err != nil {
+        IncCounter(fmt.Sprintf("task/%s/errors", name), 1)
+    } else {
+        SetGauge(fmt.Sprintf("task/%s/last_success", name), time.Now().Unix())
+    }
+}
@@ -0,0 +1,259 @@
+package notifier
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "net"
+    "net/http"
+    "net/smtp"
+    "strings"
+    "sync"
+    "time"
+)
+
+
+// Config
+const (
+    WebhookTimeout = 10 * time.Second
+    TaskFailureThreshold = 3
+)
+
+
+// Event types
+const (
+    MinipoolDissolved = "minipoolDissolved"
+    ChallengeRaised = "challengeRaised"
+    ValidatorSlashed = "validatorSlashed"
+    RplStakeLow = "rplStakeLow"
+    TaskFailing = "taskFailing"
+    StakeCheckFailed = "stakeCheckFailed"
+    TransactionStuck = "transactionStuck"
+)
+
+
+// Notifier settings
+type Settings struct {
+    WebhookUrl string
+    WebhookHeaders map[string]string
+    SmtpHost string
+    SmtpPort string
+    SmtpUsername string
+    SmtpPassword string
+    SmtpFrom string
+    SmtpTo []string
+    RepeatInterval time.Duration
+}
+
+
+// Node event notification
+// Events with the same key describe the same condition, and are only sent once per repeat interval until the condition is resolved
+type Event struct {
+    Type string                         `json:"type"`
+    Key string                          `json:"key"`
+    Title string                        `json:"title"`
+    Message string                      `json:"message"`
+    Node string                         `json:"node,omitempty"`
+    Time int64                          `json:"time"`
+}
+
+
+// Notifier
+// Publishes node events to a webhook and/or by email
+type Notifier struct {
+    settings Settings
+    client *http.Client
+    sent map[string]time.Time
+    taskFailures map[string]int
+    lock sync.Mutex
+}
+
+
+// Create new notifier
+func NewNotifier(settings Settings) *Notifier {
+    return &Notifier{
+        settings: settings,
+        client: &http.Client{Timeout: WebhookTimeout},
+        sent: make(map[string]time.Time),
+        taskFailures: make(map[string]int),
+    }
+}
+
+
+// Check whether any notification channels are configured
+func (n *Notifier) Enabled() bool {
+    return n.webhookEnabled() || n.smtpEnabled()
+}
+
+
+// Publish an event
+// The event is skipped if its condition was already notified within the repeat interval and has not been resolved
+func (n *Notifier) Notify(event Event) error {
+
+    // Check notification channels
+    if !n.Enabled() {
+        return nil
+    }
+
+    // Check for a recent notification of the condition
+    n.lock.Lock()
+    lastSent, notified := n.sent[event.Key]
+    if notified && (n.settings.RepeatInterval <= 0 || time.Since(lastSent) < n.settings.RepeatInterval) {
+        n.lock.Unlock()
+        return nil
+    }
+    n.sent[event.Key] = time.Now()
+    n.lock.Unlock()
+
+    // Set event time
+    if event.Time == 0 {
+        event.Time = time.Now().Unix()
+    }
+
+    // Send notifications
+    var errs []string
+    if n.webhookEnabled() {
+        if err := n.sendWebhook(event); err != nil {
+            errs = append(errs, err.Error())
+        }
+    }
+    if n.smtpEnabled() {
+        if err := n.sendEmail(event); err != nil {
+            errs = append(errs, err.Error())
+        }
+    }
+
+    // Allow failed notifications to be retried
+    if len(errs) > 0 {
+        n.Resolve(event.Key)
+        return fmt.Errorf("Could not send %s notification: %s", event.Type, strings.Join(errs, "; "))
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Resolve an event condition, so that it is notified again if it recurs
+func (n *Notifier) Resolve(key string) {
+    n.lock.Lock()
+    defer n.lock.Unlock()
+    delete(n.sent, key)
+}
+
+
+// Record a completed daemon task run, notifying when the task fails repeatedly
+func (n *Notifier) RecordTaskRun(name string, duration time.Duration, err error) {
+
+    // Get consecutive failure count
+    key := fmt.Sprintf("%s/%s", TaskFailing, name)
+    n.lock.Lock()
+    if err == nil {
+        n.taskFailures[name] = 0
+    } else {
+        n.taskFailures[name]++
+    }
+    failures := n.taskFailures[name]
+    n.lock.Unlock()
+
+    // Resolve on success
+    if err == nil {
+        n.Resolve(key)
+        return
+    }
+
+    // Notify
+    if failures < TaskFailureThreshold {
+        return
+    }
+    n.Notify(Event{
+        Type: TaskFailing,
+        Key: key,
+        Title: fmt.Sprintf("Task %s is failing", name),
+        Message: fmt.Sprintf("The %s task has failed %d times in a row. Latest error: %s", name, failures, err.Error()),
+    })
+
+}
+
+
+// Check whether the webhook channel is configured
+func (n *Notifier) webhookEnabled() bool {
+    return n.settings.WebhookUrl != ""
+}
+
+
+// Check whether the email channel is configured
+func (n *Notifier) smtpEnabled() bool {
+    return n.settings.SmtpHost != "" && n.settings.SmtpFrom != "" && len(n.settings.SmtpTo) > 0
+}
+
+
+// Post an event to the webhook as JSON
+func (n *Notifier) sendWebhook(event Event) error {
+
+    // Encode event
+    body, err := json.Marshal(event)
+    if err != nil {
+        return fmt.Errorf("Could not encode webhook payload: %w", err)
+    }
+
+    // Create request
+    request, err := http.NewRequest(http.MethodPost, n.settings.WebhookUrl, bytes.NewReader(body))
+    if err != nil {
+        return fmt.Errorf("Could not create webhook request: %w", err)
+    }
+    request.Header.Set("Content-Type", "application/json")
+    for name, value := range n.settings.WebhookHeaders {
+        request.Header.Set(name, value)
+    }
+
+    // Send request
+    response, err := n.client.Do(request)
+    if err != nil {
+        return fmt.Errorf("Could not send webhook request: %w", err)
+    }
+    defer response.Body.Close()
+    if response.StatusCode < 200 || response.StatusCode >= 300 {
+        return fmt.Errorf("Webhook request failed with code %d", response.StatusCode)
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Send an event by email
+func (n *Notifier) sendEmail(event Event) error {
+
+    // Get server address & authentication
+    port := n.settings.SmtpPort
+    if port == "" {
+        port = "587"
+    }
+    address := net.JoinHostPort(n.settings.SmtpHost, port)
+    var auth smtp.Auth
+    if n.settings.SmtpUsername != "" {
+        auth = smtp.PlainAuth("", n.settings.SmtpUsername, n.settings.SmtpPassword, n.settings.SmtpHost)
+    }
+
+    // Build message
+    var message bytes.Buffer
+    fmt.Fprintf(&message, "From: %s\r\n", n.settings.SmtpFrom)
+    fmt.Fprintf(&message, "To: %s\r\n", strings.Join(n.settings.SmtpTo, ", "))
+    fmt.Fprintf(&message, "Subject: [Rocket Pool] %s\r\n", event.Title)
+    fmt.Fprintf(&message, "Date: %s\r\n", time.Unix(event.Time, 0).Format(time.RFC1123Z))
+    fmt.Fprintf(&message, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+    fmt.Fprintf(&message, "%s\r\n", event.Message)
+    if event.Node != "" {
+        fmt.Fprintf(&message, "\r\nNode: %s\r\n", event.Node)
+    }
+
+    // Send message
+    if err := smtp.SendMail(address, auth, n.settings.SmtpFrom, n.settings.SmtpTo, message.Bytes()); err != nil {
+        return fmt.Errorf("Could not send email: %w", err)
+    }
+    return nil
+
+}
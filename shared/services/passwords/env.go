@@ -0,0 +1,122 @@
+package passwords
+
+import (
+    "fmt"
+    "io/ioutil"
+    "os"
+    "strings"
+    "sync"
+)
+
+
+// Environment variable password provider
+// Reads the password from an environment variable set by the process supervisor
+type EnvProvider struct {
+    variable string
+}
+
+
+// Create new environment variable password provider
+func NewEnvProvider(variable string) *EnvProvider {
+    return &EnvProvider{
+        variable: variable,
+    }
+}
+
+
+// Check if the environment variable is set
+func (p *EnvProvider) IsPasswordSet() (bool, error) {
+    return (os.Getenv(p.variable) != ""), nil
+}
+
+
+// Read the password from the environment variable
+func (p *EnvProvider) GetPassword() (string, error) {
+    password := os.Getenv(p.variable)
+    if password == "" {
+        return "", fmt.Errorf("The password environment variable %s is not set", p.variable)
+    }
+    return password, nil
+}
+
+
+// The password can only be set by the process supervisor
+func (p *EnvProvider) SetPassword(password string) error {
+    return fmt.Errorf("The password is read from the %s environment variable and cannot be set here - set the variable and restart the Rocket Pool service", p.variable)
+}
+
+
+// The password can only be set by the process supervisor
+func (p *EnvProvider) IsReadOnly() bool {
+    return true
+}
+
+
+// File descriptor password provider
+// Reads the password once from an inherited file descriptor (e.g. a pipe from the process supervisor) and holds it in memory
+type FdProvider struct {
+    fd uintptr
+    password string
+    err error
+    readOnce sync.Once
+}
+
+
+// Create new file descriptor password provider
+func NewFdProvider(fd uintptr) *FdProvider {
+    return &FdProvider{
+        fd: fd,
+    }
+}
+
+
+// Check if a password was read from the file descriptor
+func (p *FdProvider) IsPasswordSet() (bool, error) {
+    password, err := p.readPassword()
+    return (password != ""), err
+}
+
+
+// Get the password read from the file descriptor
+func (p *FdProvider) GetPassword() (string, error) {
+    password, err := p.readPassword()
+    if err != nil {
+        return "", err
+    }
+    if password == "" {
+        return "", fmt.Errorf("No password was provided on file descriptor %d", p.fd)
+    }
+    return password, nil
+}
+
+
+// Read the password from the file descriptor on first use
+func (p *FdProvider) readPassword() (string, error) {
+    p.readOnce.Do(func() {
+        file := os.NewFile(p.fd, "password")
+        if file == nil {
+            p.err = fmt.Errorf("Invalid password file descriptor %d", p.fd)
+            return
+        }
+        defer file.Close()
+        bytes, err := ioutil.ReadAll(file)
+        if err != nil {
+            p.err = fmt.Errorf("Could not read password from file descriptor %d: %w", p.fd, err)
+            return
+        }
+        p.password = strings.TrimRight(string(bytes), "\r\n")
+    })
+    return p.password, p.err
+}
+
+
+// The password can only be set by the process supervisor
+func (p *FdProvider) SetPassword(password string) error {
+    return fmt.Errorf("The password is read from file descriptor %d and cannot be set here - provide it on the descriptor and restart the Rocket Pool service", p.fd)
+}
+
+
+// The password can only be set by the process supervisor
+func (p *FdProvider) IsReadOnly() bool {
+    return true
+}
@@ -0,0 +1,64 @@
+package passwords
+
+import (
+    "fmt"
+    "io/ioutil"
+    "os"
+)
+
+
+// File password provider
+// Stores the password as a plaintext file
+type FileProvider struct {
+    passwordPath string
+}
+
+
+// Create new file password provider
+func NewFileProvider(passwordPath string) *FileProvider {
+    return &FileProvider{
+        passwordPath: passwordPath,
+    }
+}
+
+
+// Check if the password file exists
+func (p *FileProvider) IsPasswordSet() (bool, error) {
+    _, err := ioutil.ReadFile(p.passwordPath)
+    if os.IsNotExist(err) {
+        return false, nil
+    } else if err != nil {
+        return false, fmt.Errorf("Could not read password from disk: %w", err)
+    }
+    return true, nil
+}
+
+
+// Read the password from disk
+func (p *FileProvider) GetPassword() (string, error) {
+    password, err := ioutil.ReadFile(p.passwordPath)
+    if err != nil {
+        return "", fmt.Errorf("Could not read password from disk: %w", err)
+    }
+    return string(password), nil
+}
+
+
+// Write the password to disk
+// The password is written to a temporary file and moved into place, so that a partial write never replaces it
+func (p *FileProvider) SetPassword(password string) error {
+    tmpPath := p.passwordPath + ".tmp"
+    if err := ioutil.WriteFile(tmpPath, []byte(password), FileMode); err != nil {
+        return fmt.Errorf("Could not write password to disk: %w", err)
+    }
+    if err := os.Rename(tmpPath, p.passwordPath); err != nil {
+        return fmt.Errorf("Could not write password to disk: %w", err)
+    }
+    return nil
+}
+
+
+// The password file can be changed
+func (p *FileProvider) IsReadOnly() bool {
+    return false
+}
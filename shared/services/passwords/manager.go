@@ -3,7 +3,6 @@ package passwords
 import (
     "errors"
     "fmt"
-    "io/ioutil"
 )
 
 
@@ -14,39 +13,59 @@ const (
 )
 
 
+// Password provider interface
+// Providers store and retrieve the node password; the password manager validates it before it is set
+// IsPasswordSet returns an error if the provider can't determine whether the password is set (e.g. its store is unavailable)
+// Read-only providers get the password from outside of the node (e.g. from the process supervisor), so it can't be changed
+type Provider interface {
+    IsPasswordSet() (bool, error)
+    GetPassword() (string, error)
+    SetPassword(password string) error
+    IsReadOnly() bool
+}
+
+
 // Password manager
 type PasswordManager struct {
-    passwordPath string
+    provider Provider
 }
 
 
-// Create new password manager
+// Create new password manager using a password file
 func NewPasswordManager(passwordPath string) *PasswordManager {
+    return NewPasswordManagerWithProvider(NewFileProvider(passwordPath))
+}
+
+
+// Create new password manager using a password provider
+func NewPasswordManagerWithProvider(provider Provider) *PasswordManager {
     return &PasswordManager{
-        passwordPath: passwordPath,
+        provider: provider,
     }
 }
 
 
+// Get the password provider
+func (pm *PasswordManager) GetProvider() Provider {
+    return pm.provider
+}
+
+
 // Check if the password has been set
-func (pm *PasswordManager) IsPasswordSet() bool {
-    _, err := ioutil.ReadFile(pm.passwordPath)
-    return (err == nil)
+func (pm *PasswordManager) IsPasswordSet() (bool, error) {
+    return pm.provider.IsPasswordSet()
 }
 
 
 // Get the password
 func (pm *PasswordManager) GetPassword() (string, error) {
+    return pm.provider.GetPassword()
+}
 
-    // Read from disk
-    password, err := ioutil.ReadFile(pm.passwordPath)
-    if err != nil {
-        return "", fmt.Errorf("Could not read password from disk: %w", err)
-    }
-
-    // Return
-    return string(password), nil
 
+// Check if the password can't be changed
+func (pm *PasswordManager) IsReadOnly() bool {
+    return pm.provider.IsReadOnly()
 }
 
 
@@ -54,7 +73,11 @@ func (pm *PasswordManager) GetPassword() (string, error) {
 func (pm *PasswordManager) SetPassword(password string) error {
 
     // Check password is not set
-    if pm.IsPasswordSet() {
+    passwordSet, err := pm.IsPasswordSet()
+    if err != nil {
+        return err
+    }
+    if passwordSet {
         return errors.New("Password is already set")
     }
 
@@ -63,13 +86,35 @@ func (pm *PasswordManager) SetPassword(password string) error {
         return fmt.Errorf("Password must be at least %d characters long", MinPasswordLength)
     }
 
-    // Write to disk
-    if err := ioutil.WriteFile(pm.passwordPath, []byte(password), FileMode); err != nil {
-        return fmt.Errorf("Could not write password to disk: %w", err)
+    // Set password
+    return pm.provider.SetPassword(password)
+
+}
+
+
+// Change the password
+func (pm *PasswordManager) ChangePassword(password string) error {
+
+    // Check password is set
+    passwordSet, err := pm.IsPasswordSet()
+    if err != nil {
+        return err
+    }
+    if !passwordSet {
+        return errors.New("Password is not set")
     }
 
-    // Return
-    return nil
+    // Check password can be changed
+    if pm.IsReadOnly() {
+        return errors.New("The password is provided from outside of the node and cannot be changed here")
+    }
 
-}
+    // Check password length
+    if len(password) < MinPasswordLength {
+        return fmt.Errorf("Password must be at least %d characters long", MinPasswordLength)
+    }
+
+    // Set password
+    return pm.provider.SetPassword(password)
 
+}
@@ -0,0 +1,54 @@
+package passwords
+
+import (
+    "errors"
+    "sync"
+)
+
+
+// Memory password provider
+// Holds the password in memory only, so it must be entered each time the process starts
+type MemoryProvider struct {
+    password string
+    lock sync.RWMutex
+}
+
+
+// Create new memory password provider
+func NewMemoryProvider() *MemoryProvider {
+    return &MemoryProvider{}
+}
+
+
+// Check if the password has been entered
+func (p *MemoryProvider) IsPasswordSet() (bool, error) {
+    p.lock.RLock()
+    defer p.lock.RUnlock()
+    return (p.password != ""), nil
+}
+
+
+// Get the password
+func (p *MemoryProvider) GetPassword() (string, error) {
+    p.lock.RLock()
+    defer p.lock.RUnlock()
+    if p.password == "" {
+        return "", errors.New("The password has not been entered since the process started")
+    }
+    return p.password, nil
+}
+
+
+// Hold the password in memory
+func (p *MemoryProvider) SetPassword(password string) error {
+    p.lock.Lock()
+    defer p.lock.Unlock()
+    p.password = password
+    return nil
+}
+
+
+// The password held in memory can be changed
+func (p *MemoryProvider) IsReadOnly() bool {
+    return false
+}
@@ -0,0 +1,194 @@
+package passwords
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "net/http"
+    "strings"
+    "time"
+)
+
+
+// Config
+const (
+    VaultRequestTimeout = 30 * time.Second
+    VaultTokenHeader = "X-Vault-Token"
+    DefaultVaultSecretField = "password"
+)
+
+
+// Vault password provider
+// Fetches the password from a HashiCorp Vault style key/value secret store over HTTP
+// Secret paths containing a "/data/" segment are treated as version 2 key/value secrets, with their fields nested under "data"
+type VaultProvider struct {
+    url string
+    tokenPath string
+    secretPath string
+    secretField string
+    client *http.Client
+}
+
+
+// Create new vault password provider
+// The token path is optional; if set, its contents are sent as the vault token with each request
+func NewVaultProvider(url, tokenPath, secretPath, secretField string) *VaultProvider {
+    if secretField == "" {
+        secretField = DefaultVaultSecretField
+    }
+    return &VaultProvider{
+        url: strings.TrimSuffix(url, "/"),
+        tokenPath: tokenPath,
+        secretPath: strings.Trim(secretPath, "/"),
+        secretField: secretField,
+        client: &http.Client{Timeout: VaultRequestTimeout},
+    }
+}
+
+
+// Check if the password is stored in the secret
+// Returns an error if the vault can't be reached or responds with an error, rather than reporting the password as not set
+func (p *VaultProvider) IsPasswordSet() (bool, error) {
+    password, err := p.fetchPassword()
+    return (password != ""), err
+}
+
+
+// Fetch the password from the secret
+func (p *VaultProvider) GetPassword() (string, error) {
+    password, err := p.fetchPassword()
+    if err != nil {
+        return "", err
+    }
+    if password == "" {
+        return "", fmt.Errorf("Vault secret %s has no '%s' field", p.secretPath, p.secretField)
+    }
+    return password, nil
+}
+
+
+// Fetch the password from the secret
+// Returns an empty password if the secret or its password field does not exist
+func (p *VaultProvider) fetchPassword() (string, error) {
+
+    // Get secret
+    responseBody, status, err := p.request(http.MethodGet, nil)
+    if err != nil {
+        return "", fmt.Errorf("Could not get password from vault: %w", err)
+    } else if status == http.StatusNotFound {
+        return "", nil
+    } else if status != http.StatusOK {
+        return "", fmt.Errorf("Could not get password from vault: HTTP status %d; response body: '%s'", status, string(responseBody))
+    }
+
+    // Decode secret
+    var secret struct {
+        Data json.RawMessage `json:"data"`
+    }
+    if err := json.Unmarshal(responseBody, &secret); err != nil {
+        return "", fmt.Errorf("Could not decode vault secret: %w", err)
+    }
+    if p.isVersion2() {
+        var data struct {
+            Data json.RawMessage `json:"data"`
+        }
+        if err := json.Unmarshal(secret.Data, &data); err != nil {
+            return "", fmt.Errorf("Could not decode vault secret: %w", err)
+        }
+        secret.Data = data.Data
+    }
+    var fields map[string]interface{}
+    if err := json.Unmarshal(secret.Data, &fields); err != nil {
+        return "", fmt.Errorf("Could not decode vault secret: %w", err)
+    }
+
+    // Get password
+    password, _ := fields[p.secretField].(string)
+    return password, nil
+
+}
+
+
+// Write the password to the secret
+func (p *VaultProvider) SetPassword(password string) error {
+
+    // Build secret
+    var secret interface{} = map[string]string{p.secretField: password}
+    if p.isVersion2() {
+        secret = map[string]interface{}{"data": secret}
+    }
+
+    // Write secret
+    responseBody, status, err := p.request(http.MethodPost, secret)
+    if err != nil {
+        return fmt.Errorf("Could not write password to vault: %w", err)
+    } else if status != http.StatusOK && status != http.StatusNoContent {
+        return fmt.Errorf("Could not write password to vault: HTTP status %d; response body: '%s'", status, string(responseBody))
+    }
+    return nil
+
+}
+
+
+// The password can be changed in the vault
+func (p *VaultProvider) IsReadOnly() bool {
+    return false
+}
+
+
+// Check whether the secret is a version 2 key/value secret
+func (p *VaultProvider) isVersion2() bool {
+    return strings.Contains("/" + p.secretPath + "/", "/data/")
+}
+
+
+// Make a request to the vault secret
+func (p *VaultProvider) request(method string, requestBody interface{}) ([]byte, int, error) {
+
+    // Get request body
+    requestBodyBytes := []byte{}
+    if requestBody != nil {
+        var err error
+        if requestBodyBytes, err = json.Marshal(requestBody); err != nil {
+            return []byte{}, 0, err
+        }
+    }
+
+    // Build request
+    request, err := http.NewRequest(method, fmt.Sprintf("%s/v1/%s", p.url, p.secretPath), bytes.NewReader(requestBodyBytes))
+    if err != nil {
+        return []byte{}, 0, err
+    }
+    if requestBody != nil {
+        request.Header.Set("Content-Type", "application/json")
+    }
+    if p.tokenPath != "" {
+        token, err := ioutil.ReadFile(p.tokenPath)
+        if err != nil {
+            return []byte{}, 0, fmt.Errorf("Could not read vault token at %s: %w", p.tokenPath, err)
+        }
+        if len(bytes.TrimSpace(token)) == 0 {
+            return []byte{}, 0, errors.New("The vault token file is empty")
+        }
+        request.Header.Set(VaultTokenHeader, string(bytes.TrimSpace(token)))
+    }
+
+    // Send request
+    response, err := p.client.Do(request)
+    if err != nil {
+        return []byte{}, 0, err
+    }
+    defer response.Body.Close()
+
+    // Get response
+    body, err := ioutil.ReadAll(response.Body)
+    if err != nil {
+        return []byte{}, 0, err
+    }
+
+    // Return
+    return body, response.StatusCode, nil
+
+}
@@ -3,7 +3,9 @@ package services
 import (
     "context"
     "errors"
+    "fmt"
     "log"
+    "os"
     "sync"
     "time"
 
@@ -11,6 +13,11 @@ import (
     "github.com/rocket-pool/rocketpool-go/dao/trustednode"
     "github.com/rocket-pool/rocketpool-go/node"
     "github.com/urfave/cli"
+    "golang.org/x/term"
+
+    "github.com/rocket-pool/smartnode/shared/services/metrics"
+    "github.com/rocket-pool/smartnode/shared/services/passwords"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 
@@ -153,6 +160,9 @@ func RequireNodeTrusted(c *cli.Context) error {
 
 
 func WaitNodePassword(c *cli.Context, verbose bool) error {
+    if err := PromptNodePassword(c); err != nil {
+        return err
+    }
     for {
         nodePasswordSet, err := getNodePasswordSet(c)
         if err != nil {
@@ -169,6 +179,39 @@ func WaitNodePassword(c *cli.Context, verbose bool) error {
 }
 
 
+// Prompt for the node password on the terminal if it is held in memory only and has not been entered yet
+// Each process prompts for the password itself; without a terminal, only the API server can have it entered later with 'rocketpool wallet unlock'
+func PromptNodePassword(c *cli.Context) error {
+    pm, err := GetPasswordManager(c)
+    if err != nil {
+        return err
+    }
+    if _, ok := pm.GetProvider().(*passwords.MemoryProvider); !ok {
+        return nil
+    }
+    passwordSet, err := pm.IsPasswordSet()
+    if err != nil || passwordSet {
+        return err
+    }
+    if !term.IsTerminal(int(os.Stdin.Fd())) {
+        if isAPIServer {
+            log.Println("The node password is held in memory only and has not been entered. Please enter it with 'rocketpool wallet unlock'.")
+            return nil
+        }
+        return errors.New("The node password is held in memory only and must be entered when the Rocket Pool service starts. Please attach a terminal and try again.")
+    }
+    password, err := cliutils.PromptPassword(
+        "Please enter the node password:",
+        fmt.Sprintf("^.{%d,}$", passwords.MinPasswordLength),
+        fmt.Sprintf("Your password must be at least %d characters long. Please try again:", passwords.MinPasswordLength),
+    )
+    if err != nil {
+        return err
+    }
+    return pm.SetPassword(password)
+}
+
+
 func WaitNodeWallet(c *cli.Context, verbose bool) error {
     if err := WaitNodePassword(c, verbose); err != nil {
         return err
@@ -255,7 +298,7 @@ func getNodePasswordSet(c *cli.Context) (bool, error) {
     if err != nil {
         return false, err
     }
-    return pm.IsPasswordSet(), nil
+    return pm.IsPasswordSet()
 }
 
 
@@ -373,6 +416,13 @@ func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, err
             return false, err
         }
 
+        // Update metrics
+        metrics.SetGaugeBool("eth1/syncing", (progress != nil))
+        if progress != nil {
+            metrics.SetGauge("eth1/current_block", int64(progress.CurrentBlock))
+            metrics.SetGauge("eth1/highest_block", int64(progress.HighestBlock))
+        }
+
         // Check sync progress
         if progress != nil {
             if verbose {
@@ -427,6 +477,9 @@ func waitBeaconClientSynced(c *cli.Context, verbose bool, timeout int64) (bool,
             return false, err
         }
 
+        // Update metrics
+        metrics.SetGaugeBool("eth2/syncing", syncStatus.Syncing)
+
         // Check sync status
         if syncStatus.Syncing {
             if verbose {
@@ -0,0 +1,136 @@
+package rocketpool
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "net"
+    "strings"
+    "syscall"
+
+    "github.com/rocket-pool/smartnode/shared/services/apiclient"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Call the Rocket Pool API server
+// Returns false if the API server is not configured or unavailable, in which case the API command should be run directly
+func (c *Client) callAPIServer(args string) ([]byte, bool, error) {
+
+    // Get API server transport
+    transport, err := c.getAPIServer()
+    if err != nil || transport == nil {
+        return []byte{}, false, nil
+    }
+
+    // Send request
+    responseBytes, err := transport.Call(context.Background(), api.APIRequest{
+        Args: splitArgs(args),
+        GasPrice: c.gasPrice,
+        GasLimit: c.gasLimit,
+        DryRun: c.dryRun,
+    })
+
+    // Fall back to running API commands directly if the server can't be connected to
+    // Other errors (e.g. a timeout after the request was sent) are returned, as the command may have been run
+    if isDialError(err) {
+        c.apiServerUnavailable = true
+        return []byte{}, false, nil
+    }
+    return responseBytes, true, err
+
+}
+
+
+// Get the API server transport, loading its URL & token on first use
+// Returns nil if the API server is not configured or unavailable
+func (c *Client) getAPIServer() (*apiclient.HTTPTransport, error) {
+
+    // Check for loaded or unavailable server
+    if c.apiServer != nil || c.apiServerUnavailable {
+        return c.apiServer, nil
+    }
+    c.apiServerUnavailable = true
+
+    // Get API server URL
+    cfg, err := c.LoadMergedConfig()
+    if err != nil {
+        return nil, err
+    }
+    serverUrl := cfg.Smartnode.ApiUrl
+    if serverUrl == "" {
+        return nil, nil
+    }
+
+    // Get API server token
+    responseBytes, err := c.runAPICommand("server-token")
+    if err != nil {
+        return nil, err
+    }
+    var response api.APIServerTokenResponse
+    if err := json.Unmarshal(responseBytes, &response); err != nil {
+        return nil, fmt.Errorf("Could not decode API server token response: %w", err)
+    }
+    if response.Error != "" {
+        return nil, errors.New(response.Error)
+    }
+
+    // Get dial function; connections are made through the SSH client if configured
+    var dial apiclient.DialFunc
+    if c.client != nil {
+        dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+            conn, err := c.client.Dial(network, address)
+            if err != nil {
+                return nil, &net.OpError{Op: "dial", Net: network, Err: err}
+            }
+            return conn, nil
+        }
+    }
+
+    // Initialize & return server transport
+    c.apiServer = apiclient.NewHTTPTransport(serverUrl, response.Token, dial, apiclient.DefaultRequestTimeout)
+    c.apiServerUnavailable = false
+    return c.apiServer, nil
+
+}
+
+
+// Check whether an API server request failed to connect to the server
+func isDialError(err error) bool {
+    if err == nil {
+        return false
+    }
+    var opErr *net.OpError
+    if errors.As(err, &opErr) && opErr.Op == "dial" {
+        return true
+    }
+    return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ENOENT)
+}
+
+
+// Split API command arguments, respecting double-quoted arguments
+func splitArgs(args string) []string {
+    fields := []string{}
+    var field strings.Builder
+    inField, inQuotes := false, false
+    for _, r := range args {
+        switch {
+            case r == '"':
+                inField, inQuotes = true, !inQuotes
+            case r == ' ' && !inQuotes:
+                if inField {
+                    fields = append(fields, field.String())
+                    field.Reset()
+                    inField = false
+                }
+            default:
+                field.WriteRune(r)
+                inField = true
+        }
+    }
+    if inField {
+        fields = append(fields, field.String())
+    }
+    return fields
+}
@@ -2,6 +2,8 @@ package rocketpool
 
 import (
     "bufio"
+    "bytes"
+    "encoding/json"
     "errors"
     "fmt"
     "io"
@@ -14,7 +16,10 @@ import (
     "github.com/urfave/cli"
     "golang.org/x/crypto/ssh"
 
+    "github.com/rocket-pool/smartnode/shared/services/apiclient"
     "github.com/rocket-pool/smartnode/shared/services/config"
+    "github.com/rocket-pool/smartnode/shared/types/api"
+    cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
     "github.com/rocket-pool/smartnode/shared/utils/net"
 )
 
@@ -30,6 +35,13 @@ const (
     APIContainerSuffix = "_api"
     APIBinPath = "/go/bin/rocketpool"
 
+    ValidatorServiceName = "validator"
+    NodeServiceName = "node"
+    WatchtowerServiceName = "watchtower"
+    SlashingProtectionFileEnv = "INTERCHANGE_FILE"
+    SlashingProtectionExportFile = "slashing-protection-export.json"
+    SlashingProtectionImportFile = "slashing-protection-import.json"
+
     DebugColor = color.FgYellow
 )
 
@@ -40,7 +52,10 @@ type Client struct {
     daemonPath string
     gasPrice string
     gasLimit string
+    dryRun bool
     client *ssh.Client
+    apiServer *apiclient.HTTPTransport
+    apiServerUnavailable bool
 }
 
 
@@ -53,12 +68,13 @@ func NewClientFromCtx(c *cli.Context) (*Client, error) {
                      c.GlobalString("key"), 
                      c.GlobalString("passphrase"), 
                      c.GlobalString("gasPrice"), 
-                     c.GlobalString("gasLimit"))
+                     c.GlobalString("gasLimit"),
+                     c.GlobalBool("dry-run"))
 }
 
 
 // Create new Rocket Pool client
-func NewClient(configPath, daemonPath, hostAddress, user, keyPath, keyPassphrase, gasPrice, gasLimit string) (*Client, error) {
+func NewClient(configPath, daemonPath, hostAddress, user, keyPath, keyPassphrase, gasPrice, gasLimit string, dryRun bool) (*Client, error) {
 
     // Initialize SSH client if configured for SSH
     var sshClient *ssh.Client
@@ -107,6 +123,7 @@ func NewClient(configPath, daemonPath, hostAddress, user, keyPath, keyPassphrase
         daemonPath: os.ExpandEnv(daemonPath),
         gasPrice: gasPrice,
         gasLimit: gasLimit,
+        dryRun: dryRun,
         client: sshClient,
     }, nil
 
@@ -181,7 +198,7 @@ func (c *Client) InstallService(verbose, noDeps bool, network, version string) e
     go (func() {
         scanner := bufio.NewScanner(cmdOut)
         for scanner.Scan() {
-            fmt.Println(scanner.Text())
+            fmt.Fprintln(cliutils.TextOutput(), scanner.Text())
         }
     })()
 
@@ -193,7 +210,7 @@ func (c *Client) InstallService(verbose, noDeps bool, network, version string) e
         for scanner.Scan() {
             errMessage = scanner.Text()
             if verbose {
-                c.Println(scanner.Text())
+                c.Fprintln(cliutils.TextOutput(), scanner.Text())
             }
         }
     })()
@@ -264,6 +281,110 @@ func (c *Client) PrintServiceStats(composeFiles []string) error {
 }
 
 
+// Stop the validator client
+func (c *Client) StopValidator(composeFiles []string) error {
+    cmd, err := c.compose(composeFiles, fmt.Sprintf("stop %s", ValidatorServiceName))
+    if err != nil { return err }
+    return c.printOutput(cmd)
+}
+
+
+// Start the validator client
+func (c *Client) StartValidator(composeFiles []string) error {
+    cmd, err := c.compose(composeFiles, fmt.Sprintf("start %s", ValidatorServiceName))
+    if err != nil { return err }
+    return c.printOutput(cmd)
+}
+
+
+// Stop the node & watchtower daemons, which hold the node wallet
+func (c *Client) StopDaemons(composeFiles []string) error {
+    cmd, err := c.compose(composeFiles, fmt.Sprintf("stop %s %s", NodeServiceName, WatchtowerServiceName))
+    if err != nil { return err }
+    return c.printOutput(cmd)
+}
+
+
+// Start the node & watchtower daemons
+func (c *Client) StartDaemons(composeFiles []string) error {
+    cmd, err := c.compose(composeFiles, fmt.Sprintf("start %s %s", NodeServiceName, WatchtowerServiceName))
+    if err != nil { return err }
+    return c.printOutput(cmd)
+}
+
+
+// Export slashing protection history from a validator client's database to a file in the validator keychain folder
+// The validator client must be stopped
+func (c *Client) ExportClientSlashingProtection(composeFiles []string, clientId, file string) error {
+    client, err := c.getEth2Client(clientId)
+    if err != nil {
+        return err
+    }
+    if !client.CanExportSlashingProtection() {
+        return fmt.Errorf("Slashing protection history can't be exported from %s automatically.", client.Name)
+    }
+    if err := c.runSlashingProtectionCommand(composeFiles, client, client.SlashingProtection.Export, file); err != nil {
+        return fmt.Errorf("Could not export slashing protection history from %s: %w", client.Name, err)
+    }
+    return nil
+}
+
+
+// Import slashing protection history from a file in the validator keychain folder into a validator client's database
+// The validator client must be stopped
+func (c *Client) ImportClientSlashingProtection(composeFiles []string, clientId, file string) error {
+    client, err := c.getEth2Client(clientId)
+    if err != nil {
+        return err
+    }
+    if !client.CanImportSlashingProtection() {
+        return fmt.Errorf("Slashing protection history can't be imported into %s automatically.", client.Name)
+    }
+    if err := c.runSlashingProtectionCommand(composeFiles, client, client.SlashingProtection.Import, file); err != nil {
+        return fmt.Errorf("Could not import slashing protection history into %s: %w", client.Name, err)
+    }
+    return nil
+}
+
+
+// Write a file to the validator keychain folder
+// The file is streamed to the API container, so its size is not limited by command arguments
+func (c *Client) WriteValidatorKeychainFile(file string, data []byte) error {
+
+    // Load config
+    cfg, err := c.LoadMergedConfig()
+    if err != nil {
+        return err
+    }
+
+    // Get write command
+    path := fmt.Sprintf("%s/%s", cfg.Smartnode.ValidatorKeychainPath, file)
+    var cmdText string
+    if c.daemonPath == "" {
+        containerName, err := c.getAPIContainerName()
+        if err != nil {
+            return err
+        }
+        cmdText = fmt.Sprintf("docker exec -i %s sh -c %s", containerName, shellQuote(fmt.Sprintf("cat > %s", path)))
+    } else {
+        cmdText = fmt.Sprintf("cat > %s", path)
+    }
+
+    // Write file
+    cmd, err := c.newCommand(cmdText)
+    if err != nil {
+        return err
+    }
+    defer cmd.Close()
+    cmd.SetStdin(bytes.NewReader(data))
+    if err := cmd.Run(); err != nil {
+        return fmt.Errorf("Could not write %s to the validator keychain folder: %w", file, err)
+    }
+    return nil
+
+}
+
+
 // Get the Rocket Pool service version
 func (c *Client) GetServiceVersion() (string, error) {
 
@@ -318,8 +439,40 @@ func (c *Client) saveConfig(cfg config.RocketPoolConfig, path string) error {
 }
 
 
+// Run a slashing protection history command in a validator client's container
+// The container is created from the client's validator image, so history can be exported from a client which is no longer selected
+func (c *Client) runSlashingProtectionCommand(composeFiles []string, client *config.ClientOption, command, file string) error {
+    cmd, err := c.composeWithValidatorClient(composeFiles, client, fmt.Sprintf(
+        "run --rm --no-deps -T -e %s=%s --entrypoint sh %s -c %s",
+        SlashingProtectionFileEnv, shellQuote(file), ValidatorServiceName, shellQuote(command),
+    ))
+    if err != nil { return err }
+    return c.printOutput(cmd)
+}
+
+
+// Get an Eth 2.0 client option by ID
+func (c *Client) getEth2Client(clientId string) (*config.ClientOption, error) {
+    cfg, err := c.LoadMergedConfig()
+    if err != nil {
+        return nil, err
+    }
+    client := cfg.Chains.Eth2.GetClient(clientId)
+    if client == nil {
+        return nil, fmt.Errorf("Unknown Eth 2.0 client '%s'", clientId)
+    }
+    return client, nil
+}
+
+
 // Build a docker-compose command
 func (c *Client) compose(composeFiles []string, args string) (string, error) {
+    return c.composeWithValidatorClient(composeFiles, nil, args)
+}
+
+
+// Build a docker-compose command, using a validator client other than the selected client if set
+func (c *Client) composeWithValidatorClient(composeFiles []string, validatorClient *config.ClientOption, args string) (string, error) {
 
     // Cancel if running in non-docker mode
     if c.daemonPath != "" {
@@ -339,6 +492,13 @@ func (c *Client) compose(composeFiles []string, args string) (string, error) {
     if cfg.GetSelectedEth2Client() == nil {
         return "", errors.New("No Eth 2.0 client selected. Please run 'rocketpool service config' and try again.")
     }
+    validatorKeystore, err := cfg.GetValidatorKeystore()
+    if err != nil {
+        return "", err
+    }
+    if validatorClient == nil {
+        validatorClient = cfg.GetSelectedEth2Client()
+    }
 
     // Set environment variables from config
     env := []string{
@@ -349,8 +509,10 @@ func (c *Client) compose(composeFiles []string, args string) (string, error) {
         fmt.Sprintf("ETH1_IMAGE='%s'",              cfg.GetSelectedEth1Client().Image),
         fmt.Sprintf("ETH2_CLIENT='%s'",             cfg.GetSelectedEth2Client().ID),
         fmt.Sprintf("ETH2_IMAGE='%s'",              cfg.GetSelectedEth2Client().GetBeaconImage()),
-        fmt.Sprintf("VALIDATOR_CLIENT='%s'",        cfg.GetSelectedEth2Client().ID),
-        fmt.Sprintf("VALIDATOR_IMAGE='%s'",         cfg.GetSelectedEth2Client().GetValidatorImage()),
+        fmt.Sprintf("VALIDATOR_CLIENT='%s'",        validatorClient.ID),
+        fmt.Sprintf("VALIDATOR_IMAGE='%s'",         validatorClient.GetValidatorImage()),
+        fmt.Sprintf("VALIDATOR_KEYSTORE='%s'",      validatorKeystore),
+        fmt.Sprintf("REMOTE_SIGNER_URL='%s'",       cfg.RemoteSigner.Url),
         fmt.Sprintf("ETH1_PROVIDER='%s'",           cfg.Chains.Eth1.Provider),
         fmt.Sprintf("ETH1_WS_PROVIDER='%s'",        cfg.Chains.Eth1.WsProvider),
         fmt.Sprintf("ETH2_PROVIDER='%s'",           cfg.Chains.Eth2.Provider),
@@ -376,16 +538,44 @@ func (c *Client) compose(composeFiles []string, args string) (string, error) {
 
 
 // Call the Rocket Pool API
+// Calls are made through the API server if it is configured and available
 func (c *Client) callAPI(args string) ([]byte, error) {
+    responseBytes, served, err := c.callAPIServer(args)
+    if err != nil {
+        return []byte{}, err
+    }
+    if !served {
+        responseBytes, err = c.runAPICommand(args)
+        if err != nil {
+            return []byte{}, err
+        }
+    }
+
+    // Report simulated transactions in place of the response
+    if c.dryRun {
+        var response api.DryRunResponse
+        if err := json.Unmarshal(responseBytes, &response); err == nil && response.DryRun != nil {
+            return []byte{}, errors.New(response.DryRun.String())
+        }
+    }
+
+    // Return
+    return responseBytes, nil
+
+}
+
+
+// Run a Rocket Pool API command
+func (c *Client) runAPICommand(args string) ([]byte, error) {
     var cmd string
     if c.daemonPath == "" {
         containerName, err := c.getAPIContainerName()
         if err != nil {
             return []byte{}, err
         }
-        cmd = fmt.Sprintf("docker exec %s %s %s api %s", containerName, APIBinPath, c.getGasOpts(), args)
+        cmd = fmt.Sprintf("docker exec %s %s %s%s api %s", containerName, APIBinPath, c.getGasOpts(), c.getDryRunOpts(), args)
     } else {
-        cmd = fmt.Sprintf("%s --config %s --settings %s %s api %s", c.daemonPath, fmt.Sprintf("%s/%s", c.configPath, GlobalConfigFile), fmt.Sprintf("%s/%s", c.configPath, UserConfigFile), c.getGasOpts(), args)
+        cmd = fmt.Sprintf("%s --config %s --settings %s %s%s api %s", c.daemonPath, fmt.Sprintf("%s/%s", c.configPath, GlobalConfigFile), fmt.Sprintf("%s/%s", c.configPath, UserConfigFile), c.getGasOpts(), c.getDryRunOpts(), args)
     }
     return c.readOutput(cmd)
 }
@@ -404,6 +594,12 @@ func (c *Client) getAPIContainerName() (string, error) {
 }
 
 
+// Quote a string as a single shell argument
+func shellQuote(value string) string {
+    return "'" + strings.ReplaceAll(value, "'", "'\\''") + "'"
+}
+
+
 // Get gas price & limit flags
 func (c *Client) getGasOpts() string {
     var opts string
@@ -417,6 +613,15 @@ func (c *Client) getGasOpts() string {
 }
 
 
+// Get dry run flag
+func (c *Client) getDryRunOpts() string {
+    if c.dryRun {
+        return "--dry-run "
+    }
+    return ""
+}
+
+
 // Get the first downloader available to the system
 func (c *Client) getDownloader() (string, error) {
 
@@ -451,7 +656,7 @@ func (c *Client) printOutput(cmdText string) error {
     if err != nil { return err }
     cmdErr, err := cmd.StderrPipe()
     if err != nil { return err }
-    go io.Copy(os.Stdout, cmdOut)
+    go io.Copy(cliutils.TextOutput(), cmdOut)
     go io.Copy(os.Stderr, cmdErr)
 
     // Run command
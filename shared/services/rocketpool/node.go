@@ -28,6 +28,40 @@ func (c *Client) NodeStatus() (api.NodeStatusResponse, error) {
 }
 
 
+// Get node pending transactions
+func (c *Client) NodePendingTxs() (api.NodePendingTxsResponse, error) {
+    responseBytes, err := c.callAPI("node pending-txs")
+    if err != nil {
+        return api.NodePendingTxsResponse{}, fmt.Errorf("Could not get node pending transactions: %w", err)
+    }
+    var response api.NodePendingTxsResponse
+    if err := json.Unmarshal(responseBytes, &response); err != nil {
+        return api.NodePendingTxsResponse{}, fmt.Errorf("Could not decode node pending transactions response: %w", err)
+    }
+    if response.Error != "" {
+        return api.NodePendingTxsResponse{}, fmt.Errorf("Could not get node pending transactions: %s", response.Error)
+    }
+    return response, nil
+}
+
+
+// Get a report of the node's rewards over a time range
+func (c *Client) NodeRewardsReport(startTime, endTime int64) (api.NodeRewardsReportResponse, error) {
+    responseBytes, err := c.callAPI(fmt.Sprintf("node rewards-report %d %d", startTime, endTime))
+    if err != nil {
+        return api.NodeRewardsReportResponse{}, fmt.Errorf("Could not get node rewards report: %w", err)
+    }
+    var response api.NodeRewardsReportResponse
+    if err := json.Unmarshal(responseBytes, &response); err != nil {
+        return api.NodeRewardsReportResponse{}, fmt.Errorf("Could not decode node rewards report response: %w", err)
+    }
+    if response.Error != "" {
+        return api.NodeRewardsReportResponse{}, fmt.Errorf("Could not get node rewards report: %s", response.Error)
+    }
+    return response, nil
+}
+
+
 // Check whether the node can be registered
 func (c *Client) CanRegisterNode() (api.CanRegisterNodeResponse, error) {
     responseBytes, err := c.callAPI("node can-register")
@@ -42,6 +42,23 @@ func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 }
 
 
+// Change wallet password
+func (c *Client) ChangePassword(currentPassword, newPassword string) (api.ChangePasswordResponse, error) {
+    responseBytes, err := c.callAPI(fmt.Sprintf("wallet change-password \"%s\" \"%s\"", currentPassword, newPassword))
+    if err != nil {
+        return api.ChangePasswordResponse{}, fmt.Errorf("Could not change wallet password: %w", err)
+    }
+    var response api.ChangePasswordResponse
+    if err := json.Unmarshal(responseBytes, &response); err != nil {
+        return api.ChangePasswordResponse{}, fmt.Errorf("Could not decode change wallet password response: %w", err)
+    }
+    if response.Error != "" {
+        return api.ChangePasswordResponse{}, fmt.Errorf("Could not change wallet password: %s", response.Error)
+    }
+    return response, nil
+}
+
+
 // Initialize wallet
 func (c *Client) InitWallet() (api.InitWalletResponse, error) {
     responseBytes, err := c.callAPI("wallet init")
@@ -109,3 +126,54 @@ func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
     return response, nil
 }
 
+
+// Prepare slashing protection history exported by a validator client to a file in the validator keychain folder
+func (c *Client) ExportSlashingProtection(file string) (api.ExportSlashingProtectionResponse, error) {
+    responseBytes, err := c.callAPI(fmt.Sprintf("wallet export-slashing-protection %s", file))
+    if err != nil {
+        return api.ExportSlashingProtectionResponse{}, fmt.Errorf("Could not export slashing protection history: %w", err)
+    }
+    var response api.ExportSlashingProtectionResponse
+    if err := json.Unmarshal(responseBytes, &response); err != nil {
+        return api.ExportSlashingProtectionResponse{}, fmt.Errorf("Could not decode export slashing protection history response: %w", err)
+    }
+    if response.Error != "" {
+        return api.ExportSlashingProtectionResponse{}, fmt.Errorf("Could not export slashing protection history: %s", response.Error)
+    }
+    return response, nil
+}
+
+
+// Check a slashing protection history file in the validator keychain folder before a validator client imports it
+func (c *Client) ImportSlashingProtection(file string) (api.ImportSlashingProtectionResponse, error) {
+    responseBytes, err := c.callAPI(fmt.Sprintf("wallet import-slashing-protection %s", file))
+    if err != nil {
+        return api.ImportSlashingProtectionResponse{}, fmt.Errorf("Could not import slashing protection history: %w", err)
+    }
+    var response api.ImportSlashingProtectionResponse
+    if err := json.Unmarshal(responseBytes, &response); err != nil {
+        return api.ImportSlashingProtectionResponse{}, fmt.Errorf("Could not decode import slashing protection history response: %w", err)
+    }
+    if response.Error != "" {
+        return api.ImportSlashingProtectionResponse{}, fmt.Errorf("Could not import slashing protection history: %s", response.Error)
+    }
+    return response, nil
+}
+
+
+// Verify validator keystores, optionally repairing them and deleting extra keys
+func (c *Client) VerifyValidatorKeys(repair, deleteExtra bool) (api.VerifyValidatorKeysResponse, error) {
+    responseBytes, err := c.callAPI(fmt.Sprintf("wallet verify-keys %t %t", repair, deleteExtra))
+    if err != nil {
+        return api.VerifyValidatorKeysResponse{}, fmt.Errorf("Could not verify validator keys: %w", err)
+    }
+    var response api.VerifyValidatorKeysResponse
+    if err := json.Unmarshal(responseBytes, &response); err != nil {
+        return api.VerifyValidatorKeysResponse{}, fmt.Errorf("Could not decode verify validator keys response: %w", err)
+    }
+    if response.Error != "" {
+        return api.VerifyValidatorKeysResponse{}, fmt.Errorf("Could not verify validator keys: %s", response.Error)
+    }
+    return response, nil
+}
+
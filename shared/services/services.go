@@ -3,27 +3,37 @@ package services
 import (
     "fmt"
     "math/big"
+    "net/http"
     "os"
+    "strings"
     "sync"
+    "time"
 
     "github.com/docker/docker/client"
     "github.com/ethereum/go-ethereum/common"
     "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/ethereum/go-ethereum/rpc"
     "github.com/rocket-pool/rocketpool-go/rocketpool"
     "github.com/urfave/cli"
 
     "github.com/rocket-pool/smartnode/shared/services/beacon"
+    "github.com/rocket-pool/smartnode/shared/services/beacon/batch"
+    "github.com/rocket-pool/smartnode/shared/services/beacon/cache"
     "github.com/rocket-pool/smartnode/shared/services/beacon/lighthouse"
+    "github.com/rocket-pool/smartnode/shared/services/beacon/multi"
     "github.com/rocket-pool/smartnode/shared/services/beacon/nimbus"
     "github.com/rocket-pool/smartnode/shared/services/beacon/prysm"
     "github.com/rocket-pool/smartnode/shared/services/beacon/teku"
     "github.com/rocket-pool/smartnode/shared/services/config"
     "github.com/rocket-pool/smartnode/shared/services/contracts"
+    "github.com/rocket-pool/smartnode/shared/services/notifier"
     "github.com/rocket-pool/smartnode/shared/services/passwords"
+    "github.com/rocket-pool/smartnode/shared/services/state"
     "github.com/rocket-pool/smartnode/shared/services/wallet"
     lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
     nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
     prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
+    rskeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/remote"
     tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
 )
 
@@ -38,11 +48,17 @@ var (
     passwordManager *passwords.PasswordManager
     nodeWallet *wallet.Wallet
     ethClient *ethclient.Client
+    ethRpcClient *rpc.Client
     rocketPool *rocketpool.RocketPool
     rplFaucet *contracts.RPLFaucet
     beaconClient beacon.Client
+    stateStore *state.StateStore
+    nodeNotifier *notifier.Notifier
     docker *client.Client
 
+    passwordManagerErr error
+    isAPIServer bool
+
     initCfg sync.Once
     initPasswordManager sync.Once
     initNodeWallet sync.Once
@@ -50,6 +66,8 @@ var (
     initRocketPool sync.Once
     initRplFaucet sync.Once
     initBeaconClient sync.Once
+    initStateStore sync.Once
+    initNotifier sync.Once
     initDocker sync.Once
 )
 
@@ -59,6 +77,13 @@ var (
 //
 
 
+// Set the current process as the API server
+// With the prompt password provider, the API server can have the password entered after it starts with 'rocketpool wallet unlock'
+func SetAPIServer() {
+    isAPIServer = true
+}
+
+
 func GetConfig(c *cli.Context) (config.RocketPoolConfig, error) {
     return getConfig(c)
 }
@@ -69,7 +94,7 @@ func GetPasswordManager(c *cli.Context) (*passwords.PasswordManager, error) {
     if err != nil {
         return nil, err
     }
-    return getPasswordManager(cfg), nil
+    return getPasswordManager(cfg)
 }
 
 
@@ -78,8 +103,11 @@ func GetWallet(c *cli.Context) (*wallet.Wallet, error) {
     if err != nil {
         return nil, err
     }
-    pm := getPasswordManager(cfg)
-    return getWallet(cfg, pm)
+    pm, err := getPasswordManager(cfg)
+    if err != nil {
+        return nil, err
+    }
+    return getWallet(c, cfg, pm)
 }
 
 
@@ -127,6 +155,32 @@ func GetBeaconClient(c *cli.Context) (beacon.Client, error) {
 }
 
 
+func GetStateStore(c *cli.Context) (*state.StateStore, error) {
+    cfg, err := getConfig(c)
+    if err != nil {
+        return nil, err
+    }
+    ec, err := getEthClient(cfg)
+    if err != nil {
+        return nil, err
+    }
+    rp, err := getRocketPool(cfg, ec)
+    if err != nil {
+        return nil, err
+    }
+    return getStateStore(cfg, rp, ec), nil
+}
+
+
+func GetNotifier(c *cli.Context) (*notifier.Notifier, error) {
+    cfg, err := getConfig(c)
+    if err != nil {
+        return nil, err
+    }
+    return getNotifier(cfg)
+}
+
+
 func GetDocker(c *cli.Context) (*client.Client, error) {
     return getDocker()
 }
@@ -146,15 +200,34 @@ func getConfig(c *cli.Context) (config.RocketPoolConfig, error) {
 }
 
 
-func getPasswordManager(cfg config.RocketPoolConfig) *passwords.PasswordManager {
+func getPasswordManager(cfg config.RocketPoolConfig) (*passwords.PasswordManager, error) {
     initPasswordManager.Do(func() {
-        passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.PasswordPath))
+        var err error
+        defer func() { passwordManagerErr = err }()
+        var passwordProvider string
+        passwordProvider, err = cfg.GetPasswordProvider()
+        if err != nil { return }
+        switch passwordProvider {
+            case config.PromptPasswordProvider:
+                passwordManager = passwords.NewPasswordManagerWithProvider(passwords.NewMemoryProvider())
+            case config.EnvPasswordProvider:
+                passwordManager = passwords.NewPasswordManagerWithProvider(passwords.NewEnvProvider(cfg.GetPasswordEnv()))
+            case config.FdPasswordProvider:
+                var fd uintptr
+                fd, err = cfg.GetPasswordFd()
+                if err != nil { return }
+                passwordManager = passwords.NewPasswordManagerWithProvider(passwords.NewFdProvider(fd))
+            case config.VaultPasswordProvider:
+                passwordManager = passwords.NewPasswordManagerWithProvider(passwords.NewVaultProvider(cfg.PasswordVault.Url, os.ExpandEnv(cfg.PasswordVault.TokenPath), cfg.PasswordVault.SecretPath, cfg.PasswordVault.SecretField))
+            default:
+                passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.PasswordPath))
+        }
     })
-    return passwordManager
+    return passwordManager, passwordManagerErr
 }
 
 
-func getWallet(cfg config.RocketPoolConfig, pm *passwords.PasswordManager) (*wallet.Wallet, error) {
+func getWallet(c *cli.Context, cfg config.RocketPoolConfig, pm *passwords.PasswordManager) (*wallet.Wallet, error) {
     var err error
     initNodeWallet.Do(func() {
         var gasPrice *big.Int
@@ -165,23 +238,68 @@ func getWallet(cfg config.RocketPoolConfig, pm *passwords.PasswordManager) (*wal
         if err != nil { return }
         nodeWallet, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.WalletPath), cfg.Chains.Eth1.ChainID, gasPrice, gasLimit, pm)
         if err != nil { return }
-        lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), pm)
-        nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), pm)
-        prysmKeystore := prkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), pm)
-        tekuKeystore := tkkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), pm)
-        nodeWallet.AddKeystore("lighthouse", lighthouseKeystore)
-        nodeWallet.AddKeystore("nimbus", nimbusKeystore)
-        nodeWallet.AddKeystore("prysm", prysmKeystore)
-        nodeWallet.AddKeystore("teku", tekuKeystore)
+        var validatorKeystore string
+        validatorKeystore, err = cfg.GetValidatorKeystore()
+        if err != nil { return }
+        if validatorKeystore == config.RemoteSignerValidatorKeystore {
+            remoteSignerKeystore := rskeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), cfg.RemoteSigner.Url, os.ExpandEnv(cfg.RemoteSigner.TokenPath))
+            nodeWallet.AddKeystore(config.RemoteSignerValidatorKeystore, remoteSignerKeystore)
+        } else {
+            lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath))
+            nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath))
+            prysmKeystore := prkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath), pm)
+            tekuKeystore := tkkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.ValidatorKeychainPath))
+            nodeWallet.AddKeystore("lighthouse", lighthouseKeystore)
+            nodeWallet.AddKeystore("nimbus", nimbusKeystore)
+            nodeWallet.AddKeystore("prysm", prysmKeystore)
+            nodeWallet.AddKeystore("teku", tekuKeystore)
+        }
+        var ec *ethclient.Client
+        ec, err = getEthClient(cfg)
+        if err != nil { return }
+        var gasSettings wallet.GasSettings
+        gasSettings, err = getGasSettings(cfg)
+        if err != nil { return }
+        nodeWallet.SetGasSettings(ec, ethRpcClient, gasSettings)
+        var txTimeout time.Duration
+        txTimeout, err = cfg.GetTxTimeout()
+        if err != nil { return }
+        // Transactions are only tracked over HTTP, where the receipt transport removes records of transactions which can't be sent
+        if strings.HasPrefix(cfg.Chains.Eth1.Provider, "http") {
+            nodeWallet.EnableTransactionTracking(cfg.GetPendingTxsPath(), txTimeout)
+        }
+        if c.GlobalBool("dry-run") {
+            nodeWallet.EnableDryRun(ec)
+        }
     })
     return nodeWallet, err
 }
 
 
+func getGasSettings(cfg config.RocketPoolConfig) (wallet.GasSettings, error) {
+    var settings wallet.GasSettings
+    var err error
+    if settings.Strategy, err = cfg.GetGasStrategy(); err != nil { return wallet.GasSettings{}, err }
+    if settings.Multiplier, err = cfg.GetGasMultiplier(); err != nil { return wallet.GasSettings{}, err }
+    if settings.MaxGasPrice, err = cfg.GetMaxGasPrice(); err != nil { return wallet.GasSettings{}, err }
+    if settings.PriorityFee, err = cfg.GetPriorityFee(); err != nil { return wallet.GasSettings{}, err }
+    if settings.GasLimitMultiplier, err = cfg.GetGasLimitMultiplier(); err != nil { return wallet.GasSettings{}, err }
+    return settings, nil
+}
+
+
 func getEthClient(cfg config.RocketPoolConfig) (*ethclient.Client, error) {
     var err error
     initEthClient.Do(func() {
-        ethClient, err = ethclient.Dial(cfg.Chains.Eth1.Provider)
+        if strings.HasPrefix(cfg.Chains.Eth1.Provider, "http") {
+            ethRpcClient, err = rpc.DialHTTPWithClient(cfg.Chains.Eth1.Provider, &http.Client{
+                Transport: wallet.NewReceiptTransport(http.DefaultTransport, cfg.GetPendingTxsPath()),
+            })
+        } else {
+            ethRpcClient, err = rpc.Dial(cfg.Chains.Eth1.Provider)
+        }
+        if err != nil { return }
+        ethClient = ethclient.NewClient(ethRpcClient)
     })
     return ethClient, err
 }
@@ -208,23 +326,91 @@ func getRplFaucet(cfg config.RocketPoolConfig, client *ethclient.Client) (*contr
 func getBeaconClient(cfg config.RocketPoolConfig) (beacon.Client, error) {
     var err error
     initBeaconClient.Do(func() {
-        switch cfg.Chains.Eth2.Client.Selected {
-            case "lighthouse":
-                beaconClient = lighthouse.NewClient(cfg.Chains.Eth2.Provider)
-            case "nimbus":
-                beaconClient, err = nimbus.NewClient(cfg.Chains.Eth2.Provider)
-            case "prysm":
-                beaconClient, err = prysm.NewClient(cfg.Chains.Eth2.Provider)
-            case "teku":
-                beaconClient = teku.NewClient(cfg.Chains.Eth2.Provider)
-            default:
-                err = fmt.Errorf("Unknown Eth 2.0 client '%s' selected", cfg.Chains.Eth2.Client.Selected)
+
+        // Get validator batch settings
+        var batchSize, batchConcurrency int
+        batchSize, err = cfg.GetValidatorBatchSize()
+        if err != nil {
+            return
+        }
+        batchConcurrency, err = cfg.GetValidatorBatchConcurrency()
+        if err != nil {
+            return
         }
+
+        // Create a client for each provider
+        providers := cfg.Chains.Eth2.GetProviders()
+        if len(providers) == 0 {
+            providers = []string{""}
+        }
+        clients := make([]beacon.Client, len(providers))
+        for pi, provider := range providers {
+            var client beacon.Client
+            client, err = newBeaconClient(cfg.Chains.Eth2.Client.Selected, provider)
+            if err != nil {
+                return
+            }
+            clients[pi] = batch.NewClient(client, batchSize, batchConcurrency)
+        }
+
+        // Fail over between providers if multiple are configured
+        if len(clients) > 1 {
+            beaconClient = cache.NewClient(multi.NewClient(clients))
+        } else {
+            beaconClient = cache.NewClient(clients[0])
+        }
+
     })
     return beaconClient, err
 }
 
 
+func newBeaconClient(clientType string, provider string) (beacon.Client, error) {
+    switch clientType {
+        case "lighthouse":
+            return lighthouse.NewClient(provider), nil
+        case "nimbus":
+            return nimbus.NewClient(provider)
+        case "prysm":
+            return prysm.NewClient(provider)
+        case "teku":
+            return teku.NewClient(provider), nil
+        default:
+            return nil, fmt.Errorf("Unknown Eth 2.0 client '%s' selected", clientType)
+    }
+}
+
+
+func getStateStore(cfg config.RocketPoolConfig, rp *rocketpool.RocketPool, client *ethclient.Client) *state.StateStore {
+    initStateStore.Do(func() {
+        stateStore = state.NewStateStore(cfg.GetStatePath(), rp, client)
+    })
+    return stateStore
+}
+
+
+func getNotifier(cfg config.RocketPoolConfig) (*notifier.Notifier, error) {
+    var err error
+    initNotifier.Do(func() {
+        var repeatInterval time.Duration
+        repeatInterval, err = cfg.GetNotificationRepeatInterval()
+        if err != nil { return }
+        nodeNotifier = notifier.NewNotifier(notifier.Settings{
+            WebhookUrl: os.ExpandEnv(cfg.Notifications.Webhook.Url),
+            WebhookHeaders: cfg.Notifications.Webhook.Headers,
+            SmtpHost: cfg.Notifications.Smtp.Host,
+            SmtpPort: cfg.Notifications.Smtp.Port,
+            SmtpUsername: cfg.Notifications.Smtp.Username,
+            SmtpPassword: os.ExpandEnv(cfg.Notifications.Smtp.Password),
+            SmtpFrom: cfg.Notifications.Smtp.From,
+            SmtpTo: cfg.Notifications.Smtp.To,
+            RepeatInterval: repeatInterval,
+        })
+    })
+    return nodeNotifier, err
+}
+
+
 func getDocker() (*client.Client, error) {
     var err error
     initDocker.Do(func() {
@@ -0,0 +1,447 @@
+package state
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "math/big"
+    "os"
+    "path/filepath"
+    "sync"
+    "time"
+
+    "github.com/ethereum/go-ethereum"
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    ethtypes "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/types"
+    "golang.org/x/sync/errgroup"
+)
+
+
+// Config
+const (
+    StateVersion = 1
+    MinipoolStatusBatchSize = 20
+    EventLogBlockRange = 10000
+    DirMode = 0700
+    FileMode = 0600
+)
+var fullSyncInterval, _ = time.ParseDuration("24h")
+
+
+// Minipool state
+type MinipoolState struct {
+    Address common.Address          `json:"address"`
+    Status types.MinipoolStatus     `json:"status"`
+    StatusBlock uint64              `json:"statusBlock"`
+    CreatedBlock uint64             `json:"createdBlock"`
+    Destroyed bool                  `json:"destroyed"`
+    DestroyedBlock uint64           `json:"destroyedBlock"`
+}
+
+
+// Encoded state file
+type stateFile struct {
+    Version int                     `json:"version"`
+    Block uint64                    `json:"block"`
+    FullSyncBlock uint64            `json:"fullSyncBlock"`
+    FullSyncTime int64              `json:"fullSyncTime"`
+    Minipools []*MinipoolState      `json:"minipools"`
+}
+
+
+// Daemon state store
+// Tracks network minipool addresses & statuses on disk, updated incrementally from contract events
+type StateStore struct {
+    path string
+    rp *rocketpool.RocketPool
+    ec *ethclient.Client
+    state *stateFile
+    minipools map[common.Address]*MinipoolState
+    lock sync.Mutex
+}
+
+
+// Create new state store
+func NewStateStore(path string, rp *rocketpool.RocketPool, ec *ethclient.Client) *StateStore {
+    return &StateStore{
+        path: path,
+        rp: rp,
+        ec: ec,
+    }
+}
+
+
+// Update the state to the latest block
+func (s *StateStore) Update() error {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+
+    // Load state
+    if s.state == nil {
+        if err := s.load(); err != nil {
+            return err
+        }
+    }
+
+    // Get latest block
+    header, err := s.ec.HeaderByNumber(context.Background(), nil)
+    if err != nil {
+        return fmt.Errorf("Could not get latest block: %w", err)
+    }
+    latestBlock := header.Number.Uint64()
+
+    // Sync state
+    if s.state.Block == 0 || time.Since(time.Unix(s.state.FullSyncTime, 0)) > fullSyncInterval || latestBlock < s.state.Block {
+        if err := s.fullSync(latestBlock); err != nil {
+            return err
+        }
+    } else if latestBlock > s.state.Block {
+        if err := s.incrementalSync(latestBlock); err != nil {
+            return err
+        }
+    } else {
+        return nil
+    }
+
+    // Save state
+    return s.save()
+
+}
+
+
+// Get the block the state was last updated at
+func (s *StateStore) GetBlock() uint64 {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+    if s.state == nil { return 0 }
+    return s.state.Block
+}
+
+
+// Get all current minipools
+func (s *StateStore) GetMinipools() []MinipoolState {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+    minipools := []MinipoolState{}
+    if s.state == nil { return minipools }
+    for _, mp := range s.state.Minipools {
+        if !mp.Destroyed {
+            minipools = append(minipools, *mp)
+        }
+    }
+    return minipools
+}
+
+
+// Get current minipools with a specific status
+func (s *StateStore) GetMinipoolsByStatus(status types.MinipoolStatus) []MinipoolState {
+    minipools := []MinipoolState{}
+    for _, mp := range s.GetMinipools() {
+        if mp.Status == status {
+            minipools = append(minipools, mp)
+        }
+    }
+    return minipools
+}
+
+
+// Get the addresses of current minipools with a specific status
+func (s *StateStore) GetMinipoolAddressesByStatus(status types.MinipoolStatus) []common.Address {
+    addresses := []common.Address{}
+    for _, mp := range s.GetMinipoolsByStatus(status) {
+        addresses = append(addresses, mp.Address)
+    }
+    return addresses
+}
+
+
+// Get the addresses of all minipools which existed at a specific block
+// Returns false if the block is outside of the range the state can answer for
+func (s *StateStore) GetMinipoolAddressesAtBlock(blockNumber uint64) ([]common.Address, bool) {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+    if s.state == nil || blockNumber < s.state.FullSyncBlock || blockNumber > s.state.Block {
+        return []common.Address{}, false
+    }
+    addresses := []common.Address{}
+    for _, mp := range s.state.Minipools {
+        if mp.CreatedBlock > blockNumber || (mp.Destroyed && mp.DestroyedBlock <= blockNumber) {
+            continue
+        }
+        addresses = append(addresses, mp.Address)
+    }
+    return addresses, true
+}
+
+
+// Rebuild the minipool state from contract calls at a block
+func (s *StateStore) fullSync(blockNumber uint64) error {
+
+    // Initialize call options
+    opts := &bind.CallOpts{
+        BlockNumber: big.NewInt(int64(blockNumber)),
+    }
+
+    // Get minipool addresses
+    addresses, err := minipool.GetMinipoolAddresses(s.rp, opts)
+    if err != nil {
+        return fmt.Errorf("Could not get minipool addresses: %w", err)
+    }
+
+    // Get minipool statuses
+    statuses, err := s.getMinipoolStatuses(addresses, opts)
+    if err != nil {
+        return err
+    }
+
+    // Build minipool states, retaining previously recorded creation blocks
+    minipools := make([]*MinipoolState, len(addresses))
+    index := make(map[common.Address]*MinipoolState)
+    for mi, address := range addresses {
+        mp := &MinipoolState{
+            Address: address,
+            Status: statuses[mi].Status,
+            StatusBlock: statuses[mi].StatusBlock,
+        }
+        if previous, ok := s.minipools[address]; ok {
+            mp.CreatedBlock = previous.CreatedBlock
+        }
+        minipools[mi] = mp
+        index[address] = mp
+    }
+
+    // Update state
+    s.state.Block = blockNumber
+    s.state.FullSyncBlock = blockNumber
+    s.state.FullSyncTime = time.Now().Unix()
+    s.state.Minipools = minipools
+    s.minipools = index
+
+    // Return
+    return nil
+
+}
+
+
+// Update the minipool state from contract events up to a block
+func (s *StateStore) incrementalSync(blockNumber uint64) error {
+
+    // Get minipool manager address & event IDs
+    rocketMinipoolManagerAddress, err := s.rp.GetAddress("rocketMinipoolManager")
+    if err != nil {
+        return err
+    }
+    rocketMinipoolManagerAbi, err := s.rp.GetABI("rocketMinipoolManager")
+    if err != nil {
+        return err
+    }
+    rocketMinipoolAbi, err := s.rp.GetABI("rocketMinipool")
+    if err != nil {
+        return err
+    }
+    minipoolCreated, ok := rocketMinipoolManagerAbi.Events["MinipoolCreated"]
+    if !ok {
+        return fmt.Errorf("Could not find the MinipoolCreated event")
+    }
+    minipoolDestroyed, ok := rocketMinipoolManagerAbi.Events["MinipoolDestroyed"]
+    if !ok {
+        return fmt.Errorf("Could not find the MinipoolDestroyed event")
+    }
+    statusUpdated, ok := rocketMinipoolAbi.Events["StatusUpdated"]
+    if !ok {
+        return fmt.Errorf("Could not find the StatusUpdated event")
+    }
+
+    // Get minipool manager & minipool status events
+    managerLogs, err := s.filterLogs(s.state.Block + 1, blockNumber, []common.Address{*rocketMinipoolManagerAddress}, []common.Hash{minipoolCreated.ID, minipoolDestroyed.ID})
+    if err != nil {
+        return err
+    }
+    statusLogs, err := s.filterLogs(s.state.Block + 1, blockNumber, nil, []common.Hash{statusUpdated.ID})
+    if err != nil {
+        return err
+    }
+
+    // Process minipool manager events
+    updated := []common.Address{}
+    for _, log := range managerLogs {
+        if len(log.Topics) < 2 {
+            continue
+        }
+        address := common.BytesToAddress(log.Topics[1].Bytes())
+        switch log.Topics[0] {
+            case minipoolCreated.ID:
+                if _, ok := s.minipools[address]; ok {
+                    continue
+                }
+                mp := &MinipoolState{
+                    Address: address,
+                    Status: types.Initialized,
+                    StatusBlock: log.BlockNumber,
+                    CreatedBlock: log.BlockNumber,
+                }
+                s.state.Minipools = append(s.state.Minipools, mp)
+                s.minipools[address] = mp
+                updated = append(updated, address)
+            case minipoolDestroyed.ID:
+                if mp, ok := s.minipools[address]; ok {
+                    mp.Destroyed = true
+                    mp.DestroyedBlock = log.BlockNumber
+                }
+        }
+    }
+
+    // Process minipool status events for known minipools
+    for _, log := range statusLogs {
+        if mp, ok := s.minipools[log.Address]; ok && !mp.Destroyed {
+            updated = append(updated, log.Address)
+        }
+    }
+
+    // Reload updated minipool statuses
+    if len(updated) > 0 {
+        addresses := []common.Address{}
+        seen := make(map[common.Address]bool)
+        for _, address := range updated {
+            if !seen[address] {
+                addresses = append(addresses, address)
+                seen[address] = true
+            }
+        }
+        statuses, err := s.getMinipoolStatuses(addresses, &bind.CallOpts{BlockNumber: big.NewInt(int64(blockNumber))})
+        if err != nil {
+            return err
+        }
+        for mi, address := range addresses {
+            mp := s.minipools[address]
+            mp.Status = statuses[mi].Status
+            mp.StatusBlock = statuses[mi].StatusBlock
+        }
+    }
+
+    // Update state block
+    s.state.Block = blockNumber
+
+    // Return
+    return nil
+
+}
+
+
+// Get event logs over a block range in chunks
+func (s *StateStore) filterLogs(fromBlock, toBlock uint64, addresses []common.Address, eventIds []common.Hash) ([]ethtypes.Log, error) {
+    logs := []ethtypes.Log{}
+    for start := fromBlock; start <= toBlock; start += EventLogBlockRange {
+        end := start + EventLogBlockRange - 1
+        if end > toBlock { end = toBlock }
+        rangeLogs, err := s.ec.FilterLogs(context.Background(), ethereum.FilterQuery{
+            FromBlock: big.NewInt(int64(start)),
+            ToBlock: big.NewInt(int64(end)),
+            Addresses: addresses,
+            Topics: [][]common.Hash{eventIds},
+        })
+        if err != nil {
+            return []ethtypes.Log{}, fmt.Errorf("Could not get event logs for blocks %d - %d: %w", start, end, err)
+        }
+        logs = append(logs, rangeLogs...)
+    }
+    return logs, nil
+}
+
+
+// Get minipool status details in batches
+func (s *StateStore) getMinipoolStatuses(addresses []common.Address, opts *bind.CallOpts) ([]minipool.StatusDetails, error) {
+    statuses := make([]minipool.StatusDetails, len(addresses))
+    for bsi := 0; bsi < len(addresses); bsi += MinipoolStatusBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + MinipoolStatusBatchSize
+        if mei > len(addresses) { mei = len(addresses) }
+
+        // Load statuses
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                mp, err := minipool.NewMinipool(s.rp, addresses[mi])
+                if err != nil {
+                    return err
+                }
+                status, err := mp.GetStatusDetails(opts)
+                if err == nil { statuses[mi] = status }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []minipool.StatusDetails{}, err
+        }
+
+    }
+    return statuses, nil
+}
+
+
+// Load the state from disk
+func (s *StateStore) load() error {
+
+    // Initialize empty state
+    s.state = &stateFile{Version: StateVersion}
+    s.minipools = make(map[common.Address]*MinipoolState)
+
+    // Read state file; a missing file is synced from scratch
+    bytes, err := ioutil.ReadFile(s.path)
+    if os.IsNotExist(err) {
+        return nil
+    }
+    if err != nil {
+        return fmt.Errorf("Could not read state file at %s: %w", s.path, err)
+    }
+
+    // Decode state; discard outdated or corrupt state so it is synced from scratch
+    var state stateFile
+    if err := json.Unmarshal(bytes, &state); err != nil || state.Version != StateVersion {
+        return nil
+    }
+
+    // Set state
+    s.state = &state
+    for _, mp := range state.Minipools {
+        s.minipools[mp.Address] = mp
+    }
+    return nil
+
+}
+
+
+// Save the state to disk
+func (s *StateStore) save() error {
+
+    // Encode state
+    bytes, err := json.Marshal(s.state)
+    if err != nil {
+        return fmt.Errorf("Could not encode state: %w", err)
+    }
+
+    // Write to a temporary file and replace the state file
+    if err := os.MkdirAll(filepath.Dir(s.path), DirMode); err != nil {
+        return fmt.Errorf("Could not create state folder: %w", err)
+    }
+    tmpPath := s.path + ".tmp"
+    if err := ioutil.WriteFile(tmpPath, bytes, FileMode); err != nil {
+        return fmt.Errorf("Could not write state file: %w", err)
+    }
+    if err := os.Rename(tmpPath, s.path); err != nil {
+        return fmt.Errorf("Could not write state file: %w", err)
+    }
+
+    // Return
+    return nil
+
+}
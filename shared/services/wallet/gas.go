@@ -0,0 +1,242 @@
+package wallet
+
+import (
+    "context"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/ethereum/go-ethereum/rpc"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Config
+const (
+    PlaceholderGasLimit = 12000000
+    DefaultPriorityFeeGwei = 2
+)
+
+
+// Gas pricing settings
+// Strategies:
+// - fixed: use the configured gas price, or the node's suggested price if not set
+// - oracle: use the node's suggested price scaled by the multiplier
+// - basefee: send EIP-1559 transactions with the priority fee as the tip, and the latest block base fee scaled by the multiplier
+//   plus the tip as the fee cap; falls back to the oracle strategy on chains without a base fee
+// The maximum gas price caps the gas price or fee cap on every strategy; an explicit gas price above the maximum is an error
+// Gas limits are estimated and scaled by the gas limit multiplier if set (must be at least 1)
+type GasSettings struct {
+    Strategy string
+    Multiplier float64
+    MaxGasPrice *big.Int
+    PriorityFee *big.Int
+    GasLimitMultiplier float64
+}
+
+
+// Gas fees for a node account transaction
+// Legacy transactions have a gas price, and EIP-1559 transactions have a fee cap & tip instead
+// A transaction with no fees set has them suggested by the node when it is sent
+type gasFees struct {
+    gasPrice *big.Int
+    feeCap *big.Int
+    tip *big.Int
+}
+
+
+// Set the gas pricing settings for node account transactions
+func (w *Wallet) SetGasSettings(ec *ethclient.Client, rc *rpc.Client, settings GasSettings) {
+    if settings.Multiplier <= 0 {
+        settings.Multiplier = 1
+    }
+    w.ec = ec
+    w.rc = rc
+    w.gasSettings = &settings
+}
+
+
+// Get the gas fees for a new node account transaction
+func (w *Wallet) getGasFees() (gasFees, error) {
+
+    // Check explicit gas price against the maximum
+    if w.gasPrice != nil {
+        if w.gasSettings != nil && w.gasSettings.MaxGasPrice != nil && w.gasPrice.Cmp(w.gasSettings.MaxGasPrice) > 0 {
+            return gasFees{}, fmt.Errorf("The gas price of %.6f gwei is above the maximum gas price of %.6f gwei", eth.WeiToGwei(w.gasPrice), eth.WeiToGwei(w.gasSettings.MaxGasPrice))
+        }
+        return gasFees{gasPrice: new(big.Int).Set(w.gasPrice)}, nil
+    }
+
+    // Use node suggested fees if no pricing settings are set
+    if w.gasSettings == nil {
+        return gasFees{}, nil
+    }
+
+    // Get gas fees by strategy
+    var fees gasFees
+    var err error
+    switch {
+        case w.gasSettings.Strategy == "oracle":
+            fees.gasPrice, err = w.getOracleGasPrice()
+        case w.gasSettings.Strategy == "basefee":
+            fees, err = w.getBaseFeeGasFees()
+        case w.gasSettings.MaxGasPrice != nil:
+            // The node's suggested price is requested up front so that it can be capped
+            fees.gasPrice, err = w.getSuggestedGasPrice()
+        default:
+            return gasFees{}, nil
+    }
+    if err != nil {
+        return gasFees{}, err
+    }
+
+    // Cap gas price or fee cap; the tip can't be above the fee cap
+    if maxGasPrice := w.gasSettings.MaxGasPrice; maxGasPrice != nil {
+        if fees.gasPrice != nil && fees.gasPrice.Cmp(maxGasPrice) > 0 {
+            fees.gasPrice = new(big.Int).Set(maxGasPrice)
+        }
+        if fees.feeCap != nil && fees.feeCap.Cmp(maxGasPrice) > 0 {
+            fees.feeCap = new(big.Int).Set(maxGasPrice)
+        }
+        if fees.tip != nil && fees.tip.Cmp(fees.feeCap) > 0 {
+            fees.tip = new(big.Int).Set(fees.feeCap)
+        }
+    }
+
+    // Return
+    return fees, nil
+
+}
+
+
+// Get the node's suggested gas price
+func (w *Wallet) getSuggestedGasPrice() (*big.Int, error) {
+    suggestedGasPrice, err := w.ec.SuggestGasPrice(context.Background())
+    if err != nil {
+        return nil, fmt.Errorf("Could not get suggested gas price: %w", err)
+    }
+    return suggestedGasPrice, nil
+}
+
+
+// Get the node's suggested gas price scaled by the multiplier
+func (w *Wallet) getOracleGasPrice() (*big.Int, error) {
+    suggestedGasPrice, err := w.getSuggestedGasPrice()
+    if err != nil {
+        return nil, err
+    }
+    return multiplyWei(suggestedGasPrice, w.gasSettings.Multiplier), nil
+}
+
+
+// Get EIP-1559 fees from the latest block base fee scaled by the multiplier, with the priority fee as the tip
+// Falls back to a legacy gas price from the node's suggestion if the chain does not support EIP-1559
+func (w *Wallet) getBaseFeeGasFees() (gasFees, error) {
+
+    // Get latest block base fee
+    var block struct {
+        BaseFee *hexutil.Big `json:"baseFeePerGas"`
+    }
+    if err := w.rc.CallContext(context.Background(), &block, "eth_getBlockByNumber", "latest", false); err != nil {
+        return gasFees{}, fmt.Errorf("Could not get latest block base fee: %w", err)
+    }
+    if block.BaseFee == nil {
+        gasPrice, err := w.getOracleGasPrice()
+        return gasFees{gasPrice: gasPrice}, err
+    }
+
+    // Get tip
+    tip := w.gasSettings.PriorityFee
+    if tip == nil {
+        tip = eth.GweiToWei(DefaultPriorityFeeGwei)
+    }
+
+    // Calculate fee cap & return fees
+    feeCap := multiplyWei(block.BaseFee.ToInt(), w.gasSettings.Multiplier)
+    feeCap.Add(feeCap, tip)
+    return gasFees{feeCap: feeCap, tip: new(big.Int).Set(tip)}, nil
+
+}
+
+
+// Check whether gas limits should be estimated and scaled by the gas limit multiplier when signing
+func (w *Wallet) estimatesGasLimit() bool {
+    return w.gasLimit == 0 && w.gasSettings != nil && w.gasSettings.GasLimitMultiplier >= 1 && !w.dryRun
+}
+
+
+// Replace a transaction's gas limit with an estimate scaled by the gas limit multiplier
+func (w *Wallet) withEstimatedGasLimit(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+
+    // Estimate gas limit
+    gasEstimate, err := w.ec.EstimateGas(context.Background(), getCallMsg(from, tx))
+    if err != nil {
+        return nil, fmt.Errorf("Could not estimate gas needed: %w", err)
+    }
+    gasLimit := uint64(float64(gasEstimate) * w.gasSettings.GasLimitMultiplier)
+
+    // Return transaction with updated gas limit
+    return withTransactionParams(tx, tx.Nonce(), gasLimit, getTransactionFees(tx)), nil
+
+}
+
+
+// Get the gas fees of a transaction
+func getTransactionFees(tx *types.Transaction) gasFees {
+    if tx.Type() == types.DynamicFeeTxType {
+        return gasFees{feeCap: tx.GasFeeCap(), tip: tx.GasTipCap()}
+    }
+    return gasFees{gasPrice: tx.GasPrice()}
+}
+
+
+// Get a call message to estimate the gas needed by a transaction
+func getCallMsg(from common.Address, tx *types.Transaction) ethereum.CallMsg {
+    fees := getTransactionFees(tx)
+    return ethereum.CallMsg{
+        From: from,
+        To: tx.To(),
+        GasPrice: fees.gasPrice,
+        GasFeeCap: fees.feeCap,
+        GasTipCap: fees.tip,
+        Value: tx.Value(),
+        Data: tx.Data(),
+    }
+}
+
+
+// Copy a transaction with a new nonce, gas limit & gas fees
+// The copy is an EIP-1559 transaction if the fees have a fee cap, or a legacy transaction otherwise
+func withTransactionParams(tx *types.Transaction, nonce uint64, gasLimit uint64, fees gasFees) *types.Transaction {
+    if fees.feeCap != nil {
+        return types.NewTx(&types.DynamicFeeTx{
+            Nonce: nonce,
+            GasTipCap: fees.tip,
+            GasFeeCap: fees.feeCap,
+            Gas: gasLimit,
+            To: tx.To(),
+            Value: tx.Value(),
+            Data: tx.Data(),
+            AccessList: tx.AccessList(),
+        })
+    }
+    return types.NewTx(&types.LegacyTx{
+        Nonce: nonce,
+        GasPrice: fees.gasPrice,
+        Gas: gasLimit,
+        To: tx.To(),
+        Value: tx.Value(),
+        Data: tx.Data(),
+    })
+}
+
+
+// Multiply a wei amount by a float multiplier
+func multiplyWei(wei *big.Int, multiplier float64) *big.Int {
+    result, _ := new(big.Float).Mul(new(big.Float).SetInt(wei), big.NewFloat(multiplier)).Int(nil)
+    return result
+}
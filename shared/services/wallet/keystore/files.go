@@ -0,0 +1,20 @@
+package keystore
+
+import (
+    "io/ioutil"
+    "os"
+    "path/filepath"
+)
+
+
+// Write a file to a temporary path and move it into place, so that a partial write is never left behind
+func WriteFileAtomic(path string, data []byte) error {
+    if err := os.MkdirAll(filepath.Dir(path), DirMode); err != nil {
+        return err
+    }
+    tmpPath := path + ".tmp"
+    if err := ioutil.WriteFile(tmpPath, data, FileMode); err != nil {
+        return err
+    }
+    return os.Rename(tmpPath, path)
+}
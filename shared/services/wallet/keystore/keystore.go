@@ -1,12 +1,91 @@
 package keystore
 
 import (
+    "bytes"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "path/filepath"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
     eth2types "github.com/wealdtech/go-eth2-types/v2"
+    eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+    hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
 )
 
 
+// Returned when a keystore holds a validator key but cannot provide it, as with remote signers
+var ErrKeyUnavailable = errors.New("Validator key is held by the keystore but cannot be loaded")
+
+
 // Validator keystore interface
+// StagePasswordChange writes files which depend on the node password re-encrypted with the new password, at their paths with the staged file suffix, and returns their paths
 type Keystore interface {
     StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error
+    ListValidatorKeys() ([]rptypes.ValidatorPubkey, error)
+    LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
+    DeleteValidatorKey(pubkey rptypes.ValidatorPubkey) error
+    StagePasswordChange(currentPassword, newPassword string) ([]string, error)
+}
+
+
+// Keystore which holds slashing protection history itself, as with remote signers
+// Local validator clients keep history in their own databases, which are exported and imported with their own tools
+type SlashingProtectionKeystore interface {
+    LoadSlashingProtection() (*eth2.SlashingProtectionInterchange, error)
+    StoreSlashingProtection(interchange *eth2.SlashingProtectionInterchange) error
+}
+
+
+// Get a validator pubkey from a key file or folder name, which is a prefixed pubkey hex string with an optional extension
+// Returns false if the name is not a validator pubkey
+func PubkeyFromFileName(name string) (rptypes.ValidatorPubkey, bool) {
+    pubkeyHex := hexutil.RemovePrefix(name[:len(name) - len(filepath.Ext(name))])
+    pubkeyBytes, err := hex.DecodeString(pubkeyHex)
+    if err != nil || len(pubkeyBytes) != rptypes.ValidatorPubkeyLength {
+        return rptypes.ValidatorPubkey{}, false
+    }
+    return rptypes.BytesToValidatorPubkey(pubkeyBytes), true
+}
+
+
+// Decrypt an encrypted validator key and check that it matches its pubkey
+func DecryptValidatorKey(encryptor *eth2ks.Encryptor, crypto map[string]interface{}, password string, pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+
+    // Decrypt key
+    keyBytes, err := encryptor.Decrypt(crypto, password)
+    if err != nil {
+        return nil, fmt.Errorf("Could not decrypt validator key: %w", err)
+    }
+    key, err := eth2types.BLSPrivateKeyFromBytes(keyBytes)
+    if err != nil {
+        return nil, fmt.Errorf("Could not decode validator key: %w", err)
+    }
+
+    // Check pubkey
+    if !bytes.Equal(key.PublicKey().Marshal(), pubkey.Bytes()) {
+        return nil, errors.New("Validator key does not match its public key")
+    }
+
+    // Return
+    return key, nil
+
+}
+
+
+// Decrypt an encrypted validator key with the first of a set of candidate passwords which succeeds
+// Used when changing passwords, as keys may already be encrypted with the new password if a previous change was interrupted
+func DecryptValidatorKeyWithPasswords(encryptor *eth2ks.Encryptor, crypto map[string]interface{}, passwords []string, pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+    err := errors.New("No validator key password available")
+    for _, password := range passwords {
+        if password == "" { continue }
+        var key *eth2types.BLSPrivateKey
+        if key, err = DecryptValidatorKey(encryptor, crypto, password, pubkey); err == nil {
+            return key, nil
+        }
+    }
+    return nil, err
 }
 
@@ -1,6 +1,7 @@
 package lighthouse
 
 import (
+    "bytes"
     "encoding/json"
     "fmt"
     "io/ioutil"
@@ -12,7 +13,7 @@ import (
     eth2types "github.com/wealdtech/go-eth2-types/v2"
     eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 
-    "github.com/rocket-pool/smartnode/shared/services/passwords"
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
     hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
 )
 
@@ -31,7 +32,6 @@ const (
 // Lighthouse keystore
 type Keystore struct {
     keystorePath string
-    pm *passwords.PasswordManager
     encryptor *eth2ks.Encryptor
 }
 
@@ -47,10 +47,9 @@ type validatorKey struct {
 
 
 // Create new lighthouse keystore
-func NewKeystore(keystorePath string, passwordManager *passwords.PasswordManager) *Keystore {
+func NewKeystore(keystorePath string) *Keystore {
     return &Keystore{
         keystorePath: keystorePath,
-        pm: passwordManager,
         encryptor: eth2ks.New(eth2ks.WithCipher("scrypt")),
     }
 }
@@ -62,14 +61,14 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
     // Get validator pubkey
     pubkey := rptypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
 
-    // Get wallet password
-    password, err := ks.pm.GetPassword()
+    // Generate secret
+    secret, err := keystore.GenerateSecret()
     if err != nil {
-        return fmt.Errorf("Could not get wallet password: %w", err)
+        return err
     }
 
     // Encrypt key
-    encryptedKey, err := ks.encryptor.Encrypt(key.Marshal(), password)
+    encryptedKey, err := ks.encryptor.Encrypt(key.Marshal(), secret)
     if err != nil {
         return fmt.Errorf("Could not encrypt validator key: %w", err)
     }
@@ -90,7 +89,7 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
     }
 
     // Get secret file path
-    secretFilePath := filepath.Join(ks.keystorePath, KeystoreDir, SecretsDir, hexutil.AddPrefix(pubkey.Hex()))
+    secretFilePath := ks.getSecretFilePath(pubkey)
 
     // Create secrets dir
     if err := os.MkdirAll(filepath.Dir(secretFilePath), DirMode); err != nil {
@@ -98,12 +97,12 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
     }
 
     // Write secret to disk
-    if err := ioutil.WriteFile(secretFilePath, []byte(password), FileMode); err != nil {
+    if err := ioutil.WriteFile(secretFilePath, []byte(secret), FileMode); err != nil {
         return fmt.Errorf("Could not write validator secret to disk: %w", err)
     }
 
     // Get key file path
-    keyFilePath := filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir, hexutil.AddPrefix(pubkey.Hex()), KeyFileName)
+    keyFilePath := ks.getKeyFilePath(pubkey)
 
     // Create key dir
     if err := os.MkdirAll(filepath.Dir(keyFilePath), DirMode); err != nil {
@@ -120,3 +119,107 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 
 }
 
+
+// List the validator keys in the keystore
+func (ks *Keystore) ListValidatorKeys() ([]rptypes.ValidatorPubkey, error) {
+
+    // Read validator key folders
+    entries, err := ioutil.ReadDir(filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir))
+    if os.IsNotExist(err) {
+        return []rptypes.ValidatorPubkey{}, nil
+    }
+    if err != nil {
+        return nil, fmt.Errorf("Could not read validator keys folder: %w", err)
+    }
+
+    // Get validator pubkeys from folder names
+    pubkeys := []rptypes.ValidatorPubkey{}
+    for _, entry := range entries {
+        if !entry.IsDir() { continue }
+        if pubkey, ok := keystore.PubkeyFromFileName(entry.Name()); ok {
+            pubkeys = append(pubkeys, pubkey)
+        }
+    }
+
+    // Return
+    return pubkeys, nil
+
+}
+
+
+// Load a validator key from the keystore, decrypting it with its secret
+func (ks *Keystore) LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+
+    // Read key store
+    keyStoreBytes, err := ioutil.ReadFile(ks.getKeyFilePath(pubkey))
+    if err != nil {
+        return nil, fmt.Errorf("Could not read validator key from disk: %w", err)
+    }
+
+    // Decode key store
+    var keyStore validatorKey
+    if err := json.Unmarshal(keyStoreBytes, &keyStore); err != nil {
+        return nil, fmt.Errorf("Could not decode validator key: %w", err)
+    }
+    if !bytes.Equal(keyStore.Pubkey.Bytes(), pubkey.Bytes()) {
+        return nil, fmt.Errorf("Validator key file contains the key for %s", keyStore.Pubkey.Hex())
+    }
+
+    // Read secret
+    password, err := ioutil.ReadFile(ks.getSecretFilePath(pubkey))
+    if err != nil {
+        return nil, fmt.Errorf("Could not read validator secret from disk: %w", err)
+    }
+
+    // Decrypt key
+    return keystore.DecryptValidatorKey(ks.encryptor, keyStore.Crypto, string(password), pubkey)
+
+}
+
+
+// Delete a validator key and its secret from the keystore
+func (ks *Keystore) DeleteValidatorKey(pubkey rptypes.ValidatorPubkey) error {
+    if err := os.RemoveAll(filepath.Dir(ks.getKeyFilePath(pubkey))); err != nil {
+        return fmt.Errorf("Could not delete validator key from disk: %w", err)
+    }
+    if err := os.Remove(ks.getSecretFilePath(pubkey)); err != nil && !os.IsNotExist(err) {
+        return fmt.Errorf("Could not delete validator secret from disk: %w", err)
+    }
+    return nil
+}
+
+
+// Migrate validator keys which use the node password as their secret to random secrets before a node password change
+// Other keys have their own secrets and are unaffected by node password changes, so no files are staged
+func (ks *Keystore) StagePasswordChange(currentPassword, newPassword string) ([]string, error) {
+
+    // Get validator keys
+    pubkeys, err := ks.ListValidatorKeys()
+    if err != nil {
+        return nil, err
+    }
+
+    // Migrate validator keys
+    for _, pubkey := range pubkeys {
+        if err := keystore.MigrateLegacySecret(ks.encryptor, ks.getKeyFilePath(pubkey), ks.getSecretFilePath(pubkey), []string{currentPassword, newPassword}, pubkey); err != nil {
+            return nil, err
+        }
+    }
+
+    // Return
+    return nil, nil
+
+}
+
+
+// Get the path of a validator key file
+func (ks *Keystore) getKeyFilePath(pubkey rptypes.ValidatorPubkey) string {
+    return filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir, hexutil.AddPrefix(pubkey.Hex()), KeyFileName)
+}
+
+
+// Get the path of a validator secret file
+func (ks *Keystore) getSecretFilePath(pubkey rptypes.ValidatorPubkey) string {
+    return filepath.Join(ks.keystorePath, KeystoreDir, SecretsDir, hexutil.AddPrefix(pubkey.Hex()))
+}
+
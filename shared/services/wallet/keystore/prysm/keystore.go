@@ -10,10 +10,12 @@ import (
     "path/filepath"
 
     "github.com/google/uuid"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
     eth2types "github.com/wealdtech/go-eth2-types/v2"
     eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 
     "github.com/rocket-pool/smartnode/shared/services/passwords"
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
 )
 
 
@@ -89,12 +91,125 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
     ks.as.PrivateKeys = append(ks.as.PrivateKeys, key.Marshal())
     ks.as.PublicKeys = append(ks.as.PublicKeys, key.PublicKey().Marshal())
 
-    // Encode account store
-    asBytes, err := json.Marshal(ks.as)
+    // Save account store
+    return ks.save()
+
+}
+
+
+// List the validator keys in the keystore
+func (ks *Keystore) ListValidatorKeys() ([]rptypes.ValidatorPubkey, error) {
+
+    // Initialize the account store
+    if err := ks.initialize(); err != nil {
+        return nil, err
+    }
+
+    // Get validator pubkeys
+    pubkeys := make([]rptypes.ValidatorPubkey, len(ks.as.PublicKeys))
+    for ki, pubkey := range ks.as.PublicKeys {
+        pubkeys[ki] = rptypes.BytesToValidatorPubkey(pubkey)
+    }
+    return pubkeys, nil
+
+}
+
+
+// Load a validator key from the keystore
+func (ks *Keystore) LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+
+    // Initialize the account store
+    if err := ks.initialize(); err != nil {
+        return nil, err
+    }
+
+    // Find validator key in account store
+    for ki := 0; ki < len(ks.as.PublicKeys); ki++ {
+        if !bytes.Equal(pubkey.Bytes(), ks.as.PublicKeys[ki]) { continue }
+
+        // Decode key & check pubkey
+        key, err := eth2types.BLSPrivateKeyFromBytes(ks.as.PrivateKeys[ki])
+        if err != nil {
+            return nil, fmt.Errorf("Could not decode validator key: %w", err)
+        }
+        if !bytes.Equal(key.PublicKey().Marshal(), pubkey.Bytes()) {
+            return nil, errors.New("Validator key does not match its public key")
+        }
+        return key, nil
+
+    }
+
+    // Return not found error
+    return nil, fmt.Errorf("Validator %s key not found in account store", pubkey.Hex())
+
+}
+
+
+// Delete a validator key from the keystore
+func (ks *Keystore) DeleteValidatorKey(pubkey rptypes.ValidatorPubkey) error {
+
+    // Initialize the account store
+    if err := ks.initialize(); err != nil {
+        return err
+    }
+
+    // Remove validator key from account store
+    privateKeys := [][]byte{}
+    publicKeys := [][]byte{}
+    for ki := 0; ki < len(ks.as.PublicKeys); ki++ {
+        if bytes.Equal(pubkey.Bytes(), ks.as.PublicKeys[ki]) { continue }
+        privateKeys = append(privateKeys, ks.as.PrivateKeys[ki])
+        publicKeys = append(publicKeys, ks.as.PublicKeys[ki])
+    }
+
+    // Cancel if validator key was not in account store
+    if len(publicKeys) == len(ks.as.PublicKeys) {
+        return nil
+    }
+
+    // Save account store
+    ks.as.PrivateKeys = privateKeys
+    ks.as.PublicKeys = publicKeys
+    return ks.save()
+
+}
+
+
+// Write the account store re-encrypted with a new password to a staged path before a node password change
+// The staged account store replaces the current one once the password change is committed
+func (ks *Keystore) StagePasswordChange(currentPassword, newPassword string) ([]string, error) {
+
+    // Get keystore file path; cancel if it doesn't exist
+    keystoreFilePath := filepath.Join(ks.keystorePath, KeystoreDir, WalletDir, AccountsDir, KeystoreFileName)
+    if _, err := os.Stat(keystoreFilePath); os.IsNotExist(err) {
+        return nil, nil
+    }
+
+    // Initialize the account store
+    if err := ks.initialize(); err != nil {
+        return nil, err
+    }
+
+    // Encrypt account store with new password
+    ksBytes, err := ks.encodeKeystore(newPassword)
     if err != nil {
-        return fmt.Errorf("Could not encode validator account store: %w", err)
+        return nil, err
     }
 
+    // Write staged keystore to disk
+    if err := keystore.WriteFileAtomic(keystoreFilePath + keystore.StagedFileSuffix, ksBytes); err != nil {
+        return nil, fmt.Errorf("Could not write staged keystore to disk: %w", err)
+    }
+
+    // Return
+    return []string{keystoreFilePath}, nil
+
+}
+
+
+// Encrypt the account store with the wallet password and write it to disk
+func (ks *Keystore) save() error {
+
     // Get wallet password
     password, err := ks.pm.GetPassword()
     if err != nil {
@@ -102,36 +217,17 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
     }
 
     // Encrypt account store
-    asEncrypted, err := ks.encryptor.Encrypt(asBytes, password)
+    ksBytes, err := ks.encodeKeystore(password)
     if err != nil {
-        return fmt.Errorf("Could not encrypt validator account store: %w", err)
-    }
-
-    // Create new keystore
-    keystore := validatorKeystore{
-        Crypto: asEncrypted,
-        Name: ks.encryptor.Name(),
-        Version: ks.encryptor.Version(),
-        UUID: uuid.New(),
-    }
-
-    // Encode key store
-    ksBytes, err := json.Marshal(keystore)
-    if err != nil {
-        return fmt.Errorf("Could not encode validator keystore: %w", err)
+        return err
     }
 
     // Get file paths
     keystoreFilePath := filepath.Join(ks.keystorePath, KeystoreDir, WalletDir, AccountsDir, KeystoreFileName)
     configFilePath := filepath.Join(ks.keystorePath, KeystoreDir, WalletDir, ConfigFileName)
 
-    // Create keystore dir
-    if err := os.MkdirAll(filepath.Dir(keystoreFilePath), DirMode); err != nil {
-        return fmt.Errorf("Could not create keystore folder: %w", err)
-    }
-
     // Write keystore to disk
-    if err := ioutil.WriteFile(keystoreFilePath, ksBytes, FileMode); err != nil {
+    if err := keystore.WriteFileAtomic(keystoreFilePath, ksBytes); err != nil {
         return fmt.Errorf("Could not write keystore to disk: %w", err)
     }
 
@@ -159,6 +255,38 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 }
 
 
+// Encrypt the account store with a password and encode it as a keystore
+func (ks *Keystore) encodeKeystore(password string) ([]byte, error) {
+
+    // Encode account store
+    asBytes, err := json.Marshal(ks.as)
+    if err != nil {
+        return nil, fmt.Errorf("Could not encode validator account store: %w", err)
+    }
+
+    // Encrypt account store
+    asEncrypted, err := ks.encryptor.Encrypt(asBytes, password)
+    if err != nil {
+        return nil, fmt.Errorf("Could not encrypt validator account store: %w", err)
+    }
+
+    // Encode keystore
+    ksBytes, err := json.Marshal(validatorKeystore{
+        Crypto: asEncrypted,
+        Name: ks.encryptor.Name(),
+        Version: ks.encryptor.Version(),
+        UUID: uuid.New(),
+    })
+    if err != nil {
+        return nil, fmt.Errorf("Could not encode validator keystore: %w", err)
+    }
+
+    // Return
+    return ksBytes, nil
+
+}
+
+
 // Initialize the account store
 func (ks *Keystore) initialize() error {
 
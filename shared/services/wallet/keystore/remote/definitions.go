@@ -0,0 +1,173 @@
+package remote
+
+import (
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "gopkg.in/yaml.v2"
+
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+    hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+
+// Config
+// Each validator client is pointed at the remote signer using its own native configuration, written to a client folder in the keystore
+const (
+    LighthouseDir = "lighthouse"
+    LighthouseValidatorsDir = "validators"
+    LighthouseDefinitionsFileName = "validator_definitions.yml"
+    LighthouseSignerType = "web3signer"
+
+    NimbusDir = "nimbus"
+    NimbusValidatorsDir = "validators"
+    NimbusKeystoreFileName = "remote_keystore.json"
+    NimbusKeystoreVersion = 1
+    NimbusSignerType = "web3signer"
+
+    PrysmDir = "prysm"
+    PrysmConfigFileName = "external-signer.yaml"
+
+    TekuDir = "teku"
+    TekuConfigFileName = "external-signer.yaml"
+)
+
+
+// Lighthouse validator definition (--validators-dir)
+type lighthouseValidatorDefinition struct {
+    Enabled bool                        `yaml:"enabled"`
+    VotingPublicKey string              `yaml:"voting_public_key"`
+    Type string                         `yaml:"type"`
+    Url string                          `yaml:"url"`
+}
+
+
+// Nimbus remote keystore (--validators-dir)
+type nimbusRemoteKeystore struct {
+    Version uint                        `json:"version"`
+    Pubkey string                       `json:"pubkey"`
+    Remote string                       `json:"remote"`
+    Type string                         `json:"type"`
+}
+
+
+// Prysm & Teku external signer config file (--config-file)
+type externalSignerConfig struct {
+    Url string                          `yaml:"validators-external-signer-url"`
+    PublicKeys []string                 `yaml:"validators-external-signer-public-keys"`
+}
+
+
+// Write the validator client configuration for keys registered with the remote signer
+func (ks *Keystore) updateValidatorDefinitions() error {
+
+    // Get registered keys
+    pubkeys, err := ks.ListValidatorKeys()
+    if err != nil {
+        return err
+    }
+
+    // Write client configuration
+    if err := ks.writeLighthouseDefinitions(pubkeys); err != nil {
+        return fmt.Errorf("Could not write lighthouse remote signer configuration: %w", err)
+    }
+    if err := ks.writeNimbusKeystores(pubkeys); err != nil {
+        return fmt.Errorf("Could not write nimbus remote signer configuration: %w", err)
+    }
+    if err := ks.writeExternalSignerConfig(filepath.Join(ks.keystorePath, KeystoreDir, PrysmDir, PrysmConfigFileName), pubkeys); err != nil {
+        return fmt.Errorf("Could not write prysm remote signer configuration: %w", err)
+    }
+    if err := ks.writeExternalSignerConfig(filepath.Join(ks.keystorePath, KeystoreDir, TekuDir, TekuConfigFileName), pubkeys); err != nil {
+        return fmt.Errorf("Could not write teku remote signer configuration: %w", err)
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Write the lighthouse validator definitions file, defining a web3signer validator for each key
+func (ks *Keystore) writeLighthouseDefinitions(pubkeys []rptypes.ValidatorPubkey) error {
+    definitions := make([]lighthouseValidatorDefinition, len(pubkeys))
+    for pi, pubkey := range pubkeys {
+        definitions[pi] = lighthouseValidatorDefinition{
+            Enabled: true,
+            VotingPublicKey: hexutil.AddPrefix(pubkey.Hex()),
+            Type: LighthouseSignerType,
+            Url: ks.signerUrl,
+        }
+    }
+    definitionsBytes, err := yaml.Marshal(definitions)
+    if err != nil {
+        return err
+    }
+    return keystore.WriteFileAtomic(filepath.Join(ks.keystorePath, KeystoreDir, LighthouseDir, LighthouseValidatorsDir, LighthouseDefinitionsFileName), definitionsBytes)
+}
+
+
+// Write a nimbus remote keystore for each key, and remove remote keystores for keys no longer registered
+// Only validator folders are removed, as nimbus keeps its slashing protection database in the validators folder
+func (ks *Keystore) writeNimbusKeystores(pubkeys []rptypes.ValidatorPubkey) error {
+
+    // Get validators dir
+    validatorsDir := filepath.Join(ks.keystorePath, KeystoreDir, NimbusDir, NimbusValidatorsDir)
+
+    // Write remote keystores
+    registered := make(map[string]bool, len(pubkeys))
+    for _, pubkey := range pubkeys {
+        pubkeyHex := hexutil.AddPrefix(pubkey.Hex())
+        registered[pubkeyHex] = true
+        keystoreBytes, err := json.Marshal(nimbusRemoteKeystore{
+            Version: NimbusKeystoreVersion,
+            Pubkey: pubkeyHex,
+            Remote: ks.signerUrl,
+            Type: NimbusSignerType,
+        })
+        if err != nil {
+            return err
+        }
+        if err := keystore.WriteFileAtomic(filepath.Join(validatorsDir, pubkeyHex, NimbusKeystoreFileName), keystoreBytes); err != nil {
+            return err
+        }
+    }
+
+    // Remove unregistered remote keystores
+    entries, err := ioutil.ReadDir(validatorsDir)
+    if err != nil && !os.IsNotExist(err) {
+        return err
+    }
+    for _, entry := range entries {
+        if !entry.IsDir() { continue }
+        pubkey, ok := keystore.PubkeyFromFileName(entry.Name())
+        if !ok || registered[hexutil.AddPrefix(pubkey.Hex())] { continue }
+        if err := os.RemoveAll(filepath.Join(validatorsDir, entry.Name())); err != nil {
+            return err
+        }
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Write a prysm or teku config file setting the external signer URL and public keys
+func (ks *Keystore) writeExternalSignerConfig(path string, pubkeys []rptypes.ValidatorPubkey) error {
+    config := externalSignerConfig{
+        Url: ks.signerUrl,
+        PublicKeys: make([]string, len(pubkeys)),
+    }
+    for pi, pubkey := range pubkeys {
+        config.PublicKeys[pi] = hexutil.AddPrefix(pubkey.Hex())
+    }
+    configBytes, err := yaml.Marshal(config)
+    if err != nil {
+        return err
+    }
+    return keystore.WriteFileAtomic(path, configBytes)
+}
@@ -0,0 +1,334 @@
+package remote
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "net/http"
+    "path/filepath"
+    "strings"
+    "time"
+
+    "github.com/google/uuid"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    eth2types "github.com/wealdtech/go-eth2-types/v2"
+    eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+    hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+
+// Config
+const (
+    KeystoreDir = "remote-signer"
+
+    RequestContentType = "application/json"
+    RequestKeystoresPath = "/eth/v1/keystores"
+    RequestTimeout = 30 * time.Second
+)
+
+
+// Remote signer keystore
+// Validator keys are registered with a remote signer (e.g. Web3Signer) over the standard key manager API, rather than written to disk
+type Keystore struct {
+    keystorePath string
+    signerUrl string
+    tokenPath string
+    client *http.Client
+    encryptor *eth2ks.Encryptor
+}
+
+
+// Encrypted validator key store
+type validatorKey struct {
+    Crypto map[string]interface{}   `json:"crypto"`
+    Version uint                    `json:"version"`
+    UUID uuid.UUID                  `json:"uuid"`
+    Path string                     `json:"path"`
+    Pubkey rptypes.ValidatorPubkey  `json:"pubkey"`
+}
+
+
+// Key manager API types
+type listKeystoresResponse struct {
+    Data []keystoreInfo                 `json:"data"`
+}
+type keystoreInfo struct {
+    ValidatingPubkey string             `json:"validating_pubkey"`
+    DerivationPath string               `json:"derivation_path,omitempty"`
+    Readonly bool                       `json:"readonly"`
+}
+type importKeystoresRequest struct {
+    Keystores []string                  `json:"keystores"`
+    Passwords []string                  `json:"passwords"`
+    SlashingProtection string           `json:"slashing_protection,omitempty"`
+}
+type deleteKeystoresRequest struct {
+    Pubkeys []string                    `json:"pubkeys"`
+}
+type keystoresResponse struct {
+    Data []keystoreStatus               `json:"data"`
+    SlashingProtection string           `json:"slashing_protection,omitempty"`
+}
+type keystoreStatus struct {
+    Status string                       `json:"status"`
+    Message string                      `json:"message,omitempty"`
+}
+
+
+// Create new remote signer keystore
+// The token path is optional; if set, its contents are sent as a bearer token with each request
+func NewKeystore(keystorePath, signerUrl, tokenPath string) *Keystore {
+    return &Keystore{
+        keystorePath: keystorePath,
+        signerUrl: strings.TrimSuffix(signerUrl, "/"),
+        tokenPath: tokenPath,
+        client: &http.Client{Timeout: RequestTimeout},
+        encryptor: eth2ks.New(eth2ks.WithCipher("scrypt")),
+    }
+}
+
+
+// Register a validator key with the remote signer
+// Slashing protection history stored for the key is imported along with it
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+    // Get validator pubkey
+    pubkey := rptypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+    // Generate a password for the key; the remote signer stores it alongside the key
+    password, err := keystore.GenerateSecret()
+    if err != nil {
+        return err
+    }
+
+    // Encrypt key
+    encryptedKey, err := ks.encryptor.Encrypt(key.Marshal(), password)
+    if err != nil {
+        return fmt.Errorf("Could not encrypt validator key: %w", err)
+    }
+
+    // Encode key store
+    keyStoreBytes, err := json.Marshal(validatorKey{
+        Crypto: encryptedKey,
+        Version: ks.encryptor.Version(),
+        UUID: uuid.New(),
+        Path: derivationPath,
+        Pubkey: pubkey,
+    })
+    if err != nil {
+        return fmt.Errorf("Could not encode validator key: %w", err)
+    }
+
+    // Build request
+    request := importKeystoresRequest{
+        Keystores: []string{string(keyStoreBytes)},
+        Passwords: []string{password},
+    }
+
+    // Get stored slashing protection history for the key
+    history, err := ks.LoadSlashingProtection()
+    if err != nil {
+        return err
+    }
+    if history != nil && history.GetData(pubkey) != nil {
+        keyHistory := eth2.SlashingProtectionInterchange{Metadata: history.Metadata}
+        if err := keyHistory.Merge(history, []rptypes.ValidatorPubkey{pubkey}); err != nil {
+            return err
+        }
+        historyBytes, err := json.Marshal(keyHistory)
+        if err != nil {
+            return fmt.Errorf("Could not encode slashing protection history: %w", err)
+        }
+        request.SlashingProtection = string(historyBytes)
+    }
+
+    // Import key
+    var response keystoresResponse
+    if err := ks.request(http.MethodPost, request, &response); err != nil {
+        return fmt.Errorf("Could not register validator key with remote signer: %w", err)
+    }
+    if err := checkStatus(response, "imported", "duplicate"); err != nil {
+        return fmt.Errorf("Could not register validator key with remote signer: %w", err)
+    }
+
+    // Update validator client configuration
+    return ks.updateValidatorDefinitions()
+
+}
+
+
+// List the validator keys registered with the remote signer
+func (ks *Keystore) ListValidatorKeys() ([]rptypes.ValidatorPubkey, error) {
+
+    // List keys
+    var response listKeystoresResponse
+    if err := ks.request(http.MethodGet, nil, &response); err != nil {
+        return nil, fmt.Errorf("Could not list remote signer validator keys: %w", err)
+    }
+
+    // Decode pubkeys
+    pubkeys := make([]rptypes.ValidatorPubkey, len(response.Data))
+    for ki, key := range response.Data {
+        pubkey, err := rptypes.HexToValidatorPubkey(hexutil.RemovePrefix(key.ValidatingPubkey))
+        if err != nil {
+            return nil, fmt.Errorf("Invalid validator pubkey '%s' from remote signer: %w", key.ValidatingPubkey, err)
+        }
+        pubkeys[ki] = pubkey
+    }
+
+    // Return
+    return pubkeys, nil
+
+}
+
+
+// Remote signers never export private keys, so registered keys are only checked for presence
+func (ks *Keystore) LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+    pubkeys, err := ks.ListValidatorKeys()
+    if err != nil {
+        return nil, err
+    }
+    for _, registeredPubkey := range pubkeys {
+        if bytes.Equal(registeredPubkey.Bytes(), pubkey.Bytes()) {
+            return nil, keystore.ErrKeyUnavailable
+        }
+    }
+    return nil, errors.New("Validator key is not registered with the remote signer")
+}
+
+
+// Remove a validator key from the remote signer
+// Slashing protection history returned by the remote signer is merged into the stored history
+func (ks *Keystore) DeleteValidatorKey(pubkey rptypes.ValidatorPubkey) error {
+
+    // Delete key
+    var response keystoresResponse
+    if err := ks.request(http.MethodDelete, deleteKeystoresRequest{Pubkeys: []string{hexutil.AddPrefix(pubkey.Hex())}}, &response); err != nil {
+        return fmt.Errorf("Could not remove validator key from remote signer: %w", err)
+    }
+    if err := checkStatus(response, "deleted", "not_active", "not_found"); err != nil {
+        return fmt.Errorf("Could not remove validator key from remote signer: %w", err)
+    }
+
+    // Store returned slashing protection history
+    if response.SlashingProtection != "" {
+        keyHistory, err := eth2.ParseSlashingProtectionInterchange([]byte(response.SlashingProtection))
+        if err != nil {
+            return err
+        }
+        history, err := ks.LoadSlashingProtection()
+        if err != nil {
+            return err
+        }
+        if history == nil {
+            history = keyHistory
+        } else if err := history.Merge(keyHistory, nil); err != nil {
+            return err
+        }
+        if err := ks.StoreSlashingProtection(history); err != nil {
+            return err
+        }
+    }
+
+    // Update validator client configuration
+    return ks.updateValidatorDefinitions()
+
+}
+
+
+// Keys are registered with the remote signer using their own generated passwords, so there is nothing to re-encrypt
+func (ks *Keystore) StagePasswordChange(currentPassword, newPassword string) ([]string, error) {
+    return nil, nil
+}
+
+
+// Load the slashing protection history stored for keys registered with the remote signer
+// Returns nil if no history is stored
+func (ks *Keystore) LoadSlashingProtection() (*eth2.SlashingProtectionInterchange, error) {
+    return keystore.LoadSlashingProtection(filepath.Join(ks.keystorePath, KeystoreDir, keystore.SlashingProtectionFileName))
+}
+
+
+// Store slashing protection history to be imported into the remote signer along with validator keys
+func (ks *Keystore) StoreSlashingProtection(interchange *eth2.SlashingProtectionInterchange) error {
+    return keystore.StoreSlashingProtection(filepath.Join(ks.keystorePath, KeystoreDir, keystore.SlashingProtectionFileName), interchange)
+}
+
+
+// Make a request to the remote signer's key manager API and decode the response
+func (ks *Keystore) request(method string, requestBody interface{}, responseBody interface{}) error {
+
+    // Get request body
+    var requestBodyReader *bytes.Reader
+    if requestBody != nil {
+        requestBodyBytes, err := json.Marshal(requestBody)
+        if err != nil {
+            return fmt.Errorf("Could not encode request: %w", err)
+        }
+        requestBodyReader = bytes.NewReader(requestBodyBytes)
+    } else {
+        requestBodyReader = bytes.NewReader([]byte{})
+    }
+
+    // Build request
+    request, err := http.NewRequest(method, ks.signerUrl + RequestKeystoresPath, requestBodyReader)
+    if err != nil {
+        return err
+    }
+    if requestBody != nil {
+        request.Header.Set("Content-Type", RequestContentType)
+    }
+    if ks.tokenPath != "" {
+        token, err := ioutil.ReadFile(ks.tokenPath)
+        if err != nil {
+            return fmt.Errorf("Could not read remote signer token at %s: %w", ks.tokenPath, err)
+        }
+        request.Header.Set("Authorization", "Bearer " + strings.TrimSpace(string(token)))
+    }
+
+    // Send request
+    response, err := ks.client.Do(request)
+    if err != nil {
+        return err
+    }
+    defer response.Body.Close()
+
+    // Get response
+    body, err := ioutil.ReadAll(response.Body)
+    if err != nil {
+        return err
+    }
+    if response.StatusCode != http.StatusOK {
+        return fmt.Errorf("HTTP status %d; response body: '%s'", response.StatusCode, string(body))
+    }
+
+    // Decode response
+    if err := json.Unmarshal(body, responseBody); err != nil {
+        return fmt.Errorf("Could not decode response: %w", err)
+    }
+    return nil
+
+}
+
+
+// Check the status of a single key in a key manager API response
+func checkStatus(response keystoresResponse, validStatuses ...string) error {
+    if len(response.Data) != 1 {
+        return fmt.Errorf("Expected 1 key status, got %d", len(response.Data))
+    }
+    status := response.Data[0]
+    for _, validStatus := range validStatuses {
+        if status.Status == validStatus {
+            return nil
+        }
+    }
+    if status.Message != "" {
+        return fmt.Errorf("Key status '%s': %s", status.Status, status.Message)
+    }
+    return fmt.Errorf("Key status '%s'", status.Status)
+}
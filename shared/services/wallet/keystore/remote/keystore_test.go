@@ -0,0 +1,208 @@
+package remote
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "io/ioutil"
+    "net/http/httptest"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    eth2types "github.com/wealdtech/go-eth2-types/v2"
+    "gopkg.in/yaml.v2"
+
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+    hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+
+// Create a remote signer keystore backed by a mock signer
+func newTestKeystore(t *testing.T, token string) (*Keystore, string) {
+    server := httptest.NewServer(newMockSigner(token))
+    t.Cleanup(server.Close)
+    keystorePath := t.TempDir()
+    tokenPath := ""
+    if token != "" {
+        tokenPath = filepath.Join(keystorePath, "token")
+        if err := ioutil.WriteFile(tokenPath, []byte(token + "\n"), 0600); err != nil {
+            t.Fatal(err)
+        }
+    }
+    return NewKeystore(keystorePath, server.URL + "/", tokenPath), keystorePath
+}
+
+
+// Generate a validator key
+func newTestKey(t *testing.T) (*eth2types.BLSPrivateKey, rptypes.ValidatorPubkey) {
+    if err := eth2types.InitBLS(); err != nil {
+        t.Fatal(err)
+    }
+    key, err := eth2types.GenerateBLSPrivateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    return key, rptypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+}
+
+
+func TestStoreValidatorKey(t *testing.T) {
+    ks, keystorePath := newTestKeystore(t, "")
+    key, pubkey := newTestKey(t)
+    pubkeyHex := hexutil.AddPrefix(pubkey.Hex())
+
+    // Store key, twice to check duplicates are accepted
+    for i := 0; i < 2; i++ {
+        if err := ks.StoreValidatorKey(key, "m/12381/3600/0/0/0"); err != nil {
+            t.Fatalf("Could not store validator key: %s", err)
+        }
+    }
+
+    // Check key is listed
+    pubkeys, err := ks.ListValidatorKeys()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(pubkeys) != 1 || !bytes.Equal(pubkeys[0].Bytes(), pubkey.Bytes()) {
+        t.Fatalf("Incorrect validator keys listed: %v", pubkeys)
+    }
+
+    // Check key is held but unavailable
+    if _, err := ks.LoadValidatorKey(pubkey); !errors.Is(err, keystore.ErrKeyUnavailable) {
+        t.Errorf("Expected key unavailable error, got %v", err)
+    }
+
+    // Check lighthouse validator definitions
+    definitionsBytes, err := ioutil.ReadFile(filepath.Join(keystorePath, KeystoreDir, LighthouseDir, LighthouseValidatorsDir, LighthouseDefinitionsFileName))
+    if err != nil {
+        t.Fatal(err)
+    }
+    var definitions []lighthouseValidatorDefinition
+    if err := yaml.Unmarshal(definitionsBytes, &definitions); err != nil {
+        t.Fatal(err)
+    }
+    if len(definitions) != 1 || definitions[0].VotingPublicKey != pubkeyHex || definitions[0].Type != LighthouseSignerType || definitions[0].Url != ks.signerUrl || !definitions[0].Enabled {
+        t.Errorf("Incorrect lighthouse validator definitions: %+v", definitions)
+    }
+
+    // Check nimbus remote keystore
+    nimbusKeystoreBytes, err := ioutil.ReadFile(filepath.Join(keystorePath, KeystoreDir, NimbusDir, NimbusValidatorsDir, pubkeyHex, NimbusKeystoreFileName))
+    if err != nil {
+        t.Fatal(err)
+    }
+    var nimbusKeystore nimbusRemoteKeystore
+    if err := json.Unmarshal(nimbusKeystoreBytes, &nimbusKeystore); err != nil {
+        t.Fatal(err)
+    }
+    if nimbusKeystore.Pubkey != pubkeyHex || nimbusKeystore.Remote != ks.signerUrl || nimbusKeystore.Type != NimbusSignerType {
+        t.Errorf("Incorrect nimbus remote keystore: %+v", nimbusKeystore)
+    }
+
+    // Check prysm & teku external signer config
+    for _, path := range []string{
+        filepath.Join(keystorePath, KeystoreDir, PrysmDir, PrysmConfigFileName),
+        filepath.Join(keystorePath, KeystoreDir, TekuDir, TekuConfigFileName),
+    } {
+        configBytes, err := ioutil.ReadFile(path)
+        if err != nil {
+            t.Fatal(err)
+        }
+        var config externalSignerConfig
+        if err := yaml.Unmarshal(configBytes, &config); err != nil {
+            t.Fatal(err)
+        }
+        if config.Url != ks.signerUrl || len(config.PublicKeys) != 1 || config.PublicKeys[0] != pubkeyHex {
+            t.Errorf("Incorrect external signer config at %s: %+v", path, config)
+        }
+    }
+
+}
+
+
+func TestDeleteValidatorKey(t *testing.T) {
+    ks, keystorePath := newTestKeystore(t, "")
+    key, pubkey := newTestKey(t)
+    pubkeyHex := hexutil.AddPrefix(pubkey.Hex())
+
+    // Store slashing protection history for the key, then remove it from the store once imported with the key
+    history := eth2.NewSlashingProtectionInterchange(make([]byte, 32))
+    history.Data = append(history.Data, eth2.SlashingProtectionData{
+        Pubkey: pubkeyHex,
+        SignedBlocks: []eth2.SignedBlock{{Slot: 100}},
+        SignedAttestations: []eth2.SignedAttestation{{SourceEpoch: 2, TargetEpoch: 3}},
+    })
+    if err := ks.StoreSlashingProtection(history); err != nil {
+        t.Fatal(err)
+    }
+    if err := ks.StoreValidatorKey(key, "m/12381/3600/0/0/0"); err != nil {
+        t.Fatalf("Could not store validator key: %s", err)
+    }
+    if err := ks.StoreSlashingProtection(eth2.NewSlashingProtectionInterchange(make([]byte, 32))); err != nil {
+        t.Fatal(err)
+    }
+
+    // Delete key, twice to check missing keys are accepted
+    for i := 0; i < 2; i++ {
+        if err := ks.DeleteValidatorKey(pubkey); err != nil {
+            t.Fatalf("Could not delete validator key: %s", err)
+        }
+    }
+
+    // Check key is no longer held
+    pubkeys, err := ks.ListValidatorKeys()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(pubkeys) != 0 {
+        t.Errorf("Incorrect validator keys listed: %v", pubkeys)
+    }
+    if _, err := ks.LoadValidatorKey(pubkey); err == nil || errors.Is(err, keystore.ErrKeyUnavailable) {
+        t.Errorf("Expected key not registered error, got %v", err)
+    }
+    if _, err := os.Stat(filepath.Join(keystorePath, KeystoreDir, NimbusDir, NimbusValidatorsDir, pubkeyHex)); !os.IsNotExist(err) {
+        t.Errorf("Nimbus remote keystore was not removed")
+    }
+
+    // Check slashing protection history returned by the signer was stored
+    storedHistory, err := ks.LoadSlashingProtection()
+    if err != nil {
+        t.Fatal(err)
+    }
+    data := storedHistory.GetData(pubkey)
+    if data == nil || len(data.SignedBlocks) != 1 || data.SignedBlocks[0].Slot != 100 || len(data.SignedAttestations) != 1 || data.SignedAttestations[0].TargetEpoch != 3 {
+        t.Errorf("Incorrect slashing protection history stored: %+v", data)
+    }
+
+}
+
+
+func TestToken(t *testing.T) {
+    ks, _ := newTestKeystore(t, "secret")
+    key, _ := newTestKey(t)
+
+    // Check requests succeed with the token
+    if err := ks.StoreValidatorKey(key, "m/12381/3600/0/0/0"); err != nil {
+        t.Fatalf("Could not store validator key: %s", err)
+    }
+
+    // Check requests fail with an incorrect token
+    if err := ioutil.WriteFile(ks.tokenPath, []byte("incorrect"), 0600); err != nil {
+        t.Fatal(err)
+    }
+    if _, err := ks.ListValidatorKeys(); err == nil || !strings.Contains(err.Error(), "401") {
+        t.Errorf("Expected unauthorized error, got %v", err)
+    }
+
+    // Check requests fail without the token file
+    if err := os.Remove(ks.tokenPath); err != nil {
+        t.Fatal(err)
+    }
+    if _, err := ks.ListValidatorKeys(); err == nil {
+        t.Errorf("Expected missing token error")
+    }
+
+}
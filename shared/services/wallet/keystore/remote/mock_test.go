@@ -0,0 +1,180 @@
+package remote
+
+import (
+    "encoding/json"
+    "net/http"
+    "strings"
+    "sync"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+    hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+
+// Mock remote signer
+// Implements the key manager API in memory, for testing the remote signer keystore
+type mockSigner struct {
+    token string
+    keys map[string]mockSignerKey
+    pubkeys []string
+    lock sync.Mutex
+}
+type mockSignerKey struct {
+    derivationPath string
+    slashingProtection *eth2.SlashingProtectionInterchange
+}
+
+
+// Create new mock remote signer
+// If a token is set, requests must provide it as a bearer token
+func newMockSigner(token string) *mockSigner {
+    return &mockSigner{
+        token: token,
+        keys: map[string]mockSignerKey{},
+        pubkeys: []string{},
+    }
+}
+
+
+// Handle a key manager API request
+func (s *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+    // Check request
+    if r.URL.Path != RequestKeystoresPath {
+        writeMockResponse(w, http.StatusNotFound, map[string]string{"message": "Not found"})
+        return
+    }
+    if s.token != "" && r.Header.Get("Authorization") != "Bearer " + s.token {
+        writeMockResponse(w, http.StatusUnauthorized, map[string]string{"message": "Unauthorized"})
+        return
+    }
+
+    // Lock signer state
+    s.lock.Lock()
+    defer s.lock.Unlock()
+
+    // Handle request
+    switch r.Method {
+        case http.MethodGet: s.listKeystores(w)
+        case http.MethodPost: s.importKeystores(w, r)
+        case http.MethodDelete: s.deleteKeystores(w, r)
+        default: writeMockResponse(w, http.StatusMethodNotAllowed, map[string]string{"message": "Method not allowed"})
+    }
+
+}
+
+
+// List registered keys
+func (s *mockSigner) listKeystores(w http.ResponseWriter) {
+    response := listKeystoresResponse{Data: []keystoreInfo{}}
+    for _, pubkey := range s.pubkeys {
+        response.Data = append(response.Data, keystoreInfo{
+            ValidatingPubkey: pubkey,
+            DerivationPath: s.keys[pubkey].derivationPath,
+        })
+    }
+    writeMockResponse(w, http.StatusOK, response)
+}
+
+
+// Import keys, decrypting each to check its password
+func (s *mockSigner) importKeystores(w http.ResponseWriter, r *http.Request) {
+
+    // Decode request
+    var request importKeystoresRequest
+    if err := json.NewDecoder(r.Body).Decode(&request); err != nil || len(request.Keystores) != len(request.Passwords) {
+        writeMockResponse(w, http.StatusBadRequest, map[string]string{"message": "Invalid request"})
+        return
+    }
+    var slashingProtection *eth2.SlashingProtectionInterchange
+    if request.SlashingProtection != "" {
+        var err error
+        if slashingProtection, err = eth2.ParseSlashingProtectionInterchange([]byte(request.SlashingProtection)); err != nil {
+            writeMockResponse(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+            return
+        }
+    }
+
+    // Import keys
+    encryptor := eth2ks.New()
+    response := keystoresResponse{Data: make([]keystoreStatus, len(request.Keystores))}
+    for ki, keystoreJson := range request.Keystores {
+        var key validatorKey
+        if err := json.Unmarshal([]byte(keystoreJson), &key); err != nil {
+            response.Data[ki] = keystoreStatus{Status: "error", Message: err.Error()}
+            continue
+        }
+        if _, err := encryptor.Decrypt(key.Crypto, request.Passwords[ki]); err != nil {
+            response.Data[ki] = keystoreStatus{Status: "error", Message: err.Error()}
+            continue
+        }
+        pubkey := hexutil.AddPrefix(key.Pubkey.Hex())
+        if _, ok := s.keys[pubkey]; ok {
+            response.Data[ki] = keystoreStatus{Status: "duplicate"}
+            continue
+        }
+        s.keys[pubkey] = mockSignerKey{derivationPath: key.Path, slashingProtection: slashingProtection}
+        s.pubkeys = append(s.pubkeys, pubkey)
+        response.Data[ki] = keystoreStatus{Status: "imported"}
+    }
+    writeMockResponse(w, http.StatusOK, response)
+
+}
+
+
+// Delete keys, returning the slashing protection history imported with them
+func (s *mockSigner) deleteKeystores(w http.ResponseWriter, r *http.Request) {
+
+    // Decode request
+    var request deleteKeystoresRequest
+    if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+        writeMockResponse(w, http.StatusBadRequest, map[string]string{"message": "Invalid request"})
+        return
+    }
+
+    // Delete keys
+    var history *eth2.SlashingProtectionInterchange
+    response := keystoresResponse{Data: make([]keystoreStatus, len(request.Pubkeys))}
+    for pi, pubkey := range request.Pubkeys {
+        pubkey = strings.ToLower(hexutil.AddPrefix(hexutil.RemovePrefix(pubkey)))
+        key, ok := s.keys[pubkey]
+        if !ok {
+            response.Data[pi] = keystoreStatus{Status: "not_found"}
+            continue
+        }
+        if key.slashingProtection != nil {
+            if history == nil {
+                history = &eth2.SlashingProtectionInterchange{Metadata: key.slashingProtection.Metadata}
+            }
+            if validatorPubkey, err := rptypes.HexToValidatorPubkey(hexutil.RemovePrefix(pubkey)); err == nil {
+                history.Merge(key.slashingProtection, []rptypes.ValidatorPubkey{validatorPubkey})
+            }
+        }
+        delete(s.keys, pubkey)
+        for ki, registeredPubkey := range s.pubkeys {
+            if registeredPubkey == pubkey {
+                s.pubkeys = append(s.pubkeys[:ki], s.pubkeys[ki + 1:]...)
+                break
+            }
+        }
+        response.Data[pi] = keystoreStatus{Status: "deleted"}
+    }
+    if history != nil {
+        if historyBytes, err := json.Marshal(history); err == nil {
+            response.SlashingProtection = string(historyBytes)
+        }
+    }
+    writeMockResponse(w, http.StatusOK, response)
+
+}
+
+
+// Write a JSON response
+func writeMockResponse(w http.ResponseWriter, status int, response interface{}) {
+    w.Header().Set("Content-Type", RequestContentType)
+    w.WriteHeader(status)
+    json.NewEncoder(w).Encode(response)
+}
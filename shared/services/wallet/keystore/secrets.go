@@ -0,0 +1,127 @@
+package keystore
+
+import (
+    "crypto/rand"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "os"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+
+// Config
+const (
+    SecretLength = 32
+    StagedFileSuffix = ".new"
+)
+
+
+// Generate a random secret to encrypt a validator key with
+// Each key has its own secret, so keys do not depend on the node password
+func GenerateSecret() (string, error) {
+    secretBytes := make([]byte, SecretLength)
+    if _, err := rand.Read(secretBytes); err != nil {
+        return "", fmt.Errorf("Could not generate validator key secret: %w", err)
+    }
+    return hex.EncodeToString(secretBytes), nil
+}
+
+
+// Re-encrypt a validator key which uses the node password as its secret with a new random secret
+// Keys stored by earlier versions were encrypted with the node password; keys with their own secrets are left as is
+// The new secret is staged alongside the old one until the key has been re-written, so an interrupted migration is resumed safely
+func MigrateLegacySecret(encryptor *eth2ks.Encryptor, keyFilePath, secretFilePath string, passwords []string, pubkey rptypes.ValidatorPubkey) error {
+
+    // Read & decode key store
+    // The key store is decoded generically so that client-specific fields are preserved
+    keyStoreBytes, err := ioutil.ReadFile(keyFilePath)
+    if err != nil {
+        return fmt.Errorf("Could not read validator key from disk: %w", err)
+    }
+    var keyStore map[string]interface{}
+    if err := json.Unmarshal(keyStoreBytes, &keyStore); err != nil {
+        return fmt.Errorf("Could not decode validator key: %w", err)
+    }
+    crypto, ok := keyStore["crypto"].(map[string]interface{})
+    if !ok {
+        return errors.New("Validator key file does not contain an encrypted key")
+    }
+
+    // Complete an interrupted migration if the key was already re-written with a staged secret, or discard the staged secret
+    stagedSecretFilePath := secretFilePath + StagedFileSuffix
+    stagedSecret, err := ioutil.ReadFile(stagedSecretFilePath)
+    if err != nil && !os.IsNotExist(err) {
+        return fmt.Errorf("Could not read staged validator secret from disk: %w", err)
+    }
+    if err == nil {
+        if _, err := DecryptValidatorKey(encryptor, crypto, string(stagedSecret), pubkey); err == nil {
+            if err := os.Rename(stagedSecretFilePath, secretFilePath); err != nil {
+                return fmt.Errorf("Could not write validator secret to disk: %w", err)
+            }
+            return nil
+        }
+        if err := os.Remove(stagedSecretFilePath); err != nil {
+            return fmt.Errorf("Could not delete staged validator secret from disk: %w", err)
+        }
+    }
+
+    // Read secret & check whether it is the node password
+    secret, err := ioutil.ReadFile(secretFilePath)
+    if err != nil && !os.IsNotExist(err) {
+        return fmt.Errorf("Could not read validator secret from disk: %w", err)
+    }
+    if err == nil {
+        isLegacy := false
+        for _, password := range passwords {
+            if password != "" && string(secret) == password {
+                isLegacy = true
+                break
+            }
+        }
+        if !isLegacy {
+            return nil
+        }
+    }
+
+    // Decrypt key
+    key, err := DecryptValidatorKeyWithPasswords(encryptor, crypto, append([]string{string(secret)}, passwords...), pubkey)
+    if err != nil {
+        return fmt.Errorf("Could not decrypt validator %s key: %w", pubkey.Hex(), err)
+    }
+
+    // Generate & stage new secret
+    newSecret, err := GenerateSecret()
+    if err != nil {
+        return err
+    }
+    if err := WriteFileAtomic(stagedSecretFilePath, []byte(newSecret)); err != nil {
+        return fmt.Errorf("Could not write staged validator secret to disk: %w", err)
+    }
+
+    // Re-encrypt & write key store
+    keyStore["crypto"], err = encryptor.Encrypt(key.Marshal(), newSecret)
+    if err != nil {
+        return fmt.Errorf("Could not encrypt validator key: %w", err)
+    }
+    keyStoreBytes, err = json.Marshal(keyStore)
+    if err != nil {
+        return fmt.Errorf("Could not encode validator key: %w", err)
+    }
+    if err := WriteFileAtomic(keyFilePath, keyStoreBytes); err != nil {
+        return fmt.Errorf("Could not write validator key to disk: %w", err)
+    }
+
+    // Replace secret
+    if err := os.Rename(stagedSecretFilePath, secretFilePath); err != nil {
+        return fmt.Errorf("Could not write validator secret to disk: %w", err)
+    }
+
+    // Return
+    return nil
+
+}
@@ -0,0 +1,66 @@
+package keystore
+
+import (
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
+)
+
+
+// Config
+// Slashing protection history is exchanged with validator clients as EIP-3076 interchange files in the validator keychain folder
+const (
+    SlashingProtectionFileName = "slashing-protection.json"
+    DirMode = 0700
+    FileMode = 0600
+)
+
+
+// Load a slashing protection interchange file
+// Returns nil if the file does not exist
+func LoadSlashingProtection(path string) (*eth2.SlashingProtectionInterchange, error) {
+
+    // Read file
+    bytes, err := ioutil.ReadFile(path)
+    if os.IsNotExist(err) {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, fmt.Errorf("Could not read slashing protection history at %s: %w", path, err)
+    }
+
+    // Decode interchange
+    interchange, err := eth2.ParseSlashingProtectionInterchange(bytes)
+    if err != nil {
+        return nil, fmt.Errorf("Could not load slashing protection history at %s: %w", path, err)
+    }
+    return interchange, nil
+
+}
+
+
+// Write a slashing protection interchange file
+func StoreSlashingProtection(path string, interchange *eth2.SlashingProtectionInterchange) error {
+
+    // Encode interchange
+    bytes, err := json.Marshal(interchange)
+    if err != nil {
+        return fmt.Errorf("Could not encode slashing protection history: %w", err)
+    }
+
+    // Create folder
+    if err := os.MkdirAll(filepath.Dir(path), DirMode); err != nil {
+        return fmt.Errorf("Could not create slashing protection history folder: %w", err)
+    }
+
+    // Write file
+    if err := ioutil.WriteFile(path, bytes, FileMode); err != nil {
+        return fmt.Errorf("Could not write slashing protection history to disk: %w", err)
+    }
+    return nil
+
+}
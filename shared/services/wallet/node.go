@@ -8,6 +8,8 @@ import (
     "github.com/btcsuite/btcutil/hdkeychain"
     "github.com/ethereum/go-ethereum/accounts"
     "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
     "github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -57,6 +59,79 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
         return nil, errors.New("Wallet is not initialized")
     }
 
+    // Get gas fees
+    fees, err := w.getGasFees()
+    if err != nil {
+        return nil, err
+    }
+
+    // Create transactor
+    transactor, err := w.getNodeKeyedTransactor()
+    if err != nil {
+        return nil, err
+    }
+    transactor.GasPrice = fees.gasPrice
+    transactor.GasFeeCap = fees.feeCap
+    transactor.GasTipCap = fees.tip
+    transactor.GasLimit = w.gasLimit
+
+    // Skip gas estimation when the gas limit is estimated on signing or sending is simulated
+    // The dry run hook reports the estimate and any revert reason
+    estimateGasLimit := w.estimatesGasLimit()
+    if (estimateGasLimit || w.dryRun) && transactor.GasLimit == 0 {
+        transactor.GasLimit = PlaceholderGasLimit
+    }
+
+    // Estimate gas limits, run transaction hooks on signed transactions before they are sent, and track sent transactions
+    // Untracked transactions have their nonces reserved within the process instead
+    // Legacy transactions built without the transactor's fees (e.g. ETH transfers) are sent with the EIP-1559 fees if set
+    trackTransactions := w.txStore != nil && w.ec != nil && !w.dryRun
+    reserveNonces := w.txStore == nil && w.ec != nil && !w.dryRun
+    applyFees := fees.feeCap != nil
+    if estimateGasLimit || len(w.txHooks) > 0 || trackTransactions || reserveNonces || applyFees {
+        signer := transactor.Signer
+        hooks := w.txHooks
+        transactor.Signer = func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+            if applyFees && tx.Type() == types.LegacyTxType {
+                tx = withTransactionParams(tx, tx.Nonce(), tx.Gas(), fees)
+            }
+            if estimateGasLimit {
+                var err error
+                if tx, err = w.withEstimatedGasLimit(from, tx); err != nil {
+                    return nil, err
+                }
+            }
+            sign := func(tx *types.Transaction) (*types.Transaction, error) {
+                signedTx, err := signer(from, tx)
+                if err != nil {
+                    return nil, err
+                }
+                for _, hook := range hooks {
+                    if err := hook(from, signedTx); err != nil {
+                        return nil, err
+                    }
+                }
+                return signedTx, nil
+            }
+            if trackTransactions {
+                return w.signTrackedTransaction(from, tx, sign)
+            }
+            if reserveNonces {
+                return w.signReservedTransaction(from, tx, sign)
+            }
+            return sign(tx)
+        }
+    }
+
+    // Return
+    return transactor, nil
+
+}
+
+
+// Get a keyed transactor for the node account without gas settings or transaction hooks
+func (w *Wallet) getNodeKeyedTransactor() (*bind.TransactOpts, error) {
+
     // Get private key
     privateKey, _, err := w.getNodePrivateKey()
     if err != nil {
@@ -64,10 +139,7 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
     }
 
     // Create & return transactor
-    transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, w.chainID)
-    transactor.GasPrice = w.gasPrice
-    transactor.GasLimit = w.gasLimit
-    return transactor, err
+    return bind.NewKeyedTransactorWithChainID(privateKey, w.chainID)
 
 }
 
@@ -95,6 +167,10 @@ func (w *Wallet) GetNodePrivateKeyBytes() ([]byte, error) {
 // Get the node private key
 func (w *Wallet) getNodePrivateKey() (*ecdsa.PrivateKey, string, error) {
 
+    // Lock node key cache; daemon tasks may request the node key concurrently
+    w.nodeKeyLock.Lock()
+    defer w.nodeKeyLock.Unlock()
+
     // Check for cached node key
     if w.nodeKey != nil {
         return w.nodeKey, w.nodeKeyPath, nil
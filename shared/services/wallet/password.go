@@ -0,0 +1,268 @@
+package wallet
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "os"
+    "strings"
+
+    "github.com/rocket-pool/smartnode/shared/services/passwords"
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+)
+
+
+// Config
+// Files re-encrypted with a new password are staged alongside the files they replace, and the replaced files are kept until the new password is set
+const (
+    StagedFileSuffix = keystore.StagedFileSuffix
+    ReplacedFileSuffix = ".old"
+    PasswordChangeJournalSuffix = ".journal"
+)
+
+
+// Password change journal
+// Lists the files staged with the new password, so that an interrupted password change can be completed or reversed
+type passwordChangeJournal struct {
+    Paths []string                  `json:"paths"`
+}
+
+
+// Re-encrypt the wallet store and the validator keystores which depend on the node password with a new password, and update the node password
+// Returns the names of the validator keystores which were re-encrypted
+// Re-encrypted files are staged and moved into place before the password is updated; if the process is interrupted, the change is completed or reversed when the wallet is next loaded
+func (w *Wallet) ChangePassword(currentPassword, newPassword string) ([]string, error) {
+
+    // Check wallet is initialized
+    if !w.IsInitialized() {
+        return nil, errors.New("Wallet is not initialized")
+    }
+
+    // Check password can be changed
+    if w.pm.IsReadOnly() {
+        return nil, errors.New("The node password is provided from outside of the node by the configured password provider and cannot be changed here")
+    }
+    if journal, err := w.loadPasswordChangeJournal(); err != nil {
+        return nil, err
+    } else if journal != nil {
+        return nil, errors.New("A previous password change was interrupted - please restart the Rocket Pool service to complete it")
+    }
+
+    // Check passwords
+    password, err := w.pm.GetPassword()
+    if err != nil {
+        return nil, fmt.Errorf("Could not get wallet password: %w", err)
+    }
+    if currentPassword != password {
+        return nil, errors.New("The current password is incorrect")
+    }
+    if newPassword == currentPassword {
+        return nil, errors.New("The new password must be different to the current password")
+    }
+    if len(newPassword) < passwords.MinPasswordLength {
+        return nil, fmt.Errorf("Password must be at least %d characters long", passwords.MinPasswordLength)
+    }
+
+    // Stage wallet store
+    encryptedSeed, err := w.encryptor.Encrypt(w.seed, newPassword)
+    if err != nil {
+        return nil, fmt.Errorf("Could not encrypt wallet seed: %w", err)
+    }
+    ws := *w.ws
+    ws.Crypto = encryptedSeed
+    wsBytes, err := json.Marshal(ws)
+    if err != nil {
+        return nil, fmt.Errorf("Could not encode wallet: %w", err)
+    }
+    if err := keystore.WriteFileAtomic(w.walletPath + StagedFileSuffix, wsBytes); err != nil {
+        return nil, fmt.Errorf("Could not write staged wallet to disk: %w", err)
+    }
+    journal := &passwordChangeJournal{Paths: []string{w.walletPath}}
+
+    // Stage validator keystores
+    stagedKeystores := []string{}
+    for _, name := range w.GetKeystoreNames() {
+        paths, err := w.keystores[name].StagePasswordChange(currentPassword, newPassword)
+        if err != nil {
+            return nil, fmt.Errorf("Could not re-encrypt %s validator keys: %w", name, err)
+        }
+        if len(paths) > 0 {
+            stagedKeystores = append(stagedKeystores, name)
+            journal.Paths = append(journal.Paths, paths...)
+        }
+    }
+
+    // Write journal
+    if err := w.savePasswordChangeJournal(journal); err != nil {
+        return nil, err
+    }
+
+    // Move staged files into place & update password
+    if err := replaceStagedFiles(journal.Paths); err != nil {
+        return nil, w.reversePasswordChange(journal, err)
+    }
+    if err := w.pm.ChangePassword(newPassword); err != nil {
+        return nil, w.reversePasswordChange(journal, fmt.Errorf("Could not update wallet password: %w", err))
+    }
+    w.ws = &ws
+
+    // Remove replaced files & journal
+    if err := w.completePasswordChange(journal); err != nil {
+        return nil, err
+    }
+
+    // Return
+    return stagedKeystores, nil
+
+}
+
+
+// Complete or reverse an interrupted password change
+// The change was committed if the node password decrypts the new wallet store
+func (w *Wallet) recoverPasswordChange(journal *passwordChangeJournal, password string) error {
+
+    // Get wallet store versions
+    newPath, oldPath := w.walletPath + StagedFileSuffix, w.walletPath + ReplacedFileSuffix
+    if !fileExists(newPath) {
+        newPath = w.walletPath
+    } else if !fileExists(oldPath) {
+        oldPath = w.walletPath
+    }
+
+    // Complete or reverse change
+    if w.decryptsStore(newPath, password) {
+        if err := replaceStagedFiles(journal.Paths); err != nil {
+            return fmt.Errorf("Could not complete interrupted password change: %w", err)
+        }
+        return w.completePasswordChange(journal)
+    }
+    if w.decryptsStore(oldPath, password) {
+        if err := restoreReplacedFiles(journal.Paths); err != nil {
+            return fmt.Errorf("Could not reverse interrupted password change: %w", err)
+        }
+        return w.removePasswordChangeJournal()
+    }
+    return errors.New("Could not recover interrupted password change: the node password does not decrypt the current or new wallet")
+
+}
+
+
+// Remove the files replaced by a password change and the journal
+func (w *Wallet) completePasswordChange(journal *passwordChangeJournal) error {
+    for _, path := range journal.Paths {
+        if err := os.Remove(path + ReplacedFileSuffix); err != nil && !os.IsNotExist(err) {
+            return fmt.Errorf("Could not remove replaced file %s: %w", path, err)
+        }
+    }
+    return w.removePasswordChangeJournal()
+}
+
+
+// Restore the files replaced by a failed password change and remove the journal
+func (w *Wallet) reversePasswordChange(journal *passwordChangeJournal, changeErr error) error {
+    if err := restoreReplacedFiles(journal.Paths); err != nil {
+        return fmt.Errorf("%w; the password change could not be reversed and will be recovered when the wallet is next loaded: %s", changeErr, err.Error())
+    }
+    if err := w.removePasswordChangeJournal(); err != nil {
+        return fmt.Errorf("%w; %s", changeErr, err.Error())
+    }
+    return fmt.Errorf("%w; the password change was reversed", changeErr)
+}
+
+
+// Check whether a password decrypts a wallet store on disk
+func (w *Wallet) decryptsStore(path string, password string) bool {
+    ws, err := readStore(path)
+    if err != nil || ws == nil {
+        return false
+    }
+    _, err = w.encryptor.Decrypt(ws.Crypto, password)
+    return (err == nil)
+}
+
+
+// Load the password change journal
+// Returns nil if no password change is in progress
+func (w *Wallet) loadPasswordChangeJournal() (*passwordChangeJournal, error) {
+    bytes, err := ioutil.ReadFile(w.walletPath + PasswordChangeJournalSuffix)
+    if os.IsNotExist(err) {
+        return nil, nil
+    } else if err != nil {
+        return nil, fmt.Errorf("Could not read password change journal: %w", err)
+    }
+    journal := new(passwordChangeJournal)
+    if err := json.Unmarshal(bytes, journal); err != nil {
+        return nil, fmt.Errorf("Could not decode password change journal: %w", err)
+    }
+    return journal, nil
+}
+
+
+// Save the password change journal
+func (w *Wallet) savePasswordChangeJournal(journal *passwordChangeJournal) error {
+    bytes, err := json.Marshal(journal)
+    if err != nil {
+        return fmt.Errorf("Could not encode password change journal: %w", err)
+    }
+    if err := keystore.WriteFileAtomic(w.walletPath + PasswordChangeJournalSuffix, bytes); err != nil {
+        return fmt.Errorf("Could not write password change journal: %w", err)
+    }
+    return nil
+}
+
+
+// Remove the password change journal
+func (w *Wallet) removePasswordChangeJournal() error {
+    if err := os.Remove(w.walletPath + PasswordChangeJournalSuffix); err != nil && !os.IsNotExist(err) {
+        return fmt.Errorf("Could not remove password change journal: %w", err)
+    }
+    return nil
+}
+
+
+// Move staged files into place, keeping the files they replace
+// Files already moved into place are skipped, so an interrupted replacement can be resumed
+func replaceStagedFiles(paths []string) error {
+    for _, path := range paths {
+        if !fileExists(path + StagedFileSuffix) {
+            continue
+        }
+        if fileExists(path) && !fileExists(path + ReplacedFileSuffix) {
+            if err := os.Rename(path, path + ReplacedFileSuffix); err != nil {
+                return err
+            }
+        }
+        if err := os.Rename(path + StagedFileSuffix, path); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+
+// Restore replaced files and remove staged files
+func restoreReplacedFiles(paths []string) error {
+    errs := []string{}
+    for _, path := range paths {
+        if fileExists(path + ReplacedFileSuffix) {
+            if err := os.Rename(path + ReplacedFileSuffix, path); err != nil {
+                errs = append(errs, err.Error())
+            }
+        }
+        if err := os.Remove(path + StagedFileSuffix); err != nil && !os.IsNotExist(err) {
+            errs = append(errs, err.Error())
+        }
+    }
+    if len(errs) > 0 {
+        return errors.New(strings.Join(errs, "; "))
+    }
+    return nil
+}
+
+
+// Check whether a file exists
+func fileExists(path string) bool {
+    _, err := os.Stat(path)
+    return !os.IsNotExist(err)
+}
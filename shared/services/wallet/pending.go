@@ -0,0 +1,563 @@
+package wallet
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "math/big"
+    "os"
+    "path/filepath"
+    "sync"
+    "syscall"
+    "time"
+
+    "github.com/ethereum/go-ethereum"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/ethereum/go-ethereum/core/types"
+)
+
+
+// Config
+// Replacement transactions must pay at least 10% more gas than the transactions they replace
+const (
+    GasBumpPercent = 15
+    NonceReservationPeriod = 1 * time.Minute
+    ResolvedTxRetention = 1 * time.Hour
+    PendingTxsDirMode = 0700
+)
+
+
+// Pending transaction statuses
+const (
+    TxStatusPending = "pending"
+    TxStatusMined = "mined"
+    TxStatusDropped = "dropped"
+)
+
+
+// A node account transaction tracked until it is mined
+// Each broadcast (the original transaction and any replacements) shares the same nonce; the latest is last in Hashes
+// The gas price of an EIP-1559 transaction is its fee cap
+type PendingTransaction struct {
+    From common.Address                 `json:"from"`
+    Nonce uint64                        `json:"nonce"`
+    To *common.Address                  `json:"to"`
+    Value *big.Int                      `json:"value"`
+    GasLimit uint64                     `json:"gasLimit"`
+    GasPrice *big.Int                   `json:"gasPrice"`
+    Hashes []common.Hash                `json:"hashes"`
+    RawTx hexutil.Bytes                 `json:"rawTx"`
+    Status string                       `json:"status"`
+    MinedHash common.Hash               `json:"minedHash"`
+    FirstSent int64                     `json:"firstSent"`
+    LastSent int64                      `json:"lastSent"`
+    Resolved int64                      `json:"resolved"`
+}
+
+
+// A change to a tracked transaction made by the transaction monitor
+type PendingTransactionUpdate struct {
+    Transaction PendingTransaction
+    PreviousHash common.Hash
+    Replaced bool
+    Capped bool
+    Error error
+}
+
+
+// Pending transaction store
+// The store is shared by all processes using the node wallet; writes are serialized with a lock file
+type pendingTxStore struct {
+    path string
+    timeout time.Duration
+    lock sync.Mutex
+}
+
+
+// Pending transactions file
+type pendingTxsFile struct {
+    Transactions []PendingTransaction   `json:"transactions"`
+}
+
+
+// Get the latest hash for a tracked transaction
+func (tx PendingTransaction) Hash() common.Hash {
+    if tx.Status == TxStatusMined {
+        return tx.MinedHash
+    }
+    if len(tx.Hashes) == 0 {
+        return common.Hash{}
+    }
+    return tx.Hashes[len(tx.Hashes) - 1]
+}
+
+
+// Nonce assigned to an untracked transaction
+type nonceReservation struct {
+    nonce uint64
+    reserved time.Time
+}
+
+
+// Track node account transactions in the pending transactions file at the path
+// Tracked transactions are assigned nonces after any still being sent, and are re-broadcast by the transaction monitor if not mined before the timeout
+func (w *Wallet) EnableTransactionTracking(path string, timeout time.Duration) {
+    w.txStore = &pendingTxStore{
+        path: path,
+        timeout: timeout,
+    }
+}
+
+
+// Get the tracked node account transactions
+func (w *Wallet) GetPendingTransactions() ([]PendingTransaction, error) {
+    if w.txStore == nil {
+        return []PendingTransaction{}, nil
+    }
+    file, err := w.txStore.load()
+    if err != nil {
+        return nil, err
+    }
+    return file.Transactions, nil
+}
+
+
+// Check pending node account transactions, resolve mined & dropped transactions, and re-broadcast transactions which have timed out
+// Timed out transactions are replaced with a bumped gas price; they are not replaced if the bumped gas price is above the maximum
+func (w *Wallet) MonitorTransactions() ([]PendingTransactionUpdate, error) {
+
+    // Check transaction tracking is enabled
+    if w.txStore == nil || w.ec == nil {
+        return []PendingTransactionUpdate{}, nil
+    }
+
+    // Lock store
+    unlock, err := w.txStore.acquire()
+    if err != nil {
+        return nil, err
+    }
+    defer unlock()
+
+    // Load pending transactions
+    file, err := w.txStore.load()
+    if err != nil {
+        return nil, err
+    }
+
+    // Check transactions
+    updates := []PendingTransactionUpdate{}
+    confirmedNonces := make(map[common.Address]uint64)
+    now := time.Now()
+    for ti := 0; ti < len(file.Transactions); ti++ {
+        tx := &file.Transactions[ti]
+        if tx.Status != TxStatusPending { continue }
+
+        // Check for a mined receipt for any broadcast of the transaction
+        minedHash, mined, err := w.getMinedHash(tx.Hashes)
+        if err != nil {
+            return nil, err
+        }
+        if mined {
+            tx.Status = TxStatusMined
+            tx.MinedHash = minedHash
+            tx.Resolved = now.Unix()
+            updates = append(updates, PendingTransactionUpdate{Transaction: *tx})
+            continue
+        }
+
+        // Check whether the transaction was superseded by a later transaction with the same nonce
+        if isSuperseded(file.Transactions, ti) {
+            tx.Status = TxStatusDropped
+            tx.Resolved = now.Unix()
+            updates = append(updates, PendingTransactionUpdate{Transaction: *tx})
+            continue
+        }
+
+        // Check whether the nonce has been used by another transaction
+        confirmedNonce, ok := confirmedNonces[tx.From]
+        if !ok {
+            confirmedNonce, err = w.ec.NonceAt(context.Background(), tx.From, nil)
+            if err != nil {
+                return nil, fmt.Errorf("Could not get confirmed nonce for %s: %w", tx.From.Hex(), err)
+            }
+            confirmedNonces[tx.From] = confirmedNonce
+        }
+        if tx.Nonce < confirmedNonce {
+            tx.Status = TxStatusDropped
+            tx.Resolved = now.Unix()
+            updates = append(updates, PendingTransactionUpdate{Transaction: *tx})
+            continue
+        }
+
+        // Re-broadcast transactions which have timed out
+        if now.Sub(time.Unix(tx.LastSent, 0)) < w.txStore.timeout { continue }
+        updates = append(updates, w.rebroadcastTransaction(tx))
+
+    }
+
+    // Prune resolved transactions
+    transactions := []PendingTransaction{}
+    for _, tx := range file.Transactions {
+        if tx.Status != TxStatusPending && now.Sub(time.Unix(tx.Resolved, 0)) > ResolvedTxRetention { continue }
+        transactions = append(transactions, tx)
+    }
+    file.Transactions = transactions
+
+    // Save & return
+    if err := w.txStore.save(file); err != nil {
+        return nil, err
+    }
+    return updates, nil
+
+}
+
+
+// Assign the next node account nonce to a transaction, sign it, and record it as pending
+// The record is removed by the receipt transport if the transaction can't be sent
+func (w *Wallet) signTrackedTransaction(from common.Address, tx *types.Transaction, sign func(*types.Transaction) (*types.Transaction, error)) (*types.Transaction, error) {
+
+    // Lock store; the nonce is reserved once the transaction is recorded
+    unlock, err := w.txStore.acquire()
+    if err != nil {
+        return nil, err
+    }
+    defer unlock()
+
+    // Load pending transactions
+    file, err := w.txStore.load()
+    if err != nil {
+        return nil, err
+    }
+
+    // Get next nonce
+    // Transactions recorded recently may not have been broadcast yet, so their nonces are reserved
+    nonce, err := w.ec.PendingNonceAt(context.Background(), from)
+    if err != nil {
+        return nil, fmt.Errorf("Could not get pending nonce for %s: %w", from.Hex(), err)
+    }
+    for _, pendingTx := range file.Transactions {
+        if pendingTx.From != from || pendingTx.Status != TxStatusPending { continue }
+        if time.Since(time.Unix(pendingTx.FirstSent, 0)) > NonceReservationPeriod { continue }
+        if pendingTx.Nonce >= nonce {
+            nonce = pendingTx.Nonce + 1
+        }
+    }
+
+    // Sign transaction with nonce
+    signedTx, err := sign(withTransactionParams(tx, nonce, tx.Gas(), getTransactionFees(tx)))
+    if err != nil {
+        return nil, err
+    }
+    rawTx, err := signedTx.MarshalBinary()
+    if err != nil {
+        return nil, fmt.Errorf("Could not encode transaction: %w", err)
+    }
+
+    // Record transaction
+    // A previous transaction with the same nonce is superseded once this transaction has been sent, and resolved on the next check
+    now := time.Now().Unix()
+    file.Transactions = append(file.Transactions, PendingTransaction{
+        From: from,
+        Nonce: nonce,
+        To: signedTx.To(),
+        Value: signedTx.Value(),
+        GasLimit: signedTx.Gas(),
+        GasPrice: signedTx.GasPrice(),
+        Hashes: []common.Hash{signedTx.Hash()},
+        RawTx: rawTx,
+        Status: TxStatusPending,
+        FirstSent: now,
+        LastSent: now,
+    })
+    if err := w.txStore.save(file); err != nil {
+        return nil, err
+    }
+
+    // Return
+    return signedTx, nil
+
+}
+
+
+// Assign the next node account nonce to an untracked transaction and sign it
+// Nonces are reserved within the process, so that tasks sending transactions at the same time don't use the same nonce
+func (w *Wallet) signReservedTransaction(from common.Address, tx *types.Transaction, sign func(*types.Transaction) (*types.Transaction, error)) (*types.Transaction, error) {
+
+    // Lock reservations
+    w.nonceLock.Lock()
+    defer w.nonceLock.Unlock()
+
+    // Get next nonce
+    // The last reserved nonce may not have been broadcast yet, so it is skipped
+    nonce, err := w.ec.PendingNonceAt(context.Background(), from)
+    if err != nil {
+        return nil, fmt.Errorf("Could not get pending nonce for %s: %w", from.Hex(), err)
+    }
+    if reservation, ok := w.nonceReservations[from]; ok && time.Since(reservation.reserved) <= NonceReservationPeriod && reservation.nonce >= nonce {
+        nonce = reservation.nonce + 1
+    }
+
+    // Sign transaction with nonce
+    signedTx, err := sign(withTransactionParams(tx, nonce, tx.Gas(), getTransactionFees(tx)))
+    if err != nil {
+        return nil, err
+    }
+
+    // Reserve nonce & return
+    w.nonceReservations[from] = nonceReservation{nonce: nonce, reserved: time.Now()}
+    return signedTx, nil
+
+}
+
+
+// Get the hash of a mined transaction broadcast, if any
+func (w *Wallet) getMinedHash(hashes []common.Hash) (common.Hash, bool, error) {
+    for hi := len(hashes) - 1; hi >= 0; hi-- {
+        receipt, err := w.ec.TransactionReceipt(context.Background(), hashes[hi])
+        if errors.Is(err, ethereum.NotFound) {
+            continue
+        } else if err != nil {
+            return common.Hash{}, false, fmt.Errorf("Could not get transaction %s receipt: %w", hashes[hi].Hex(), err)
+        }
+        if receipt != nil {
+            return hashes[hi], true, nil
+        }
+    }
+    return common.Hash{}, false, nil
+}
+
+
+// Check whether a tracked transaction was superseded by a later pending transaction with the same nonce
+func isSuperseded(transactions []PendingTransaction, index int) bool {
+    tx := transactions[index]
+    for ti := index + 1; ti < len(transactions); ti++ {
+        laterTx := transactions[ti]
+        if laterTx.From == tx.From && laterTx.Nonce == tx.Nonce && laterTx.Status == TxStatusPending {
+            return true
+        }
+    }
+    return false
+}
+
+
+// Re-broadcast a timed out transaction, replacing it with a bumped gas price
+// The transaction is not re-broadcast if the bumped gas price is above the maximum, as the node would reject a replacement at a lower price
+func (w *Wallet) rebroadcastTransaction(tx *PendingTransaction) PendingTransactionUpdate {
+
+    // Initialize update
+    update := PendingTransactionUpdate{PreviousHash: tx.Hash()}
+
+    // Decode latest broadcast
+    signedTx := new(types.Transaction)
+    if err := signedTx.UnmarshalBinary(tx.RawTx); err != nil {
+        update.Transaction = *tx
+        update.Error = fmt.Errorf("Could not decode transaction: %w", err)
+        return update
+    }
+
+    // Get bumped gas fees; EIP-1559 replacements must bump both the fee cap and the tip
+    fees := getTransactionFees(signedTx)
+    var maxPrice *big.Int
+    if fees.feeCap != nil {
+        fees.feeCap, fees.tip = bumpGasPrice(fees.feeCap), bumpGasPrice(fees.tip)
+        maxPrice = fees.feeCap
+    } else {
+        fees.gasPrice = bumpGasPrice(fees.gasPrice)
+        maxPrice = fees.gasPrice
+    }
+    if w.gasSettings != nil && w.gasSettings.MaxGasPrice != nil && maxPrice.Cmp(w.gasSettings.MaxGasPrice) > 0 {
+        update.Transaction = *tx
+        update.Capped = true
+        return update
+    }
+
+    // Sign replacement
+    replacementTx, err := w.signNodeTransaction(tx.From, withTransactionParams(signedTx, tx.Nonce, signedTx.Gas(), fees))
+    if err != nil {
+        update.Transaction = *tx
+        update.Error = err
+        return update
+    }
+    rawTx, err := replacementTx.MarshalBinary()
+    if err != nil {
+        update.Transaction = *tx
+        update.Error = fmt.Errorf("Could not encode transaction: %w", err)
+        return update
+    }
+
+    // Send replacement
+    // Mined & dropped transactions are resolved on the next check
+    if err := w.ec.SendTransaction(context.Background(), replacementTx); err != nil {
+        update.Transaction = *tx
+        update.Error = fmt.Errorf("Could not send transaction: %w", err)
+        return update
+    }
+
+    // Record replacement
+    tx.LastSent = time.Now().Unix()
+    tx.GasPrice = replacementTx.GasPrice()
+    tx.Hashes = append(tx.Hashes, replacementTx.Hash())
+    tx.RawTx = rawTx
+    update.Replaced = true
+
+    // Return
+    update.Transaction = *tx
+    return update
+
+}
+
+
+// Bump a gas price for a replacement transaction; the extra wei ensures the replacement is priced above the minimum bump
+func bumpGasPrice(gasPrice *big.Int) *big.Int {
+    bumped := new(big.Int).Div(new(big.Int).Mul(gasPrice, big.NewInt(100 + GasBumpPercent)), big.NewInt(100))
+    return bumped.Add(bumped, big.NewInt(1))
+}
+
+
+// Sign a transaction with the node account key
+func (w *Wallet) signNodeTransaction(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+    transactor, err := w.getNodeKeyedTransactor()
+    if err != nil {
+        return nil, err
+    }
+    return transactor.Signer(from, tx)
+}
+
+
+// Acquire the store lock, shared between processes
+func (s *pendingTxStore) acquire() (func(), error) {
+    s.lock.Lock()
+
+    // Open lock file
+    if err := os.MkdirAll(filepath.Dir(s.path), PendingTxsDirMode); err != nil {
+        s.lock.Unlock()
+        return nil, fmt.Errorf("Could not create pending transactions folder: %w", err)
+    }
+    lockFile, err := os.OpenFile(s.path + ".lock", os.O_RDWR|os.O_CREATE, FileMode)
+    if err != nil {
+        s.lock.Unlock()
+        return nil, fmt.Errorf("Could not open pending transactions lock file: %w", err)
+    }
+
+    // Lock file
+    if err := syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX); err != nil {
+        lockFile.Close()
+        s.lock.Unlock()
+        return nil, fmt.Errorf("Could not lock pending transactions file: %w", err)
+    }
+
+    // Return unlock function
+    return func() {
+        syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+        lockFile.Close()
+        s.lock.Unlock()
+    }, nil
+
+}
+
+
+// Get the latest hash of a tracked transaction from any of its hashes
+// Returns false if the hash is not tracked or is already the latest
+func (s *pendingTxStore) getReplacementHash(hash common.Hash) (common.Hash, bool) {
+    file, err := s.load()
+    if err != nil {
+        return common.Hash{}, false
+    }
+    for _, tx := range file.Transactions {
+        for _, txHash := range tx.Hashes {
+            if txHash != hash { continue }
+            latestHash := tx.Hash()
+            return latestHash, (latestHash != hash && latestHash != common.Hash{})
+        }
+    }
+    return common.Hash{}, false
+}
+
+
+// Remove a tracked transaction which could not be sent
+// Only transactions which have not been re-broadcast are removed; failed replacements are handled by the transaction monitor
+func (s *pendingTxStore) remove(hash common.Hash) error {
+
+    // Check for the transaction before locking the store
+    file, err := s.load()
+    if err != nil {
+        return err
+    }
+    if !isUnsentTransaction(file.Transactions, hash) {
+        return nil
+    }
+
+    // Lock store
+    unlock, err := s.acquire()
+    if err != nil {
+        return err
+    }
+    defer unlock()
+
+    // Reload pending transactions & remove transaction
+    file, err = s.load()
+    if err != nil {
+        return err
+    }
+    transactions := []PendingTransaction{}
+    for _, tx := range file.Transactions {
+        if isUnsentTransaction([]PendingTransaction{tx}, hash) { continue }
+        transactions = append(transactions, tx)
+    }
+    file.Transactions = transactions
+
+    // Save
+    return s.save(file)
+
+}
+
+
+// Check whether a pending transaction with a single broadcast matching the hash is tracked
+func isUnsentTransaction(transactions []PendingTransaction, hash common.Hash) bool {
+    for _, tx := range transactions {
+        if tx.Status == TxStatusPending && len(tx.Hashes) == 1 && tx.Hashes[0] == hash {
+            return true
+        }
+    }
+    return false
+}
+
+
+// Load the pending transactions file
+func (s *pendingTxStore) load() (*pendingTxsFile, error) {
+    bytes, err := ioutil.ReadFile(s.path)
+    if os.IsNotExist(err) {
+        return &pendingTxsFile{Transactions: []PendingTransaction{}}, nil
+    } else if err != nil {
+        return nil, fmt.Errorf("Could not read pending transactions file: %w", err)
+    }
+    file := new(pendingTxsFile)
+    if err := json.Unmarshal(bytes, file); err != nil {
+        return nil, fmt.Errorf("Could not decode pending transactions file: %w", err)
+    }
+    if file.Transactions == nil {
+        file.Transactions = []PendingTransaction{}
+    }
+    return file, nil
+}
+
+
+// Save the pending transactions file
+// The file is written to a temporary path and moved into place so that readers never see a partial write
+func (s *pendingTxStore) save(file *pendingTxsFile) error {
+    bytes, err := json.Marshal(file)
+    if err != nil {
+        return fmt.Errorf("Could not encode pending transactions file: %w", err)
+    }
+    tmpPath := s.path + ".tmp"
+    if err := ioutil.WriteFile(tmpPath, bytes, FileMode); err != nil {
+        return fmt.Errorf("Could not write pending transactions file: %w", err)
+    }
+    if err := os.Rename(tmpPath, s.path); err != nil {
+        return fmt.Errorf("Could not write pending transactions file: %w", err)
+    }
+    return nil
+}
@@ -0,0 +1,170 @@
+package wallet
+
+import (
+    "bytes"
+    "encoding/json"
+    "io/ioutil"
+    "net/http"
+    "strings"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/ethereum/go-ethereum/core/types"
+)
+
+
+// Config
+const TransactionReceiptMethod = "eth_getTransactionReceipt"
+const SendRawTransactionMethod = "eth_sendRawTransaction"
+
+
+// HTTP transport which redirects transaction receipt requests for replaced node account transactions to their latest replacement
+// Callers waiting for the original transaction to be mined are notified when a replacement is mined instead
+// Tracked transactions which can't be sent are removed from the pending transactions file, so they are never re-broadcast
+type receiptTransport struct {
+    base http.RoundTripper
+    txStore *pendingTxStore
+}
+
+
+// JSON-RPC transaction receipt request
+type receiptRequest struct {
+    Version string                      `json:"jsonrpc"`
+    ID json.RawMessage                  `json:"id"`
+    Method string                       `json:"method"`
+    Params []common.Hash                `json:"params"`
+}
+
+
+// JSON-RPC raw transaction send request
+type sendRequest struct {
+    Method string                       `json:"method"`
+    Params []hexutil.Bytes              `json:"params"`
+}
+
+
+// JSON-RPC response error
+type rpcErrorResponse struct {
+    Error *struct {
+        Message string                  `json:"message"`
+    }                                   `json:"error"`
+}
+
+
+// Create a transaction receipt redirecting transport for the pending transactions file at the path
+func NewReceiptTransport(base http.RoundTripper, pendingTxsPath string) http.RoundTripper {
+    return &receiptTransport{
+        base: base,
+        txStore: &pendingTxStore{path: pendingTxsPath},
+    }
+}
+
+
+// Send a request, redirecting transaction receipt requests for replaced transactions
+func (t *receiptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+    // Check request
+    if req.Method != http.MethodPost || req.Body == nil {
+        return t.base.RoundTrip(req)
+    }
+
+    // Read request body
+    body, err := ioutil.ReadAll(req.Body)
+    req.Body.Close()
+    if err != nil {
+        return nil, err
+    }
+
+    // Redirect transaction receipt requests
+    body = t.redirectReceiptRequest(body)
+
+    // Get the hash of a sent transaction
+    sentHash, sending := getSentTransactionHash(body)
+
+    // Send request
+    req = req.Clone(req.Context())
+    req.Body = ioutil.NopCloser(bytes.NewReader(body))
+    req.ContentLength = int64(len(body))
+    res, err := t.base.RoundTrip(req)
+    if !sending {
+        return res, err
+    }
+
+    // Remove the record of a tracked transaction which could not be sent
+    if err != nil {
+        t.txStore.remove(sentHash)
+        return nil, err
+    }
+    resBody, err := ioutil.ReadAll(res.Body)
+    res.Body.Close()
+    if err != nil {
+        t.txStore.remove(sentHash)
+        return nil, err
+    }
+    res.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+    if isSendError(res.StatusCode, resBody) {
+        t.txStore.remove(sentHash)
+    }
+    return res, nil
+
+}
+
+
+// Replace the transaction hash in a receipt request with its latest replacement, if any
+func (t *receiptTransport) redirectReceiptRequest(body []byte) []byte {
+
+    // Decode request
+    if !bytes.Contains(body, []byte(TransactionReceiptMethod)) {
+        return body
+    }
+    var request receiptRequest
+    if err := json.Unmarshal(body, &request); err != nil || request.Method != TransactionReceiptMethod || len(request.Params) != 1 {
+        return body
+    }
+
+    // Get replacement hash
+    replacementHash, ok := t.txStore.getReplacementHash(request.Params[0])
+    if !ok {
+        return body
+    }
+    request.Params[0] = replacementHash
+
+    // Encode & return request
+    redirectedBody, err := json.Marshal(request)
+    if err != nil {
+        return body
+    }
+    return redirectedBody
+
+}
+
+
+// Get the hash of the transaction sent by a raw transaction send request
+func getSentTransactionHash(body []byte) (common.Hash, bool) {
+    if !bytes.Contains(body, []byte(SendRawTransactionMethod)) {
+        return common.Hash{}, false
+    }
+    var request sendRequest
+    if err := json.Unmarshal(body, &request); err != nil || request.Method != SendRawTransactionMethod || len(request.Params) != 1 {
+        return common.Hash{}, false
+    }
+    tx := new(types.Transaction)
+    if err := tx.UnmarshalBinary(request.Params[0]); err != nil {
+        return common.Hash{}, false
+    }
+    return tx.Hash(), true
+}
+
+
+// Check whether a raw transaction send response is an error
+// Transactions already known to the node were sent previously, and are not send errors
+func isSendError(statusCode int, body []byte) bool {
+    if statusCode < 200 || statusCode >= 300 {
+        return true
+    }
+    var response rpcErrorResponse
+    if err := json.Unmarshal(body, &response); err != nil {
+        return true
+    }
+    return response.Error != nil && !strings.Contains(strings.ToLower(response.Error.Message), "already known")
+}
@@ -0,0 +1,87 @@
+package wallet
+
+import (
+    "context"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/ethclient"
+
+    "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+
+// Transaction hook, run on each signed node account transaction before it is sent
+// Returning an error prevents the transaction from being sent
+type TransactionHook func(from common.Address, tx *types.Transaction) error
+
+
+// Error returned in place of sending a transaction in dry run mode
+type DryRunError struct {
+    Result api.DryRunResult
+}
+func (e *DryRunError) Error() string {
+    return e.Result.String()
+}
+func (e *DryRunError) DryRunResult() *api.DryRunResult {
+    return &e.Result
+}
+
+
+// Add a node account transaction hook
+func (w *Wallet) AddTransactionHook(hook TransactionHook) {
+    w.txHooks = append(w.txHooks, hook)
+}
+
+
+// Simulate node account transactions against the pending state instead of sending them
+func (w *Wallet) EnableDryRun(ec *ethclient.Client) {
+    if !w.dryRunHooked {
+        w.AddTransactionHook(func(from common.Address, tx *types.Transaction) error {
+            if !w.dryRun {
+                return nil
+            }
+            return &DryRunError{Result: simulateTransaction(ec, from, tx)}
+        })
+        w.dryRunHooked = true
+    }
+    w.dryRun = true
+}
+
+
+// Send node account transactions normally after dry run mode has been enabled
+func (w *Wallet) DisableDryRun() {
+    w.dryRun = false
+}
+
+
+// Estimate gas & check for reverts on a transaction
+func simulateTransaction(ec *ethclient.Client, from common.Address, tx *types.Transaction) api.DryRunResult {
+
+    // Initialize result
+    result := api.DryRunResult{
+        From: from,
+        To: tx.To(),
+        Value: tx.Value(),
+        Data: tx.Data(),
+        GasPrice: tx.GasPrice(),
+        Cost: big.NewInt(0),
+    }
+
+    // Estimate gas against the pending state; estimation fails with the revert reason if the transaction would revert
+    gasEstimate, err := ec.EstimateGas(context.Background(), getCallMsg(from, tx))
+    if err != nil {
+        result.Reverted = true
+        result.RevertReason = err.Error()
+        return result
+    }
+
+    // Calculate cost at gas price; the gas price of an EIP-1559 transaction is its fee cap
+    result.GasEstimate = gasEstimate
+    result.Cost.Mul(new(big.Int).SetUint64(gasEstimate), tx.GasPrice())
+
+    // Return
+    return result
+
+}
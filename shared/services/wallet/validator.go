@@ -4,11 +4,15 @@ import (
     "bytes"
     "errors"
     "fmt"
+    "sort"
     "sync"
 
     rptypes "github.com/rocket-pool/rocketpool-go/types"
     eth2types "github.com/wealdtech/go-eth2-types/v2"
     eth2util "github.com/wealdtech/go-eth2-util"
+
+    "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
 )
 
 
@@ -94,6 +98,27 @@ func (w *Wallet) GetValidatorKeyByPubkey(pubkey rptypes.ValidatorPubkey) (*eth2t
 }
 
 
+// Get the next validator key without storing it or advancing the account index
+// The same key is returned by CreateValidatorKey, so it can be checked before it is used
+func (w *Wallet) GetNextValidatorKey() (*eth2types.BLSPrivateKey, error) {
+
+    // Check wallet is initialized
+    if !w.IsInitialized() {
+        return nil, errors.New("Wallet is not initialized")
+    }
+
+    // Get validator key
+    key, _, err := w.getValidatorPrivateKey(w.ws.NextAccount)
+    if err != nil {
+        return nil, err
+    }
+
+    // Return validator key
+    return key, nil
+
+}
+
+
 // Create a new validator key
 func (w *Wallet) CreateValidatorKey() (*eth2types.BLSPrivateKey, error) {
 
@@ -102,12 +127,8 @@ func (w *Wallet) CreateValidatorKey() (*eth2types.BLSPrivateKey, error) {
         return nil, errors.New("Wallet is not initialized")
     }
 
-    // Get & increment account index
-    index := w.ws.NextAccount
-    w.ws.NextAccount++
-
     // Get validator key
-    key, path, err := w.getValidatorPrivateKey(index)
+    key, path, err := w.getValidatorPrivateKey(w.ws.NextAccount)
     if err != nil {
         return nil, err
     }
@@ -119,6 +140,9 @@ func (w *Wallet) CreateValidatorKey() (*eth2types.BLSPrivateKey, error) {
         }
     }
 
+    // Increment account index
+    w.ws.NextAccount++
+
     // Return validator key
     return key, nil
 
@@ -133,13 +157,175 @@ func (w *Wallet) RecoverValidatorKey(pubkey rptypes.ValidatorPubkey) error {
         return errors.New("Wallet is not initialized")
     }
 
+    // Find validator key
+    validatorKey, derivationPath, err := w.recoverValidatorPrivateKey(pubkey)
+    if err != nil {
+        return err
+    }
+
+    // Update keystores
+    for name, ks := range w.keystores {
+        if err := ks.StoreValidatorKey(validatorKey, derivationPath); err != nil {
+            return fmt.Errorf("Could not store %s validator key: %w", name, err)
+        }
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Get the names of the validator client keystores, in order
+func (w *Wallet) GetKeystoreNames() []string {
+    names := []string{}
+    for name := range w.keystores {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return names
+}
+
+
+// List the validator keys stored in a validator client's keystore
+func (w *Wallet) ListStoredValidatorKeys(keystoreName string) ([]rptypes.ValidatorPubkey, error) {
+    ks, err := w.getKeystore(keystoreName)
+    if err != nil {
+        return nil, err
+    }
+    pubkeys, err := ks.ListValidatorKeys()
+    if err != nil {
+        return nil, fmt.Errorf("Could not list %s validator keys: %w", keystoreName, err)
+    }
+    return pubkeys, nil
+}
+
+
+// Check that a validator key stored in a validator client's keystore can be loaded and matches the key derived from the wallet
+// Keys held by keystores which can't provide them (e.g. remote signers) are checked for presence only
+func (w *Wallet) CheckStoredValidatorKey(keystoreName string, pubkey rptypes.ValidatorPubkey) error {
+
+    // Check wallet is initialized
+    if !w.IsInitialized() {
+        return errors.New("Wallet is not initialized")
+    }
+
+    // Get keystore
+    ks, err := w.getKeystore(keystoreName)
+    if err != nil {
+        return err
+    }
+
+    // Initialize BLS support
+    initializeBLS()
+
+    // Load stored key
+    storedKey, err := ks.LoadValidatorKey(pubkey)
+    if err != nil && !errors.Is(err, keystore.ErrKeyUnavailable) {
+        return err
+    }
+
+    // Get derived key & compare; keys which can't be loaded are only checked for presence
+    derivedKey, err := w.GetValidatorKeyByPubkey(pubkey)
+    if err != nil {
+        return errors.New("Validator key is not derived from the node wallet")
+    }
+    if storedKey != nil && !bytes.Equal(storedKey.Marshal(), derivedKey.Marshal()) {
+        return errors.New("Validator key does not match the key derived from the node wallet")
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Replace a validator key in a validator client's keystore with the key derived from the wallet
+func (w *Wallet) RestoreStoredValidatorKey(keystoreName string, pubkey rptypes.ValidatorPubkey) error {
+
+    // Check wallet is initialized
+    if !w.IsInitialized() {
+        return errors.New("Wallet is not initialized")
+    }
+
+    // Get keystore
+    ks, err := w.getKeystore(keystoreName)
+    if err != nil {
+        return err
+    }
+
+    // Find validator key
+    validatorKey, derivationPath, err := w.recoverValidatorPrivateKey(pubkey)
+    if err != nil {
+        return err
+    }
+
+    // Replace stored key
+    if err := ks.DeleteValidatorKey(pubkey); err != nil {
+        return fmt.Errorf("Could not delete %s validator key: %w", keystoreName, err)
+    }
+    if err := ks.StoreValidatorKey(validatorKey, derivationPath); err != nil {
+        return fmt.Errorf("Could not store %s validator key: %w", keystoreName, err)
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Delete a validator key from a validator client's keystore
+func (w *Wallet) DeleteStoredValidatorKey(keystoreName string, pubkey rptypes.ValidatorPubkey) error {
+    ks, err := w.getKeystore(keystoreName)
+    if err != nil {
+        return err
+    }
+    if err := ks.DeleteValidatorKey(pubkey); err != nil {
+        return fmt.Errorf("Could not delete %s validator key: %w", keystoreName, err)
+    }
+    return nil
+}
+
+
+// Load the slashing protection history held by a keystore
+// Returns nil if no history is stored
+func (w *Wallet) LoadSlashingProtection(keystoreName string) (*eth2.SlashingProtectionInterchange, error) {
+    ks, err := w.getSlashingProtectionKeystore(keystoreName)
+    if err != nil {
+        return nil, err
+    }
+    interchange, err := ks.LoadSlashingProtection()
+    if err != nil {
+        return nil, fmt.Errorf("Could not load %s slashing protection history: %w", keystoreName, err)
+    }
+    return interchange, nil
+}
+
+
+// Store slashing protection history in a keystore which holds it
+func (w *Wallet) StoreSlashingProtection(keystoreName string, interchange *eth2.SlashingProtectionInterchange) error {
+    ks, err := w.getSlashingProtectionKeystore(keystoreName)
+    if err != nil {
+        return err
+    }
+    if err := ks.StoreSlashingProtection(interchange); err != nil {
+        return fmt.Errorf("Could not store %s slashing protection history: %w", keystoreName, err)
+    }
+    return nil
+}
+
+
+// Find a validator private key by public key, searching past the next account index
+// The next account index is updated if the key is found past it
+func (w *Wallet) recoverValidatorPrivateKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, string, error) {
+
     // Find matching validator key
     var index uint
     var validatorKey *eth2types.BLSPrivateKey
     var derivationPath string
     for index = 0; index < w.ws.NextAccount + MaxValidatorKeyRecoverAttempts; index++ {
         if key, path, err := w.getValidatorPrivateKey(index); err != nil {
-            return err
+            return nil, "", err
         } else if bytes.Equal(pubkey.Bytes(), key.PublicKey().Marshal()) {
             validatorKey = key
             derivationPath = path
@@ -149,7 +335,7 @@ func (w *Wallet) RecoverValidatorKey(pubkey rptypes.ValidatorPubkey) error {
 
     // Check validator key
     if validatorKey == nil {
-        return fmt.Errorf("Validator %s key not found", pubkey.Hex())
+        return nil, "", fmt.Errorf("Validator %s key not found", pubkey.Hex())
     }
 
     // Update account index
@@ -158,16 +344,33 @@ func (w *Wallet) RecoverValidatorKey(pubkey rptypes.ValidatorPubkey) error {
         w.ws.NextAccount = nextIndex
     }
 
-    // Update keystores
-    for name, ks := range w.keystores {
-        if err := ks.StoreValidatorKey(validatorKey, derivationPath); err != nil {
-            return fmt.Errorf("Could not store %s validator key: %w", name, err)
-        }
+    // Return
+    return validatorKey, derivationPath, nil
+
+}
+
+
+// Get a validator client keystore by name
+func (w *Wallet) getKeystore(name string) (keystore.Keystore, error) {
+    ks, ok := w.keystores[name]
+    if !ok {
+        return nil, fmt.Errorf("Unknown validator keystore '%s'", name)
     }
+    return ks, nil
+}
 
-    // Return
-    return nil
 
+// Get a keystore which holds slashing protection history by name
+func (w *Wallet) getSlashingProtectionKeystore(name string) (keystore.SlashingProtectionKeystore, error) {
+    ks, err := w.getKeystore(name)
+    if err != nil {
+        return nil, err
+    }
+    spks, ok := ks.(keystore.SlashingProtectionKeystore)
+    if !ok {
+        return nil, fmt.Errorf("The %s validator keystore does not hold slashing protection history; it is kept by the validator client", name)
+    }
+    return spks, nil
 }
 
 
@@ -7,9 +7,14 @@ import (
     "fmt"
     "io/ioutil"
     "math/big"
+    "os"
+    "sync"
 
     "github.com/btcsuite/btcd/chaincfg"
     "github.com/btcsuite/btcutil/hdkeychain"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/ethereum/go-ethereum/rpc"
     "github.com/google/uuid"
     "github.com/tyler-smith/go-bip39"
     eth2types "github.com/wealdtech/go-eth2-types/v2"
@@ -46,6 +51,7 @@ type Wallet struct {
     // Node key cache
     nodeKey *ecdsa.PrivateKey
     nodeKeyPath string
+    nodeKeyLock sync.Mutex
 
     // Validator key caches
     validatorKeys map[uint]*eth2types.BLSPrivateKey
@@ -58,6 +64,23 @@ type Wallet struct {
     gasPrice *big.Int
     gasLimit uint64
 
+    // Gas pricing settings & clients
+    gasSettings *GasSettings
+    ec *ethclient.Client
+    rc *rpc.Client
+
+    // Node transaction hooks
+    txHooks []TransactionHook
+    dryRun bool
+    dryRunHooked bool
+
+    // Pending transaction tracking
+    txStore *pendingTxStore
+
+    // Nonces assigned to untracked transactions
+    nonceReservations map[common.Address]nonceReservation
+    nonceLock sync.Mutex
+
 }
 
 
@@ -89,6 +112,7 @@ func NewWallet(walletPath, chainIDStr string, gasPrice *big.Int, gasLimit uint64
         validatorKeys: map[uint]*eth2types.BLSPrivateKey{},
         validatorKeyIndices: map[string]uint{},
         keystores: map[string]keystore.Keystore{},
+        nonceReservations: map[common.Address]nonceReservation{},
         gasPrice: gasPrice,
         gasLimit: gasLimit,
     }
@@ -104,6 +128,14 @@ func NewWallet(walletPath, chainIDStr string, gasPrice *big.Int, gasLimit uint64
 }
 
 
+// Set the desired gas price & limit, overriding the config
+// A nil gas price or zero gas limit uses the configured gas pricing strategy & estimation
+func (w *Wallet) SetGasOptions(gasPrice *big.Int, gasLimit uint64) {
+    w.gasPrice = gasPrice
+    w.gasLimit = gasLimit
+}
+
+
 // Add a keystore to the wallet
 func (w *Wallet) AddKeystore(name string, ks keystore.Keystore) {
     w.keystores[name] = ks
@@ -125,6 +157,35 @@ func (w *Wallet) GetInitialized() (bool, error) {
 }
 
 
+// Check that a password decrypts the wallet store on disk
+// Any password is accepted if no wallet store exists yet, and either password is accepted if a password change was interrupted
+func (w *Wallet) CheckPassword(password string) error {
+
+    // Get wallet store paths
+    paths := []string{w.walletPath}
+    if journal, err := w.loadPasswordChangeJournal(); err != nil {
+        return err
+    } else if journal != nil {
+        paths = append(paths, w.walletPath + StagedFileSuffix, w.walletPath + ReplacedFileSuffix)
+    }
+
+    // Check password
+    storeExists := false
+    for _, path := range paths {
+        if !fileExists(path) { continue }
+        storeExists = true
+        if w.decryptsStore(path, password) {
+            return nil
+        }
+    }
+    if storeExists {
+        return errors.New("The password does not match the node wallet")
+    }
+    return nil
+
+}
+
+
 // Serialize the wallet to a JSON string
 func (w *Wallet) String() (string, error) {
 
@@ -201,6 +262,7 @@ func (w *Wallet) Recover(mnemonic string) error {
 
 
 // Save the wallet store to disk
+// The seed is encrypted with the current node password, so a wallet loaded before a password change is saved with the new password
 func (w *Wallet) Save() error {
 
     // Check wallet is initialized
@@ -208,6 +270,19 @@ func (w *Wallet) Save() error {
         return errors.New("Wallet is not initialized")
     }
 
+    // Get wallet password
+    password, err := w.pm.GetPassword()
+    if err != nil {
+        return fmt.Errorf("Could not get wallet password: %w", err)
+    }
+
+    // Encrypt seed
+    encryptedSeed, err := w.encryptor.Encrypt(w.seed, password)
+    if err != nil {
+        return fmt.Errorf("Could not encrypt wallet seed: %w", err)
+    }
+    w.ws.Crypto = encryptedSeed
+
     // Encode wallet store
     wsBytes, err := json.Marshal(w.ws)
     if err != nil {
@@ -215,7 +290,7 @@ func (w *Wallet) Save() error {
     }
 
     // Write wallet store to disk
-    if err := ioutil.WriteFile(w.walletPath, wsBytes, FileMode); err != nil {
+    if err := keystore.WriteFileAtomic(w.walletPath, wsBytes); err != nil {
         return fmt.Errorf("Could not write wallet to disk: %w", err)
     }
 
@@ -228,23 +303,39 @@ func (w *Wallet) Save() error {
 // Load the wallet store from disk and decrypt it
 func (w *Wallet) loadStore() (bool, error) {
 
-    // Read wallet store from disk; cancel if not found
-    wsBytes, err := ioutil.ReadFile(w.walletPath)
+    // Get wallet password; the wallet is loaded once the password is set
+    passwordSet, err := w.pm.IsPasswordSet()
     if err != nil {
+        return false, fmt.Errorf("Could not check wallet password: %w", err)
+    }
+    if !passwordSet {
         return false, nil
     }
+    password, err := w.pm.GetPassword()
+    if err != nil {
+        return false, fmt.Errorf("Could not get wallet password: %w", err)
+    }
 
-    // Decode wallet store
-    w.ws = new(walletStore)
-    if err = json.Unmarshal(wsBytes, w.ws); err != nil {
-        return false, fmt.Errorf("Could not decode wallet: %w", err)
+    // Complete or reverse an interrupted password change
+    journal, err := w.loadPasswordChangeJournal()
+    if err != nil {
+        return false, err
+    }
+    if journal != nil {
+        if err := w.recoverPasswordChange(journal, password); err != nil {
+            return false, err
+        }
     }
 
-    // Get wallet password
-    password, err := w.pm.GetPassword()
+    // Read wallet store from disk; cancel if not found
+    ws, err := readStore(w.walletPath)
     if err != nil {
-        return false, fmt.Errorf("Could not get wallet password: %w", err)
+        return false, err
+    }
+    if ws == nil {
+        return false, nil
     }
+    w.ws = ws
 
     // Decrypt seed
     w.seed, err = w.encryptor.Decrypt(w.ws.Crypto, password)
@@ -303,3 +394,20 @@ func (w *Wallet) initializeStore(mnemonic string) error {
 
 }
 
+
+// Read and decode a wallet store from disk
+// Returns nil if the wallet store doesn't exist
+func readStore(path string) (*walletStore, error) {
+    wsBytes, err := ioutil.ReadFile(path)
+    if os.IsNotExist(err) {
+        return nil, nil
+    } else if err != nil {
+        return nil, fmt.Errorf("Could not read wallet from disk: %w", err)
+    }
+    ws := new(walletStore)
+    if err := json.Unmarshal(wsBytes, ws); err != nil {
+        return nil, fmt.Errorf("Could not decode wallet: %w", err)
+    }
+    return ws, nil
+}
+
@@ -1,8 +1,60 @@
 package api
 
+import (
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
 
 type APIResponse struct {
     Status string   `json:"status"`
     Error string    `json:"error"`
 }
 
+
+type APIRequest struct {
+    Args []string                       `json:"args"`
+    GasPrice string                     `json:"gasPrice"`
+    GasLimit string                     `json:"gasLimit"`
+    DryRun bool                         `json:"dryRun"`
+}
+type APIServerTokenResponse struct {
+    Status string                       `json:"status"`
+    Error string                        `json:"error"`
+    Token string                        `json:"token"`
+}
+
+
+type DryRunResult struct {
+    From common.Address                 `json:"from"`
+    To *common.Address                  `json:"to"`
+    Value *big.Int                      `json:"value"`
+    Data hexutil.Bytes                  `json:"data"`
+    GasEstimate uint64                  `json:"gasEstimate"`
+    GasPrice *big.Int                   `json:"gasPrice"`
+    Cost *big.Int                       `json:"cost"`
+    Reverted bool                       `json:"reverted"`
+    RevertReason string                 `json:"revertReason"`
+}
+type DryRunResponse struct {
+    Status string                       `json:"status"`
+    Error string                        `json:"error"`
+    DryRun *DryRunResult                `json:"dryRun,omitempty"`
+}
+
+
+// Get a dry run result summary
+func (r DryRunResult) String() string {
+    to := "a new contract"
+    if r.To != nil {
+        to = r.To.Hex()
+    }
+    if r.Reverted {
+        return fmt.Sprintf("Dry run: the transaction to %s would fail: %s", to, r.RevertReason)
+    }
+    return fmt.Sprintf("Dry run: the transaction to %s was not sent. Estimated gas: %d; cost at %.6f gwei: %.6f ETH.", to, r.GasEstimate, eth.WeiToGwei(r.GasPrice), eth.WeiToEth(r.Cost))
+}
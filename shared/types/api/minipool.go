@@ -41,6 +41,27 @@ type ValidatorDetails struct {
 }
 
 
+type MinipoolPerformanceResponse struct {
+    Status string                           `json:"status"`
+    Error string                            `json:"error"`
+    StartEpoch uint64                       `json:"startEpoch"`
+    EndEpoch uint64                         `json:"endEpoch"`
+    Minipools []MinipoolPerformance         `json:"minipools"`
+}
+type MinipoolPerformance struct {
+    Address common.Address                  `json:"address"`
+    ValidatorPubkey types.ValidatorPubkey   `json:"validatorPubkey"`
+    ValidatorIndex uint64                   `json:"validatorIndex"`
+    AttestationsExpected uint64             `json:"attestationsExpected"`
+    AttestationsMissed uint64               `json:"attestationsMissed"`
+    BlocksProposed uint64                   `json:"blocksProposed"`
+    BlocksMissed uint64                     `json:"blocksMissed"`
+    StartBalance uint64                     `json:"startBalance"`
+    EndBalance uint64                       `json:"endBalance"`
+    Apr float64                             `json:"apr"`
+}
+
+
 type CanRefundMinipoolResponse struct {
     Status string                   `json:"status"`
     Error string                    `json:"error"`
@@ -6,6 +6,7 @@ import (
     "github.com/ethereum/go-ethereum/common"
 
     "github.com/rocket-pool/rocketpool-go/tokens"
+    "github.com/rocket-pool/rocketpool-go/types"
 )
 
 
@@ -152,3 +153,71 @@ type NodeBurnResponse struct {
     TxHash common.Hash                  `json:"txHash"`
 }
 
+
+
+type PendingTransaction struct {
+    Hash common.Hash                    `json:"hash"`
+    Hashes []common.Hash                `json:"hashes"`
+    Nonce uint64                        `json:"nonce"`
+    To *common.Address                  `json:"to"`
+    Value *big.Int                      `json:"value"`
+    GasLimit uint64                     `json:"gasLimit"`
+    GasPrice *big.Int                   `json:"gasPrice"`
+    Status string                       `json:"status"`
+    FirstSent int64                     `json:"firstSent"`
+    LastSent int64                      `json:"lastSent"`
+    Replacements int                    `json:"replacements"`
+}
+type NodePendingTxsResponse struct {
+    Status string                       `json:"status"`
+    Error string                        `json:"error"`
+    Transactions []PendingTransaction   `json:"transactions"`
+}
+
+
+type NodeRewardsReportResponse struct {
+    Status string                       `json:"status"`
+    Error string                        `json:"error"`
+    StartTime int64                     `json:"startTime"`
+    EndTime int64                       `json:"endTime"`
+    StartBlock uint64                   `json:"startBlock"`
+    EndBlock uint64                     `json:"endBlock"`
+    StartEpoch uint64                   `json:"startEpoch"`
+    EndEpoch uint64                     `json:"endEpoch"`
+    Minipools []MinipoolRewards         `json:"minipools"`
+    EthWithdrawals []EthWithdrawal      `json:"ethWithdrawals"`
+    RplClaims []RplRewardsClaim         `json:"rplClaims"`
+    TotalNodeShare *big.Int             `json:"totalNodeShare"`
+    TotalEthWithdrawn *big.Int          `json:"totalEthWithdrawn"`
+    TotalRplClaimed *big.Int            `json:"totalRplClaimed"`
+    TotalRplValue *big.Int              `json:"totalRplValue"`
+}
+type MinipoolRewards struct {
+    Address common.Address                  `json:"address"`
+    ValidatorPubkey types.ValidatorPubkey   `json:"validatorPubkey"`
+    ValidatorIndex uint64                   `json:"validatorIndex"`
+    NodeFee float64                         `json:"nodeFee"`
+    NodeDepositBalance *big.Int             `json:"nodeDepositBalance"`
+    UserDepositBalance *big.Int             `json:"userDepositBalance"`
+    StartBalance *big.Int                   `json:"startBalance"`
+    EndBalance *big.Int                     `json:"endBalance"`
+    BalanceChange *big.Int                  `json:"balanceChange"`
+    NodeShare *big.Int                      `json:"nodeShare"`
+    EthWithdrawn *big.Int                   `json:"ethWithdrawn"`
+}
+type EthWithdrawal struct {
+    Minipool common.Address             `json:"minipool"`
+    To common.Address                   `json:"to"`
+    Block uint64                        `json:"block"`
+    Time int64                          `json:"time"`
+    TxHash common.Hash                  `json:"txHash"`
+    Amount *big.Int                     `json:"amount"`
+}
+type RplRewardsClaim struct {
+    Block uint64                        `json:"block"`
+    Time int64                          `json:"time"`
+    TxHash common.Hash                  `json:"txHash"`
+    Amount *big.Int                     `json:"amount"`
+    RplPrice *big.Int                   `json:"rplPrice"`
+    EthValue *big.Int                   `json:"ethValue"`
+}
@@ -3,6 +3,8 @@ package api
 import (
     "github.com/ethereum/go-ethereum/common"
     "github.com/rocket-pool/rocketpool-go/types"
+
+    "github.com/rocket-pool/smartnode/shared/types/eth2"
 )
 
 
@@ -21,6 +23,13 @@ type SetPasswordResponse struct {
 }
 
 
+type ChangePasswordResponse struct {
+    Status string                           `json:"status"`
+    Error string                            `json:"error"`
+    Keystores []string                      `json:"keystores"`
+}
+
+
 type InitWalletResponse struct {
     Status string                           `json:"status"`
     Error string                            `json:"error"`
@@ -52,3 +61,43 @@ type ExportWalletResponse struct {
     AccountPrivateKey string                `json:"accountPrivateKey"`
 }
 
+
+type ExportSlashingProtectionResponse struct {
+    Status string                           `json:"status"`
+    Error string                            `json:"error"`
+    Interchange eth2.SlashingProtectionInterchange `json:"interchange"`
+}
+
+
+type ImportSlashingProtectionResponse struct {
+    Status string                           `json:"status"`
+    Error string                            `json:"error"`
+    ValidatorKeys []types.ValidatorPubkey   `json:"validatorKeys"`
+    MissingValidatorKeys []types.ValidatorPubkey `json:"missingValidatorKeys"`
+}
+
+
+type VerifyValidatorKeysResponse struct {
+    Status string                           `json:"status"`
+    Error string                            `json:"error"`
+    ValidatorKeys []types.ValidatorPubkey   `json:"validatorKeys"`
+    Keystores []KeystoreValidatorKeys       `json:"keystores"`
+    Repaired bool                           `json:"repaired"`
+    DeletedExtra bool                       `json:"deletedExtra"`
+}
+type KeystoreValidatorKeys struct {
+    Client string                           `json:"client"`
+    Error string                            `json:"error"`
+    KeyCount int                            `json:"keyCount"`
+    MissingKeys []types.ValidatorPubkey     `json:"missingKeys"`
+    ExtraKeys []types.ValidatorPubkey       `json:"extraKeys"`
+    UnknownKeys []types.ValidatorPubkey     `json:"unknownKeys"`
+    InvalidKeys []InvalidValidatorKey       `json:"invalidKeys"`
+    DeletedKeys []types.ValidatorPubkey     `json:"deletedKeys"`
+    RetainedKeys []InvalidValidatorKey      `json:"retainedKeys"`
+}
+type InvalidValidatorKey struct {
+    Pubkey types.ValidatorPubkey            `json:"pubkey"`
+    Error string                            `json:"error"`
+}
+
@@ -0,0 +1,249 @@
+package eth2
+
+import (
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "strings"
+
+    "github.com/rocket-pool/rocketpool-go/types"
+
+    hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+
+// EIP-3076 slashing protection interchange format version
+const InterchangeFormatVersion = "5"
+
+
+// EIP-3076 slashing protection interchange
+type SlashingProtectionInterchange struct {
+    Metadata SlashingProtectionMetadata         `json:"metadata"`
+    Data []SlashingProtectionData               `json:"data"`
+}
+type SlashingProtectionMetadata struct {
+    InterchangeFormatVersion string             `json:"interchange_format_version"`
+    GenesisValidatorsRoot string                `json:"genesis_validators_root"`
+}
+type SlashingProtectionData struct {
+    Pubkey string                               `json:"pubkey"`
+    SignedBlocks []SignedBlock                  `json:"signed_blocks"`
+    SignedAttestations []SignedAttestation      `json:"signed_attestations"`
+}
+type SignedBlock struct {
+    Slot uint64                                 `json:"slot,string"`
+    SigningRoot string                          `json:"signing_root,omitempty"`
+}
+type SignedAttestation struct {
+    SourceEpoch uint64                          `json:"source_epoch,string"`
+    TargetEpoch uint64                          `json:"target_epoch,string"`
+    SigningRoot string                          `json:"signing_root,omitempty"`
+}
+
+
+// Create a new, empty slashing protection interchange
+func NewSlashingProtectionInterchange(genesisValidatorsRoot []byte) *SlashingProtectionInterchange {
+    return &SlashingProtectionInterchange{
+        Metadata: SlashingProtectionMetadata{
+            InterchangeFormatVersion: InterchangeFormatVersion,
+            GenesisValidatorsRoot: hexutil.AddPrefix(hex.EncodeToString(genesisValidatorsRoot)),
+        },
+        Data: []SlashingProtectionData{},
+    }
+}
+
+
+// Decode and validate a slashing protection interchange
+func ParseSlashingProtectionInterchange(bytes []byte) (*SlashingProtectionInterchange, error) {
+
+    // Decode interchange
+    interchange := &SlashingProtectionInterchange{}
+    if err := json.Unmarshal(bytes, interchange); err != nil {
+        return nil, fmt.Errorf("Could not decode slashing protection interchange: %w", err)
+    }
+
+    // Check metadata
+    if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+        return nil, fmt.Errorf("Unsupported slashing protection interchange format version '%s' - only version %s is supported", interchange.Metadata.InterchangeFormatVersion, InterchangeFormatVersion)
+    }
+    if root, err := hex.DecodeString(hexutil.RemovePrefix(interchange.Metadata.GenesisValidatorsRoot)); err != nil || len(root) != 32 {
+        return nil, fmt.Errorf("Invalid slashing protection interchange genesis validators root '%s'", interchange.Metadata.GenesisValidatorsRoot)
+    }
+    interchange.Metadata.GenesisValidatorsRoot = normalizeHex(interchange.Metadata.GenesisValidatorsRoot)
+
+    // Check validator data
+    for di := range interchange.Data {
+        data := &interchange.Data[di]
+        if pubkey, err := hex.DecodeString(hexutil.RemovePrefix(data.Pubkey)); err != nil || len(pubkey) != types.ValidatorPubkeyLength {
+            return nil, fmt.Errorf("Invalid validator pubkey '%s' in slashing protection interchange", data.Pubkey)
+        }
+        data.Pubkey = normalizeHex(data.Pubkey)
+        for _, attestation := range data.SignedAttestations {
+            if attestation.SourceEpoch > attestation.TargetEpoch {
+                return nil, fmt.Errorf("Invalid attestation for validator %s in slashing protection interchange - source epoch %d is after target epoch %d", data.Pubkey, attestation.SourceEpoch, attestation.TargetEpoch)
+            }
+        }
+    }
+
+    // Return
+    return interchange, nil
+
+}
+
+
+// Get the slashing protection data for a validator; returns nil if the validator has no data
+func (i *SlashingProtectionInterchange) GetData(pubkey types.ValidatorPubkey) *SlashingProtectionData {
+    pubkeyHex := hexutil.AddPrefix(pubkey.Hex())
+    for di := range i.Data {
+        if i.Data[di].Pubkey == pubkeyHex {
+            return &i.Data[di]
+        }
+    }
+    return nil
+}
+
+
+// Get the pubkeys of the validators with slashing protection data
+func (i *SlashingProtectionInterchange) GetPubkeys() ([]types.ValidatorPubkey, error) {
+    pubkeys := make([]types.ValidatorPubkey, len(i.Data))
+    for di, data := range i.Data {
+        pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(data.Pubkey))
+        if err != nil {
+            return []types.ValidatorPubkey{}, fmt.Errorf("Invalid validator pubkey '%s': %w", data.Pubkey, err)
+        }
+        pubkeys[di] = pubkey
+    }
+    return pubkeys, nil
+}
+
+
+// Merge the slashing protection data for a set of validators from another interchange into this one
+// Data for all validators is merged if pubkeys is nil
+func (i *SlashingProtectionInterchange) Merge(other *SlashingProtectionInterchange, pubkeys []types.ValidatorPubkey) error {
+
+    // Check genesis validators roots
+    if normalizeHex(other.Metadata.GenesisValidatorsRoot) != i.Metadata.GenesisValidatorsRoot {
+        return errors.New("Slashing protection interchange is for a different network (genesis validators root does not match)")
+    }
+
+    // Merge validator data
+    for _, otherData := range other.Data {
+        if pubkeys != nil && !containsPubkey(pubkeys, otherData.Pubkey) {
+            continue
+        }
+        data := i.getOrAddData(otherData.Pubkey)
+        for _, block := range otherData.SignedBlocks {
+            if !containsBlock(data.SignedBlocks, block) {
+                data.SignedBlocks = append(data.SignedBlocks, block)
+            }
+        }
+        for _, attestation := range otherData.SignedAttestations {
+            if !containsAttestation(data.SignedAttestations, attestation) {
+                data.SignedAttestations = append(data.SignedAttestations, attestation)
+            }
+        }
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Add a watermark for a validator, preventing it from signing blocks at or before a slot and attestations at or before an epoch
+func (i *SlashingProtectionInterchange) AddWatermark(pubkey types.ValidatorPubkey, slot, sourceEpoch, targetEpoch uint64) {
+    data := i.getOrAddData(hexutil.AddPrefix(pubkey.Hex()))
+    block := SignedBlock{Slot: slot}
+    attestation := SignedAttestation{SourceEpoch: sourceEpoch, TargetEpoch: targetEpoch}
+    if !containsBlock(data.SignedBlocks, block) {
+        data.SignedBlocks = append(data.SignedBlocks, block)
+    }
+    if !containsAttestation(data.SignedAttestations, attestation) {
+        data.SignedAttestations = append(data.SignedAttestations, attestation)
+    }
+}
+
+
+// Reduce the interchange to the minimal format, with a single block and attestation per validator
+// The block and attestation are at the highest slot and source & target epochs signed, and have no signing roots
+func (i *SlashingProtectionInterchange) Minify() {
+    for di := range i.Data {
+        data := &i.Data[di]
+        if len(data.SignedBlocks) > 0 {
+            var block SignedBlock
+            for _, signedBlock := range data.SignedBlocks {
+                if signedBlock.Slot > block.Slot { block.Slot = signedBlock.Slot }
+            }
+            data.SignedBlocks = []SignedBlock{block}
+        }
+        if len(data.SignedAttestations) > 0 {
+            var attestation SignedAttestation
+            for _, signedAttestation := range data.SignedAttestations {
+                if signedAttestation.SourceEpoch > attestation.SourceEpoch { attestation.SourceEpoch = signedAttestation.SourceEpoch }
+                if signedAttestation.TargetEpoch > attestation.TargetEpoch { attestation.TargetEpoch = signedAttestation.TargetEpoch }
+            }
+            data.SignedAttestations = []SignedAttestation{attestation}
+        }
+    }
+}
+
+
+// Get the slashing protection data for a validator by pubkey hex string, adding it if it doesn't exist
+func (i *SlashingProtectionInterchange) getOrAddData(pubkey string) *SlashingProtectionData {
+    pubkey = normalizeHex(pubkey)
+    for di := range i.Data {
+        if i.Data[di].Pubkey == pubkey {
+            return &i.Data[di]
+        }
+    }
+    i.Data = append(i.Data, SlashingProtectionData{
+        Pubkey: pubkey,
+        SignedBlocks: []SignedBlock{},
+        SignedAttestations: []SignedAttestation{},
+    })
+    return &i.Data[len(i.Data) - 1]
+}
+
+
+// Check whether a list of pubkeys contains a pubkey hex string
+func containsPubkey(pubkeys []types.ValidatorPubkey, pubkey string) bool {
+    pubkey = normalizeHex(pubkey)
+    for _, p := range pubkeys {
+        if hexutil.AddPrefix(p.Hex()) == pubkey {
+            return true
+        }
+    }
+    return false
+}
+
+
+// Check whether a list of signed blocks contains a block
+func containsBlock(blocks []SignedBlock, block SignedBlock) bool {
+    for _, b := range blocks {
+        if b.Slot == block.Slot && normalizeHex(b.SigningRoot) == normalizeHex(block.SigningRoot) {
+            return true
+        }
+    }
+    return false
+}
+
+
+// Check whether a list of signed attestations contains an attestation
+func containsAttestation(attestations []SignedAttestation, attestation SignedAttestation) bool {
+    for _, a := range attestations {
+        if a.SourceEpoch == attestation.SourceEpoch && a.TargetEpoch == attestation.TargetEpoch && normalizeHex(a.SigningRoot) == normalizeHex(attestation.SigningRoot) {
+            return true
+        }
+    }
+    return false
+}
+
+
+// Normalize a hex string to lower case with a prefix; empty strings are unchanged
+func normalizeHex(value string) string {
+    if value == "" {
+        return ""
+    }
+    return hexutil.AddPrefix(strings.ToLower(hexutil.RemovePrefix(value)))
+}
@@ -4,20 +4,78 @@ import (
     "encoding/json"
     "errors"
     "fmt"
+    "io"
+    "os"
     "reflect"
 
+    "github.com/urfave/cli"
+
     "github.com/rocket-pool/smartnode/shared/types/api"
 )
 
 
+// App metadata key for the writer API responses are printed to
+const outputMetadataKey = "apiOutput"
+
+
+// API response printer
+type ResponsePrinter struct {
+    output io.Writer
+}
+
+
+// Errors carrying a simulated transaction result
+type dryRunError interface {
+    DryRunResult() *api.DryRunResult
+}
+
+
+// Get the printer for an API command's responses
+// Responses are printed to stdout unless the app has its own output (e.g. for each API server request)
+func Output(c *cli.Context) *ResponsePrinter {
+    if w, ok := c.App.Metadata[outputMetadataKey].(io.Writer); ok {
+        return NewResponsePrinter(w)
+    }
+    return NewResponsePrinter(os.Stdout)
+}
+
+
+// Create new API response printer
+func NewResponsePrinter(w io.Writer) *ResponsePrinter {
+    return &ResponsePrinter{output: w}
+}
+
+
+// Set the writer an app's API responses are printed to
+// The app metadata is shared with subcommands, so it must be set before the app is run
+func SetOutput(app *cli.App, w io.Writer) {
+    if app.Metadata == nil {
+        app.Metadata = map[string]interface{}{}
+    }
+    app.Metadata[outputMetadataKey] = w
+}
+
+
+// Print an API response to stdout
+func PrintResponse(response interface{}, responseError error) {
+    NewResponsePrinter(os.Stdout).PrintResponse(response, responseError)
+}
+
+
+// Print an API error response to stdout
+func PrintErrorResponse(err error) {
+    NewResponsePrinter(os.Stdout).PrintErrorResponse(err)
+}
+
+
 // Print an API response
 // response must be a pointer to a struct type with Error and Status string fields
-func PrintResponse(response interface{}, responseError error) {
+func (p *ResponsePrinter) PrintResponse(response interface{}, responseError error) {
 
     // Check response type
     r := reflect.ValueOf(response)
     if !(r.Kind() == reflect.Ptr && r.Type().Elem().Kind() == reflect.Struct) {
-        PrintErrorResponse(errors.New("Invalid API response"))
+        p.PrintErrorResponse(errors.New("Invalid API response"))
         return
     }
 
@@ -31,17 +89,25 @@ func PrintResponse(response interface{}, responseError error) {
     sf := r.Elem().FieldByName("Status")
     ef := r.Elem().FieldByName("Error")
     if !(sf.IsValid() && sf.CanSet() && sf.Kind() == reflect.String && ef.IsValid() && ef.CanSet() && ef.Kind() == reflect.String) {
-        PrintErrorResponse(errors.New("Invalid API response"))
+        p.PrintErrorResponse(errors.New("Invalid API response"))
         return
     }
 
-    // Populate error
+    // Populate error; transactions simulated in dry run mode are reported as results instead
+    var dryRunResult *api.DryRunResult
     if responseError != nil {
-        ef.SetString(responseError.Error())
+        var dryRunErr dryRunError
+        if errors.As(responseError, &dryRunErr) {
+            dryRunResult = dryRunErr.DryRunResult()
+        } else {
+            ef.SetString(responseError.Error())
+        }
     }
 
     // Set status
-    if ef.String() == "" {
+    if dryRunResult != nil {
+        sf.SetString("dryRun")
+    } else if ef.String() == "" {
         sf.SetString("success")
     } else {
         sf.SetString("error")
@@ -50,18 +116,41 @@ func PrintResponse(response interface{}, responseError error) {
     // Encode
     responseBytes, err := json.Marshal(response)
     if err != nil {
-        PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+        p.PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
         return
     }
 
+    // Add dry run result
+    if dryRunResult != nil {
+        responseBytes, err = addDryRunResult(responseBytes, dryRunResult)
+        if err != nil {
+            p.PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+            return
+        }
+    }
+
     // Print
-    fmt.Println(string(responseBytes))
+    fmt.Fprintln(p.output, string(responseBytes))
 
 }
 
 
 // Print an API error response
-func PrintErrorResponse(err error) {
-    PrintResponse(&api.APIResponse{}, err)
+func (p *ResponsePrinter) PrintErrorResponse(err error) {
+    p.PrintResponse(&api.APIResponse{}, err)
 }
 
+
+// Add a dry run result to an encoded API response
+func addDryRunResult(responseBytes []byte, result *api.DryRunResult) ([]byte, error) {
+    var fields map[string]json.RawMessage
+    if err := json.Unmarshal(responseBytes, &fields); err != nil {
+        return []byte{}, err
+    }
+    resultBytes, err := json.Marshal(result)
+    if err != nil {
+        return []byte{}, err
+    }
+    fields["dryRun"] = resultBytes
+    return json.Marshal(fields)
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "strings"
+
+    "github.com/urfave/cli"
+    "gopkg.in/yaml.v2"
+)
+
+
+// Command inputs loaded from an answers file, by command name (e.g. "node deposit") and flag name
+var answers map[string]map[string]interface{}
+
+// Whether prompts are disabled
+var nonInteractive bool
+
+
+// Missing input error
+// Returned when inputs required by a command are not provided in non-interactive mode
+type MissingInputError struct {
+    Command string
+    Inputs []string
+}
+func (e *MissingInputError) Error() string {
+    return fmt.Sprintf("Missing input(s) required in non-interactive mode: %s. These can be provided with command flags or under '%s' in an answers file.", strings.Join(e.Inputs, ", "), e.Command)
+}
+
+
+// Set whether prompts are disabled
+func SetNonInteractive(value bool) {
+    nonInteractive = value
+}
+
+
+// Check whether prompts are disabled
+func IsNonInteractive() bool {
+    return nonInteractive
+}
+
+
+// Load command inputs from a YAML answers file
+// Each top-level key is a command name, mapped to the flag values to use for that command:
+//
+//   node deposit:
+//     amount: 16
+//     max-slippage: auto
+//     yes: true
+//
+func LoadAnswers(path string) error {
+
+    // Read file
+    bytes, err := ioutil.ReadFile(path)
+    if err != nil {
+        return fmt.Errorf("Could not read answers file at %s: %w", path, err)
+    }
+
+    // Decode answers
+    var fileAnswers map[string]map[string]interface{}
+    if err := yaml.Unmarshal(bytes, &fileAnswers); err != nil {
+        return fmt.Errorf("Could not parse answers file at %s: %w", path, err)
+    }
+
+    // Set & return
+    answers = fileAnswers
+    return nil
+
+}
+
+
+// Wrap command actions to apply answers file inputs before running them, and to set the command name on missing input errors
+func WrapCommandActions(commands []cli.Command) {
+    wrapCommandActions(commands, "")
+}
+func wrapCommandActions(commands []cli.Command, parentName string) {
+    for ci := range commands {
+        command := &commands[ci]
+        commandName := strings.TrimSpace(parentName + " " + command.Name)
+        wrapCommandActions(command.Subcommands, commandName)
+        if command.Action == nil { continue }
+        action := command.Action
+        command.Action = func(c *cli.Context) (err error) {
+
+            // Set the command name on missing input errors
+            defer func() {
+                var missingInputErr *MissingInputError
+                if errors.As(err, &missingInputErr) {
+                    missingInputErr.Command = commandName
+                }
+            }()
+
+            // Apply answers & run action
+            if err := applyAnswers(c, commandName); err != nil {
+                return err
+            }
+            return cli.HandleAction(action, c)
+
+        }
+    }
+}
+
+
+// Check that inputs for a command's prompts are provided in non-interactive mode
+// Inputs are command flags, which may be set on the command line or in an answers file
+func RequireInputs(c *cli.Context, flagNames ...string) error {
+    if !nonInteractive {
+        return nil
+    }
+    missing := []string{}
+    for _, name := range flagNames {
+        if !c.IsSet(name) {
+            missing = append(missing, "--" + name)
+        }
+    }
+    if len(missing) > 0 {
+        return &MissingInputError{Inputs: missing}
+    }
+    return nil
+}
+
+
+// Apply answers to a command's flags which were not set on the command line
+func applyAnswers(c *cli.Context, commandName string) error {
+    for name, value := range answers[commandName] {
+
+        // Get flag names, including aliases
+        var flagNames []string
+        for _, flag := range c.Command.Flags {
+            names := strings.Split(flag.GetName(), ",")
+            for ni := range names {
+                names[ni] = strings.TrimSpace(names[ni])
+            }
+            for _, flagName := range names {
+                if flagName == name {
+                    flagNames = names
+                    break
+                }
+            }
+        }
+        if flagNames == nil {
+            return fmt.Errorf("Invalid answer '%s' for command '%s' - the command has no '%s' option", name, commandName, name)
+        }
+
+        // Skip flags set on the command line
+        if c.IsSet(name) { continue }
+
+        // Set flag values; lists set multiple values on slice flags
+        values, ok := value.([]interface{})
+        if !ok {
+            values = []interface{}{value}
+        }
+        for _, flagName := range flagNames {
+            for _, flagValue := range values {
+                if err := c.Set(flagName, fmt.Sprint(flagValue)); err != nil {
+                    return fmt.Errorf("Invalid answer '%s' for command '%s': %w", name, commandName, err)
+                }
+            }
+        }
+
+    }
+    return nil
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "io"
+    "os"
+    "strconv"
+    "strings"
+
+    "github.com/urfave/cli"
+    "gopkg.in/yaml.v2"
+)
+
+
+// Output formats
+const (
+    TableOutput = "table"
+    JsonOutput = "json"
+    YamlOutput = "yaml"
+)
+
+
+// Result of a command run on one of several items (e.g. a minipool or lot)
+type ItemResult struct {
+    Item string                         `json:"item"`
+    Response interface{}                `json:"response,omitempty"`
+    Error string                        `json:"error,omitempty"`
+}
+
+
+// Structured output writer
+var output io.Writer = os.Stdout
+
+// Text output writer, for messages & prompts
+var textOutput io.Writer = os.Stdout
+
+
+// Set the writer structured output is printed to
+func SetOutput(w io.Writer) {
+    output = w
+}
+
+
+// Set the writer text output is printed to
+// Text output is printed to stderr when structured output is printed to stdout
+func SetTextOutput(w io.Writer) {
+    textOutput = w
+}
+
+
+// Get the writer text output is printed to
+func TextOutput() io.Writer {
+    return textOutput
+}
+
+
+// Print text output
+func Print(a ...interface{}) {
+    fmt.Fprint(textOutput, a...)
+}
+func Printf(format string, a ...interface{}) {
+    fmt.Fprintf(textOutput, format, a...)
+}
+func Println(a ...interface{}) {
+    fmt.Fprintln(textOutput, a...)
+}
+
+
+// Get the output format selected with the global output flag
+func GetOutputFormat(c *cli.Context) string {
+    format := strings.ToLower(c.GlobalString("output"))
+    if format == "" {
+        return TableOutput
+    }
+    return format
+}
+
+
+// Check whether structured (JSON or YAML) output is selected
+func IsStructuredOutput(c *cli.Context) bool {
+    format := GetOutputFormat(c)
+    return (format == JsonOutput || format == YamlOutput)
+}
+
+
+// Create an item result from an API response & error
+func NewItemResult(item string, response interface{}, err error) ItemResult {
+    if err != nil {
+        return ItemResult{Item: item, Error: err.Error()}
+    }
+    return ItemResult{Item: item, Response: response}
+}
+
+
+// Print a value in the selected structured output format
+func PrintOutput(c *cli.Context, value interface{}) error {
+    return PrintFormattedOutput(GetOutputFormat(c), value)
+}
+
+
+// Print a value in a structured output format
+func PrintFormattedOutput(format string, value interface{}) error {
+
+    // Encode value as JSON
+    jsonBytes, err := json.MarshalIndent(value, "", "  ")
+    if err != nil {
+        return fmt.Errorf("Could not encode output: %w", err)
+    }
+
+    // Print JSON
+    if format != YamlOutput {
+        _, err := fmt.Fprintln(output, string(jsonBytes))
+        return err
+    }
+
+    // Re-encode as YAML so that the YAML schema matches the JSON schema
+    var data interface{}
+    decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+    decoder.UseNumber()
+    if err := decoder.Decode(&data); err != nil {
+        return fmt.Errorf("Could not encode output: %w", err)
+    }
+    yamlBytes, err := yaml.Marshal(getYamlValue(data))
+    if err != nil {
+        return fmt.Errorf("Could not encode output: %w", err)
+    }
+
+    // Print YAML
+    _, err = output.Write(yamlBytes)
+    return err
+
+}
+
+
+// Convert a decoded JSON value for YAML encoding
+// Integers which don't fit in 64 bits (e.g. wei amounts) are encoded as strings to preserve their precision
+func getYamlValue(value interface{}) interface{} {
+    switch v := value.(type) {
+        case map[string]interface{}:
+            for key, item := range v {
+                v[key] = getYamlValue(item)
+            }
+            return v
+        case []interface{}:
+            for i, item := range v {
+                v[i] = getYamlValue(item)
+            }
+            return v
+        case json.Number:
+            if i, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+                return i
+            }
+            if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+                return u
+            }
+            if strings.ContainsAny(v.String(), ".eE") {
+                if f, err := strconv.ParseFloat(v.String(), 64); err == nil {
+                    return f
+                }
+            }
+            return v.String()
+    }
+    return value
+}
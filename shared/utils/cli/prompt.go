@@ -2,43 +2,64 @@ package cli
 
 import (
     "bufio"
+    "errors"
     "fmt"
     "os"
     "regexp"
     "strconv"
     "strings"
+
+    "github.com/urfave/cli"
 )
 
 
 // Prompt for user input
-func Prompt(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) string {
+// Returns a missing input error if prompts are disabled
+func Prompt(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) (string, error) {
+
+    // Check prompts are enabled
+    if err := checkInteractive(initialPrompt); err != nil {
+        return "", err
+    }
 
     // Print initial prompt
-    fmt.Println(initialPrompt)
+    Println(initialPrompt)
 
     // Get valid user input
     scanner := bufio.NewScanner(os.Stdin)
-    for scanner.Scan(); !regexp.MustCompile(expectedFormat).MatchString(scanner.Text()); scanner.Scan() {
-        fmt.Println("")
-        fmt.Println(incorrectFormatPrompt)
+    for {
+        if !scanner.Scan() {
+            if err := scanner.Err(); err != nil {
+                return "", fmt.Errorf("Could not read input: %w", err)
+            }
+            return "", errors.New("Could not read input: input closed")
+        }
+        if regexp.MustCompile(expectedFormat).MatchString(scanner.Text()) {
+            break
+        }
+        Println("")
+        Println(incorrectFormatPrompt)
     }
-    fmt.Println("")
+    Println("")
 
     // Return user input
-    return scanner.Text()
+    return scanner.Text(), nil
 
 }
 
 
 // Prompt for confirmation
-func Confirm(initialPrompt string) bool {
-    response := Prompt(fmt.Sprintf("%s [y/n]", initialPrompt), "(?i)^(y|yes|n|no)$", "Please answer 'y' or 'n'")
-    return (strings.ToLower(response[:1]) == "y")
+func Confirm(initialPrompt string) (bool, error) {
+    response, err := Prompt(fmt.Sprintf("%s [y/n]", initialPrompt), "(?i)^(y|yes|n|no)$", "Please answer 'y' or 'n'")
+    if err != nil {
+        return false, err
+    }
+    return (strings.ToLower(response[:1]) == "y"), nil
 }
 
 
 // Prompt for user selection
-func Select(initialPrompt string, options []string) (int, string) {
+func Select(initialPrompt string, options []string) (int, string, error) {
 
     // Get prompt
     prompt := initialPrompt
@@ -54,7 +75,10 @@ func Select(initialPrompt string, options []string) (int, string) {
     expectedFormat := fmt.Sprintf("^(%s)$", strings.Join(optionNumbers, "|"))
 
     // Prompt user
-    response := Prompt(prompt, expectedFormat, "Please enter a number corresponding to an option")
+    response, err := Prompt(prompt, expectedFormat, "Please enter a number corresponding to an option")
+    if err != nil {
+        return 0, "", err
+    }
 
     // Get selected option
     index, _ := strconv.Atoi(response)
@@ -62,7 +86,24 @@ func Select(initialPrompt string, options []string) (int, string) {
     selectedOption := options[selectedIndex]
 
     // Return
-    return selectedIndex, selectedOption
+    return selectedIndex, selectedOption, nil
+
+}
+
 
+// Check that prompts are enabled, returning a missing input error if not
+func checkInteractive(prompt string) error {
+    if nonInteractive {
+        return &MissingInputError{Inputs: []string{fmt.Sprintf("'%s'", strings.SplitN(prompt, "\n", 2)[0])}}
+    }
+    return nil
 }
 
+
+// Prompt for confirmation, unless already confirmed with the command's yes flag
+func ConfirmUnlessYes(c *cli.Context, initialPrompt string) (bool, error) {
+    if c.Bool("yes") {
+        return true, nil
+    }
+    return Confirm(initialPrompt)
+}
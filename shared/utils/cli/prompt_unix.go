@@ -12,10 +12,16 @@ import (
 
 
 // Prompt for password input
-func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) string {
+// Returns a missing input error if prompts are disabled
+func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) (string, error) {
+
+    // Check prompts are enabled
+    if err := checkInteractive(initialPrompt); err != nil {
+        return "", err
+    }
 
     // Print initial prompt
-    fmt.Println(initialPrompt)
+    Println(initialPrompt)
 
     // Get valid user input
     var input string
@@ -24,24 +30,24 @@ func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormat
 
         // Incorrect format
         if init {
-            fmt.Println("")
-            fmt.Println(incorrectFormatPrompt)
+            Println("")
+            Println(incorrectFormatPrompt)
         } else {
             init = true
         }
 
         // Read password
-        if bytes, err := term.ReadPassword(syscall.Stdin); err != nil {
-            fmt.Println(fmt.Errorf("Could not read password: %w", err))
-        } else {
-            input = string(bytes)
+        bytes, err := term.ReadPassword(syscall.Stdin)
+        if err != nil {
+            return "", fmt.Errorf("Could not read password: %w", err)
         }
+        input = string(bytes)
 
     }
-    fmt.Println("")
+    Println("")
 
     // Return user input
-    return input
+    return input, nil
 
 }
 
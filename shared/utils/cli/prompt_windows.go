@@ -4,7 +4,16 @@ package cli
 
 
 // Prompt for password input
-func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) string {
+// Returns a missing input error if prompts are disabled
+func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) (string, error) {
+
+    // Check prompts are enabled
+    if err := checkInteractive(initialPrompt); err != nil {
+        return "", err
+    }
+
+    // Prompt for input
     return Prompt(initialPrompt, expectedFormat, incorrectFormatPrompt)
+
 }
 
@@ -3,9 +3,11 @@ package cli
 import (
     "fmt"
     "math/big"
+    "path/filepath"
     "regexp"
     "strconv"
     "strings"
+    "time"
 
     "github.com/ethereum/go-ethereum/common"
     "github.com/tyler-smith/go-bip39"
@@ -69,6 +71,16 @@ func ValidateAddress(name, value string) (common.Address, error) {
 }
 
 
+// Validate a date in the format YYYY-MM-DD
+func ValidateDate(name, value string) (time.Time, error) {
+    val, err := time.ParseInLocation("2006-01-02", value, time.Local)
+    if err != nil {
+        return time.Time{}, fmt.Errorf("Invalid %s '%s' - must be in the format 'YYYY-MM-DD'", name, value)
+    }
+    return val, nil
+}
+
+
 // Validate a wei amount
 func ValidateWeiAmount(name, value string) (*big.Int, error) {
     val := new(big.Int)
@@ -217,6 +229,36 @@ func ValidateWalletMnemonic(name, value string) (string, error) {
 }
 
 
+// Validate a report format
+func ValidateReportFormat(name, value string) (string, error) {
+    val := strings.ToLower(value)
+    if !(val == "csv" || val == "json") {
+        return "", fmt.Errorf("Invalid %s '%s' - valid formats are 'csv' and 'json'", name, value)
+    }
+    return val, nil
+}
+
+
+// Validate an output format
+func ValidateOutputFormat(name, value string) (string, error) {
+    val := strings.ToLower(value)
+    if !(val == TableOutput || val == JsonOutput || val == YamlOutput) {
+        return "", fmt.Errorf("Invalid %s '%s' - valid formats are 'table', 'json' and 'yaml'", name, value)
+    }
+    return val, nil
+}
+
+
+// Validate a relative file path, which may not refer outside of its base folder
+func ValidateRelativePath(name, value string) (string, error) {
+    path := filepath.Clean(value)
+    if value == "" || filepath.IsAbs(path) || path == ".." || strings.HasPrefix(path, ".." + string(filepath.Separator)) {
+        return "", fmt.Errorf("Invalid %s '%s' - must be a relative path within its folder", name, value)
+    }
+    return path, nil
+}
+
+
 // Validate a timezone location
 func ValidateTimezoneLocation(name, value string) (string, error) {
     if !regexp.MustCompile("^([a-zA-Z_]{2,}\\/)+[a-zA-Z_]{2,}$").MatchString(value) {
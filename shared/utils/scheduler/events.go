@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/ethereum/go-ethereum/ethclient"
+)
+
+
+// New block trigger
+type blockTrigger struct {
+    ec *ethclient.Client
+    pollInterval time.Duration
+    taskNames []string
+}
+
+
+// Trigger tasks early whenever the Eth 1.0 client receives a new block
+func (s *Scheduler) TriggerOnNewBlocks(ec *ethclient.Client, pollInterval time.Duration, names ...string) error {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+
+    // Check scheduler state & task names
+    if s.started {
+        return fmt.Errorf("Could not add new block trigger: the scheduler has already started")
+    }
+    for _, name := range names {
+        if _, ok := s.tasks[name]; !ok {
+            return fmt.Errorf("Could not add new block trigger for task %s: task not found", name)
+        }
+    }
+
+    // Add trigger
+    s.blockTriggers = append(s.blockTriggers, &blockTrigger{
+        ec: ec,
+        pollInterval: pollInterval,
+        taskNames: names,
+    })
+
+    // Return
+    return nil
+
+}
+
+
+// Poll for new blocks and trigger tasks
+func (s *Scheduler) watchBlocks(bt *blockTrigger) {
+    var lastBlock uint64
+    for {
+
+        // Get latest block header
+        header, err := bt.ec.HeaderByNumber(context.Background(), nil)
+        if err != nil {
+            s.errorLog.Println(fmt.Errorf("Could not get latest block for task triggers: %w", err))
+        } else if blockNumber := header.Number.Uint64(); blockNumber > lastBlock {
+
+            // Trigger tasks on new blocks, skipping the first block seen
+            if lastBlock > 0 {
+                for _, name := range bt.taskNames {
+                    s.Trigger(name)
+                }
+            }
+            lastBlock = blockNumber
+
+        }
+
+        // Pause before next poll
+        time.Sleep(bt.pollInterval)
+
+    }
+}
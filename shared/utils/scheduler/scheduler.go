@@ -0,0 +1,297 @@
+package scheduler
+
+import (
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+
+// Task concurrency policies
+type ConcurrencyPolicy int
+const (
+
+    // Triggers received while the task is running are dropped, and scheduled runs are skipped
+    // while a timed out run of the task is still in progress
+    SkipIfRunning ConcurrencyPolicy = iota
+
+    // A single trigger received while the task is running is queued and run on completion
+    QueueIfRunning
+
+    // Scheduled runs start even while a timed out run of the task is still in progress
+    AllowConcurrent
+
+)
+
+
+// Task function
+type TaskFunc func() error
+
+
+// Task run listener, called with the outcome of each task run
+type TaskListener func(name string, duration time.Duration, err error)
+
+
+// Task scheduling settings
+// Tasks with the same group run one at a time; the timeout applies to waiting for the group as well as to the run,
+// and a run which times out frees the group for other tasks
+type TaskSettings struct {
+    Interval time.Duration
+    Timeout time.Duration
+    StartDelay time.Duration
+    Concurrency ConcurrencyPolicy
+    Group string
+}
+
+
+// Scheduled task
+type task struct {
+    name string
+    run TaskFunc
+    settings TaskSettings
+    trigger chan struct{}
+    running int
+    lock sync.Mutex
+}
+
+
+// Task scheduler
+type Scheduler struct {
+    tasks map[string]*task
+    taskNames []string
+    blockTriggers []*blockTrigger
+    listeners []TaskListener
+    groupLanes map[string]chan struct{}
+    errorLog log.ColorLogger
+    started bool
+    lock sync.Mutex
+}
+
+
+// Create new task scheduler
+func NewScheduler(errorLog log.ColorLogger) *Scheduler {
+    return &Scheduler{
+        tasks: map[string]*task{},
+        taskNames: []string{},
+        groupLanes: map[string]chan struct{}{},
+        errorLog: errorLog,
+    }
+}
+
+
+// Register a task with the scheduler
+func (s *Scheduler) Register(name string, run TaskFunc, settings TaskSettings) error {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+
+    // Check scheduler state & task name
+    if s.started {
+        return fmt.Errorf("Could not register task %s: the scheduler has already started", name)
+    }
+    if _, ok := s.tasks[name]; ok {
+        return fmt.Errorf("Could not register task %s: a task with this name already exists", name)
+    }
+
+    // Check settings
+    if settings.Interval <= 0 {
+        return fmt.Errorf("Could not register task %s: the task interval must be greater than zero", name)
+    }
+
+    // Add task
+    s.tasks[name] = &task{
+        name: name,
+        run: run,
+        settings: settings,
+        trigger: make(chan struct{}, 1),
+    }
+    s.taskNames = append(s.taskNames, name)
+    if settings.Group != "" {
+        if _, ok := s.groupLanes[settings.Group]; !ok {
+            s.groupLanes[settings.Group] = make(chan struct{}, 1)
+        }
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Add a listener to be notified of task run outcomes
+func (s *Scheduler) AddTaskListener(listener TaskListener) {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+    s.listeners = append(s.listeners, listener)
+}
+
+
+// Trigger a task to run as soon as possible
+func (s *Scheduler) Trigger(name string) error {
+
+    // Get task
+    s.lock.Lock()
+    t, ok := s.tasks[name]
+    s.lock.Unlock()
+    if !ok {
+        return fmt.Errorf("Could not trigger task %s: task not found", name)
+    }
+
+    // Send trigger; ignore if a trigger is already pending
+    select {
+        case t.trigger <- struct{}{}:
+        default:
+    }
+    return nil
+
+}
+
+
+// Start all registered tasks and block forever
+func (s *Scheduler) Run() {
+    s.Start()
+    select {}
+}
+
+
+// Start all registered tasks
+func (s *Scheduler) Start() {
+    s.lock.Lock()
+    defer s.lock.Unlock()
+    if s.started {
+        return
+    }
+    s.started = true
+    for _, name := range s.taskNames {
+        go s.loop(s.tasks[name])
+    }
+    for _, bt := range s.blockTriggers {
+        go s.watchBlocks(bt)
+    }
+}
+
+
+// Run a task on its interval or when triggered
+func (s *Scheduler) loop(t *task) {
+
+    // Initialize timer
+    timer := time.NewTimer(t.settings.StartDelay)
+
+    // Run task loop
+    for {
+
+        // Wait for next scheduled run or trigger
+        select {
+            case <-timer.C:
+            case <-t.trigger:
+                if !timer.Stop() {
+                    <-timer.C
+                }
+        }
+
+        // Run task
+        s.execute(t)
+
+        // Drop triggers received while running unless queueing them
+        if t.settings.Concurrency != QueueIfRunning {
+            select {
+                case <-t.trigger:
+                default:
+            }
+        }
+
+        // Schedule next run
+        timer.Reset(t.settings.Interval)
+
+    }
+
+}
+
+
+// Execute a single task run
+func (s *Scheduler) execute(t *task) {
+
+    // Check for runs still in progress
+    t.lock.Lock()
+    if t.running > 0 && t.settings.Concurrency != AllowConcurrent {
+        t.lock.Unlock()
+        return
+    }
+    t.running++
+    t.lock.Unlock()
+
+    // Start timeout; a nil channel never times out
+    startTime := time.Now()
+    var timeout <-chan time.Time
+    if t.settings.Timeout > 0 {
+        timer := time.NewTimer(t.settings.Timeout)
+        defer timer.Stop()
+        timeout = timer.C
+    }
+
+    // Wait for other tasks in the group to complete
+    release := func() {}
+    if t.settings.Group != "" {
+        s.lock.Lock()
+        lane := s.groupLanes[t.settings.Group]
+        s.lock.Unlock()
+        select {
+            case lane <- struct{}{}:
+                var releaseOnce sync.Once
+                release = func() {
+                    releaseOnce.Do(func() { <-lane })
+                }
+            case <-timeout:
+                t.lock.Lock()
+                t.running--
+                t.lock.Unlock()
+                s.report(t, startTime, fmt.Errorf("Task %s timed out after %s waiting for other %s tasks", t.name, t.settings.Timeout.String(), t.settings.Group))
+                return
+        }
+    }
+
+    // Run task
+    done := make(chan error, 1)
+    go func() {
+        err := t.run()
+        release()
+        t.lock.Lock()
+        t.running--
+        t.lock.Unlock()
+        done <- err
+    }()
+
+    // Wait for task to complete or time out; a timed out run frees the group
+    var err error
+    select {
+        case err = <-done:
+        case <-timeout:
+            release()
+            err = fmt.Errorf("Task %s timed out after %s", t.name, t.settings.Timeout.String())
+    }
+
+    // Report run
+    s.report(t, startTime, err)
+
+}
+
+
+// Log a task run error and notify listeners of the outcome
+func (s *Scheduler) report(t *task, startTime time.Time, err error) {
+
+    // Log errors
+    if err != nil {
+        s.errorLog.Println(err)
+    }
+
+    // Notify listeners
+    s.lock.Lock()
+    listeners := s.listeners
+    s.lock.Unlock()
+    duration := time.Since(startTime)
+    for _, listener := range listeners {
+        listener(t.name, duration, err)
+    }
+
+}
@@ -3,15 +3,15 @@
 package term
 
 import (
-    "os"
+    "io"
     "os/exec"
 )
 
 
-// Clear terminal output
-func Clear() error {
+// Clear terminal output printed to a writer
+func Clear(w io.Writer) error {
     cmd := exec.Command("clear")
-    cmd.Stdout = os.Stdout
+    cmd.Stdout = w
     return cmd.Run()
 }
 
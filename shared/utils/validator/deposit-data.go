@@ -1,6 +1,10 @@
 package validator
 
 import (
+    "bytes"
+    "errors"
+    "fmt"
+
     "github.com/ethereum/go-ethereum/common"
     "github.com/prysmaticlabs/go-ssz"
     eth2types "github.com/wealdtech/go-eth2-types/v2"
@@ -32,17 +36,8 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
         Amount: DepositAmount,
     }
 
-    // Get signing root
-    sr, err := ssz.SigningRoot(depositData)
-    if err != nil {
-        return DepositData{}, common.Hash{}, err
-    }
-
     // Get signing root with domain
-    srWithDomain, err := ssz.HashTreeRoot(signingRoot{
-        ObjectRoot: sr[:],
-        Domain: eth2types.Domain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot),
-    })
+    srWithDomain, err := getDepositSigningRoot(depositData, eth2Config)
     if err != nil {
         return DepositData{}, common.Hash{}, err
     }
@@ -61,3 +56,66 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 
 }
 
+
+// Verify deposit data & root against the expected withdrawal credentials
+// Checks the deposit amount and withdrawal credentials, the BLS signature over the deposit message, and the deposit data root
+func VerifyDepositData(depositData DepositData, depositDataRoot common.Hash, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config) error {
+
+    // Check deposit amount & withdrawal credentials
+    if depositData.Amount != DepositAmount {
+        return fmt.Errorf("Deposit amount %d gwei does not match the expected amount of %d gwei", depositData.Amount, DepositAmount)
+    }
+    if !bytes.Equal(depositData.WithdrawalCredentials, withdrawalCredentials[:]) {
+        return fmt.Errorf("Deposit withdrawal credentials %x do not match the minipool withdrawal credentials %s", depositData.WithdrawalCredentials, withdrawalCredentials.Hex())
+    }
+
+    // Get public key & signature
+    pubkey, err := eth2types.BLSPublicKeyFromBytes(depositData.PublicKey)
+    if err != nil {
+        return fmt.Errorf("Invalid deposit public key: %w", err)
+    }
+    signature, err := eth2types.BLSSignatureFromBytes(depositData.Signature)
+    if err != nil {
+        return fmt.Errorf("Invalid deposit signature: %w", err)
+    }
+
+    // Verify signature
+    srWithDomain, err := getDepositSigningRoot(depositData, eth2Config)
+    if err != nil {
+        return err
+    }
+    if !signature.Verify(srWithDomain[:], pubkey) {
+        return errors.New("Deposit signature is not valid for the deposit public key")
+    }
+
+    // Verify deposit data root
+    expectedRoot, err := ssz.HashTreeRoot(depositData)
+    if err != nil {
+        return err
+    }
+    if !bytes.Equal(expectedRoot[:], depositDataRoot[:]) {
+        return fmt.Errorf("Deposit data root %s does not match the expected root %x", depositDataRoot.Hex(), expectedRoot)
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Get the signing root with domain for deposit data
+func getDepositSigningRoot(depositData DepositData, eth2Config beacon.Eth2Config) ([32]byte, error) {
+
+    // Get signing root; the signature is excluded
+    sr, err := ssz.SigningRoot(depositData)
+    if err != nil {
+        return [32]byte{}, err
+    }
+
+    // Get signing root with domain
+    return ssz.HashTreeRoot(signingRoot{
+        ObjectRoot: sr[:],
+        Domain: eth2types.Domain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot),
+    })
+
+}
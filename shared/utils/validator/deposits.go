@@ -0,0 +1,86 @@
+package validator
+
+import (
+    "bytes"
+    "context"
+    "encoding/binary"
+    "fmt"
+    "math/big"
+    "strings"
+
+    "github.com/ethereum/go-ethereum"
+    "github.com/ethereum/go-ethereum/accounts/abi"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/rocket-pool/rocketpool-go/types"
+)
+
+
+// Settings
+const (
+    DepositEventLogBlockRange = 10000
+    DepositContractABI = `[{"anonymous":false,"inputs":[{"indexed":false,"name":"pubkey","type":"bytes"},{"indexed":false,"name":"withdrawal_credentials","type":"bytes"},{"indexed":false,"name":"amount","type":"bytes"},{"indexed":false,"name":"signature","type":"bytes"},{"indexed":false,"name":"index","type":"bytes"}],"name":"DepositEvent","type":"event"}]`
+)
+
+
+// Deposit contract deposit event
+type depositEvent struct {
+    Pubkey []byte
+    WithdrawalCredentials []byte
+    Amount []byte
+    Signature []byte
+    Index []byte
+}
+
+
+// Get deposits made to the deposit contract for a validator pubkey within a block range
+func GetValidatorDeposits(ec *ethclient.Client, depositContractAddress common.Address, pubkey types.ValidatorPubkey, fromBlock, toBlock uint64) ([]DepositData, error) {
+
+    // Parse deposit contract ABI
+    depositContractAbi, err := abi.JSON(strings.NewReader(DepositContractABI))
+    if err != nil {
+        return []DepositData{}, fmt.Errorf("Could not parse deposit contract ABI: %w", err)
+    }
+    depositEventId := depositContractAbi.Events["DepositEvent"].ID
+
+    // Get deposit events in block ranges
+    deposits := []DepositData{}
+    for start := fromBlock; start <= toBlock; start += DepositEventLogBlockRange {
+        end := start + DepositEventLogBlockRange - 1
+        if end > toBlock { end = toBlock }
+
+        // Get logs
+        logs, err := ec.FilterLogs(context.Background(), ethereum.FilterQuery{
+            FromBlock: big.NewInt(int64(start)),
+            ToBlock: big.NewInt(int64(end)),
+            Addresses: []common.Address{depositContractAddress},
+            Topics: [][]common.Hash{[]common.Hash{depositEventId}},
+        })
+        if err != nil {
+            return []DepositData{}, fmt.Errorf("Could not get deposit events for blocks %d - %d: %w", start, end, err)
+        }
+
+        // Decode deposits for the pubkey
+        for _, log := range logs {
+            var event depositEvent
+            if err := depositContractAbi.UnpackIntoInterface(&event, "DepositEvent", log.Data); err != nil {
+                return []DepositData{}, fmt.Errorf("Could not decode deposit event in transaction %s: %w", log.TxHash.Hex(), err)
+            }
+            if !bytes.Equal(event.Pubkey, pubkey.Bytes()) { continue }
+            deposit := DepositData{
+                PublicKey: event.Pubkey,
+                WithdrawalCredentials: event.WithdrawalCredentials,
+                Signature: event.Signature,
+            }
+            if len(event.Amount) == 8 {
+                deposit.Amount = binary.LittleEndian.Uint64(event.Amount)
+            }
+            deposits = append(deposits, deposit)
+        }
+
+    }
+
+    // Return
+    return deposits, nil
+
+}